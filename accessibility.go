@@ -0,0 +1,205 @@
+package tuistyles
+
+import "github.com/orchard9/tui-styles/internal/colorspace"
+
+// ColorBlindness identifies a color vision deficiency to simulate
+type ColorBlindness int
+
+const (
+	// Protanopia is the absence of red-sensitive (L) cones
+	Protanopia ColorBlindness = iota
+	// Deuteranopia is the absence of green-sensitive (M) cones
+	Deuteranopia
+	// Tritanopia is the absence of blue-sensitive (S) cones
+	Tritanopia
+	// Achromatopsia is complete color blindness (luminance only)
+	Achromatopsia
+)
+
+// String returns human-readable deficiency name
+func (cb ColorBlindness) String() string {
+	switch cb {
+	case Protanopia:
+		return "Protanopia"
+	case Deuteranopia:
+		return "Deuteranopia"
+	case Tritanopia:
+		return "Tritanopia"
+	case Achromatopsia:
+		return "Achromatopsia"
+	default:
+		return "Unknown"
+	}
+}
+
+// WCAG 2.x contrast thresholds for use with CheckContrast
+const (
+	// ContrastAA is the minimum ratio for normal text at level AA
+	ContrastAA = 4.5
+	// ContrastAALarge is the minimum ratio for large/bold text at level AA
+	ContrastAALarge = 3.0
+	// ContrastAAA is the minimum ratio for normal text at level AAA
+	ContrastAAA = 7.0
+)
+
+// simulationMatrices are the Machado, Oliveira & Fernandes (2009) matrices
+// for full-severity dichromacy, applied in linear RGB
+var simulationMatrices = map[ColorBlindness][3][3]float64{
+	Protanopia: {
+		{0.152286, 1.052583, -0.204868},
+		{0.114503, 0.786281, 0.099216},
+		{-0.003882, -0.048116, 1.051998},
+	},
+	Deuteranopia: {
+		{0.367322, 0.860646, -0.227968},
+		{0.280085, 0.672501, 0.047413},
+		{-0.011820, 0.042940, 0.968881},
+	},
+	Tritanopia: {
+		{1.255528, -0.076749, -0.178779},
+		{-0.078411, 0.930809, 0.147602},
+		{0.004733, 0.691367, 0.303900},
+	},
+}
+
+// SimulateColorBlindness returns the color as perceived with the given
+// deficiency, as a hex Color. Unparseable colors are returned unchanged.
+//
+// Example:
+//
+//	seen := SimulateColorBlindness(Color("#FF0000"), Deuteranopia)
+func SimulateColorBlindness(c Color, kind ColorBlindness) Color {
+	r, g, b, ok := c.RGB()
+	if !ok {
+		return c
+	}
+
+	lr, lg, lb := colorspace.SRGBToLinear(r), colorspace.SRGBToLinear(g), colorspace.SRGBToLinear(b)
+
+	if kind == Achromatopsia {
+		y := colorspace.LinearToSRGB(relativeLuminance(lr, lg, lb))
+		return hexFromRGB(y, y, y)
+	}
+
+	m, exists := simulationMatrices[kind]
+	if !exists {
+		return c
+	}
+
+	return hexFromRGB(
+		colorspace.LinearToSRGB(m[0][0]*lr+m[0][1]*lg+m[0][2]*lb),
+		colorspace.LinearToSRGB(m[1][0]*lr+m[1][1]*lg+m[1][2]*lb),
+		colorspace.LinearToSRGB(m[2][0]*lr+m[2][1]*lg+m[2][2]*lb),
+	)
+}
+
+// SimulatePalette applies SimulateColorBlindness to every color in palette,
+// returning a new slice in the same order.
+func SimulatePalette(palette []Color, kind ColorBlindness) []Color {
+	simulated := make([]Color, len(palette))
+	for i, c := range palette {
+		simulated[i] = SimulateColorBlindness(c, kind)
+	}
+	return simulated
+}
+
+// CheckContrast returns the WCAG contrast ratio between fg and bg, from 1
+// (no contrast) to 21 (black on white). Compare against ContrastAA,
+// ContrastAALarge, or ContrastAAA. Unparseable colors are treated as black.
+//
+// Example:
+//
+//	if CheckContrast(Color("yellow"), Color("white")) < ContrastAA {
+//	    // pick a darker foreground
+//	}
+func CheckContrast(fg, bg Color) float64 {
+	l1, l2 := fg.Luminance(), bg.Luminance()
+	if l1 < l2 {
+		l1, l2 = l2, l1
+	}
+	return (l1 + 0.05) / (l2 + 0.05)
+}
+
+// PaletteConflict reports two palette colors that become hard to tell apart
+// under a color vision deficiency
+type PaletteConflict struct {
+	A, B     Color          // The conflicting palette entries (original values)
+	Kind     ColorBlindness // Deficiency under which they collide
+	Distance float64        // Perceived CIE76 ΔE after simulation
+}
+
+// DefaultMinColorDistance is the ΔE below which CheckPalette reports a conflict.
+// Values around 10 are noticeable at a glance; below that colors read as "the same".
+const DefaultMinColorDistance = 10.0
+
+// CheckPalette simulates each deficiency on palette and reports every pair
+// of colors whose perceived distance falls below minDistance (use
+// DefaultMinColorDistance when unsure). Pairs that are already close in
+// normal vision are not reported, since that is a design choice rather than
+// an accessibility regression. An empty result means the palette is safe.
+//
+// Example:
+//
+//	conflicts := CheckPalette([]Color{"#FF0000", "#00FF00"}, DefaultMinColorDistance)
+//	for _, c := range conflicts {
+//	    fmt.Printf("%s and %s collide under %s\n", c.A, c.B, c.Kind)
+//	}
+func CheckPalette(palette []Color, minDistance float64) []PaletteConflict {
+	var conflicts []PaletteConflict
+
+	for _, kind := range []ColorBlindness{Protanopia, Deuteranopia, Tritanopia} {
+		simulated := SimulatePalette(palette, kind)
+		for i := 0; i < len(palette); i++ {
+			for j := i + 1; j < len(palette); j++ {
+				if colorDistance(palette[i], palette[j]) < minDistance {
+					continue
+				}
+				d := colorDistance(simulated[i], simulated[j])
+				if d < minDistance {
+					conflicts = append(conflicts, PaletteConflict{
+						A:        palette[i],
+						B:        palette[j],
+						Kind:     kind,
+						Distance: d,
+					})
+				}
+			}
+		}
+	}
+
+	return conflicts
+}
+
+// ContrastingText returns black or white, whichever is more readable on top
+// of c. Use it to pick a text color for an arbitrary background.
+//
+// Example:
+//
+//	bg := Color("#FFFF00")
+//	s := NewStyle().Background(bg).Foreground(bg.ContrastingText()) // black
+func (c Color) ContrastingText() Color {
+	return c.ContrastingTextFrom(Color("#000000"), Color("#FFFFFF"))
+}
+
+// ContrastingTextFrom returns whichever of the given candidate colors has the
+// highest contrast against c, letting themes supply their own dark/light pair
+// instead of pure black and white. The first candidate wins ties; c itself is
+// returned if no candidates are given.
+//
+// Example:
+//
+//	fg := bg.ContrastingTextFrom(theme.TextDark, theme.TextLight)
+func (c Color) ContrastingTextFrom(candidates ...Color) Color {
+	if len(candidates) == 0 {
+		return c
+	}
+
+	best := candidates[0]
+	bestRatio := CheckContrast(best, c)
+	for _, candidate := range candidates[1:] {
+		if ratio := CheckContrast(candidate, c); ratio > bestRatio {
+			best, bestRatio = candidate, ratio
+		}
+	}
+	return best
+}
@@ -0,0 +1,146 @@
+package tuistyles
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCheckContrast(t *testing.T) {
+	tests := []struct {
+		name   string
+		fg, bg Color
+		want   float64
+	}{
+		{"black on white", "#000000", "#FFFFFF", 21},
+		{"white on black", "#FFFFFF", "#000000", 21},
+		{"same color", "#777777", "#777777", 1},
+		{"mid gray on white", "#777777", "#FFFFFF", 4.48},
+		{"invalid treated as black", "nope", "#FFFFFF", 21},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := CheckContrast(tt.fg, tt.bg)
+			if math.Abs(got-tt.want) > 0.01 {
+				t.Errorf("CheckContrast(%s, %s) = %.3f, want %.2f", tt.fg, tt.bg, got, tt.want)
+			}
+		})
+	}
+
+	if CheckContrast("yellow", "white") >= ContrastAA {
+		t.Error("yellow on white should fail WCAG AA")
+	}
+}
+
+func TestSimulateColorBlindness(t *testing.T) {
+	tests := []struct {
+		name  string
+		color Color
+		kind  ColorBlindness
+		want  Color
+	}{
+		{"white is unchanged", "#FFFFFF", Deuteranopia, "#FFFFFF"},
+		{"black is unchanged", "#000000", Protanopia, "#000000"},
+		{"achromatopsia is gray", "#FF0000", Achromatopsia, "#7F7F7F"},
+		{"invalid color passes through", "nope", Tritanopia, "nope"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SimulateColorBlindness(tt.color, tt.kind); got != tt.want {
+				t.Errorf("SimulateColorBlindness(%s, %s) = %s, want %s", tt.color, tt.kind, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSimulatePalette(t *testing.T) {
+	palette := []Color{"red", "#00FF00", "214"}
+	got := SimulatePalette(palette, Protanopia)
+	if len(got) != len(palette) {
+		t.Fatalf("SimulatePalette() returned %d colors, want %d", len(got), len(palette))
+	}
+	for i, c := range got {
+		if _, err := NewColor(string(c)); err != nil {
+			t.Errorf("simulated color %d (%s) is not valid: %v", i, c, err)
+		}
+	}
+}
+
+func TestCheckPalette(t *testing.T) {
+	// Classic red/green pair collides for red-green deficiencies
+	conflicts := CheckPalette([]Color{"#D62728", "#2CA02C"}, DefaultMinColorDistance)
+	kinds := map[ColorBlindness]bool{}
+	for _, c := range conflicts {
+		kinds[c.Kind] = true
+	}
+	if !kinds[Deuteranopia] {
+		t.Errorf("expected red/green conflict under Deuteranopia, got %+v", conflicts)
+	}
+
+	// Blue/orange is the usual safe pairing
+	if conflicts := CheckPalette([]Color{"#0072B2", "#E69F00"}, DefaultMinColorDistance); len(conflicts) != 0 {
+		t.Errorf("blue/orange should be safe, got %+v", conflicts)
+	}
+}
+
+func TestColorBlindness_String(t *testing.T) {
+	if Tritanopia.String() != "Tritanopia" || ColorBlindness(99).String() != "Unknown" {
+		t.Error("unexpected ColorBlindness.String() output")
+	}
+}
+
+func TestColor_ContrastingText(t *testing.T) {
+	tests := []struct {
+		bg   Color
+		want Color
+	}{
+		{"#FFFFFF", "#000000"},
+		{"#FFFF00", "#000000"},
+		{"yellow", "#000000"},
+		{"#000000", "#FFFFFF"},
+		{"#0000FF", "#FFFFFF"},
+		{"blue", "#FFFFFF"},
+		{"#777777", "#000000"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.bg.ContrastingText(); got != tt.want {
+			t.Errorf("%s.ContrastingText() = %s, want %s", tt.bg, got, tt.want)
+		}
+	}
+}
+
+func TestColor_ContrastingTextFrom(t *testing.T) {
+	dark, light := Color("#1E1E2E"), Color("#CDD6F4")
+
+	if got := Color("#FFFFFF").ContrastingTextFrom(dark, light); got != dark {
+		t.Errorf("on white got %s, want %s", got, dark)
+	}
+	if got := Color("#000000").ContrastingTextFrom(dark, light); got != light {
+		t.Errorf("on black got %s, want %s", got, light)
+	}
+	if got := Color("red").ContrastingTextFrom(); got != "red" {
+		t.Errorf("no candidates got %s, want the color itself", got)
+	}
+}
+
+func TestStyle_AutoForeground(t *testing.T) {
+	yellow := Color("#FFFF00")
+
+	auto := NewStyle().Background(yellow).AutoForeground(true).Render("x")
+	want := "\x1b[38;2;0;0;0;48;2;255;255;0mx\x1b[0m"
+	if auto != want {
+		t.Errorf("AutoForeground render = %q, want %q", auto, want)
+	}
+
+	explicit := NewStyle().Background(yellow).Foreground(Color("red")).AutoForeground(true).Render("x")
+	if explicit != NewStyle().Background(yellow).Foreground(Color("red")).Render("x") {
+		t.Error("explicit Foreground should take precedence over AutoForeground")
+	}
+
+	noBg := NewStyle().AutoForeground(true).Render("x")
+	if noBg != "x" {
+		t.Errorf("AutoForeground without background = %q, want plain text", noBg)
+	}
+}
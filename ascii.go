@@ -0,0 +1,163 @@
+package tuistyles
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+// ASCIIEnv is the environment variable that makes renderers created with
+// NewRenderer write 7-bit ASCII only (see Renderer.StrictASCII). Any value
+// strconv.ParseBool accepts as true (such as "1") enables it.
+const ASCIIEnv = "TUISTYLES_ASCII"
+
+// asciiTransliterations maps characters that have no entry in the glyph
+// fallbacks, such as accented letters and common symbols, to one ASCII
+// character
+var asciiTransliterations = func() map[string]string {
+	m := map[string]string{
+		"★": "*", "☆": "*", "✱": "*", "ℹ": "i", "⚠": "!", "✖": "x", "✕": "x",
+		"❯": ">", "❮": "<", "»": ">", "«": "<", "›": ">", "‹": "<",
+		"±": "+", "×": "x", "÷": "/", "°": "o", "©": "c", "®": "r",
+		"¡": "!", "¿": "?", " ": " ", "‐": "-", "‑": "-", "−": "-",
+		"′": "'", "″": "\"", "‚": ",", "„": "\"", "⋯": ".", "¦": "|",
+	}
+	for i, digit := range "123456789" {
+		m[string(rune('①'+i))] = string(digit)
+	}
+	letters := map[string]string{
+		"A": "ÀÁÂÃÄÅĀĂĄ", "a": "àáâãäåāăą", "C": "ÇĆĈĊČ", "c": "çćĉċč",
+		"D": "ĎĐ", "d": "ďđ", "E": "ÈÉÊËĒĔĖĘĚ", "e": "èéêëēĕėęě",
+		"G": "ĜĞĠĢ", "g": "ĝğġģ", "I": "ÌÍÎÏĨĪĬĮİ", "i": "ìíîïĩīĭįı",
+		"L": "ĹĻĽĿŁ", "l": "ĺļľŀł", "N": "ÑŃŅŇ", "n": "ñńņň",
+		"O": "ÒÓÔÕÖØŌŎŐ", "o": "òóôõöøōŏő", "R": "ŔŖŘ", "r": "ŕŗř",
+		"S": "ŚŜŞŠ", "s": "śŝşš", "T": "ŢŤ", "t": "ţť",
+		"U": "ÙÚÛÜŨŪŬŮŰŲ", "u": "ùúûüũūŭůűų", "Y": "ÝŸ", "y": "ýÿ",
+		"Z": "ŹŻŽ", "z": "źżž",
+	}
+	for ascii, accented := range letters {
+		for _, r := range accented {
+			m[string(r)] = ascii
+		}
+	}
+	return m
+}()
+
+// asciiFor returns the ASCII replacement for a glyph that is not ASCII
+// itself, and whether it has one
+func asciiFor(g string) (string, bool) {
+	if repl, ok := asciiFallbacks[g]; ok {
+		return repl, true
+	}
+	repl, ok := asciiTransliterations[g]
+	return repl, ok
+}
+
+// ToASCII returns s with every character outside 7-bit ASCII replaced, so
+// it is safe for logs and terminals that only handle ASCII: box drawing,
+// bullets, arrows, and punctuation become their FallbackGlyphs equivalents,
+// accented letters lose their accents, and anything else becomes "?", one
+// per cell, so layouts keep their shape. Escape sequences are kept, with
+// any non-ASCII bytes in them (such as in a hyperlink's URL)
+// percent-encoded.
+//
+// Example:
+//
+//	ToASCII("╭─╮ café ★ 日本") // "+-+ cafe * ????"
+func ToASCII(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+	for _, seg := range measure.Segments(s) {
+		if seg.Escape {
+			for i := 0; i < len(seg.Text); i++ {
+				if c := seg.Text[i]; c >= 0x80 {
+					fmt.Fprintf(&b, "%%%02X", c)
+				} else {
+					b.WriteByte(c)
+				}
+			}
+			continue
+		}
+		measure.EachGrapheme(seg.Text, func(cluster string, width int) bool {
+			if isASCII(cluster) {
+				b.WriteString(cluster)
+				return true
+			}
+			repl, ok := asciiFor(cluster)
+			if !ok || len(repl) > width {
+				repl = ""
+			}
+			b.WriteString(repl)
+			b.WriteString(strings.Repeat("?", width-len(repl)))
+			return true
+		})
+	}
+	return b.String()
+}
+
+// UnmappedGlyphs returns the characters in s that ToASCII can only show as
+// "?", each once, in the order they first appear. Escape sequences are
+// skipped. Use it in tests to check that output meant for ASCII-only logs
+// reads well.
+//
+// Example:
+//
+//	UnmappedGlyphs("╭─╮ café ★ 日本") // ["日", "本"]
+func UnmappedGlyphs(s string) []string {
+	var glyphs []string
+	seen := make(map[string]bool)
+	for _, seg := range measure.Segments(s) {
+		if seg.Escape {
+			continue
+		}
+		measure.EachGrapheme(seg.Text, func(cluster string, width int) bool {
+			if isASCII(cluster) || seen[cluster] {
+				return true
+			}
+			if repl, ok := asciiFor(cluster); ok && len(repl) <= width {
+				return true
+			}
+			seen[cluster] = true
+			glyphs = append(glyphs, cluster)
+			return true
+		})
+	}
+	return glyphs
+}
+
+// isASCII reports whether every byte of s is 7-bit
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= 0x80 {
+			return false
+		}
+	}
+	return true
+}
+
+// StrictASCII makes the renderer guarantee 7-bit ASCII output, for CI logs
+// and other legacy consumers: borders and glyphs fall back as under
+// GlyphsASCII, and whatever is left outside ASCII is replaced as ToASCII
+// describes, including text added by hooks.
+//
+// A renderer whose glyph support is GlyphsASCII, whether detected (see
+// DetectGlyphSupport) or set with GlyphSupport, or whose output target is
+// TargetASCII is always strict, and NewRenderer turns strictness on when
+// ASCIIEnv is set.
+//
+// Returns a new Renderer, leaving the original unchanged.
+//
+// Example:
+//
+//	r := NewRenderer().StrictASCII(os.Getenv("CI") != "")
+func (r Renderer) StrictASCII(v bool) Renderer {
+	r2 := r
+	r2.strictASCII = v
+	return r2
+}
+
+// asciiOnly reports whether the renderer writes 7-bit ASCII only
+func (r Renderer) asciiOnly() bool {
+	return r.strictASCII || r.glyphs == GlyphsASCII || r.target == TargetASCII
+}
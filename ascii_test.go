@@ -0,0 +1,66 @@
+package tuistyles
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestToASCII(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"ascii unchanged", "plain text", "plain text"},
+		{"borders", "╭─┬─╮", "+-+-+"},
+		{"accents", "café Ñandú", "cafe Nandu"},
+		{"symbols", "★ ⚠ ❯ ① …", "* ! > 1 ."},
+		{"wide unknown", "日本", "????"},
+		{"combining mark", "é", "?"},
+		{"styles kept", "\x1b[1m•\x1b[0m", "\x1b[1m*\x1b[0m"},
+		{"escape payload", "\x1b]8;;https://example.com/ü\x1b\\x\x1b]8;;\x1b\\", "\x1b]8;;https://example.com/%C3%BC\x1b\\x\x1b]8;;\x1b\\"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ToASCII(tt.in)
+			require.Equal(t, tt.want, got)
+			require.True(t, isASCII(got))
+			require.Equal(t, Width(tt.in), Width(got))
+		})
+	}
+}
+
+func TestUnmappedGlyphs(t *testing.T) {
+	require.Equal(t, []string{"日", "本"}, UnmappedGlyphs("╭─╮ café ★ 日本 日"))
+	require.Empty(t, UnmappedGlyphs("\x1b]8;;https://ü\x1b\\• ok"))
+}
+
+func TestRenderer_StrictASCII(t *testing.T) {
+	style := NewStyle().Border(RoundedBorder()).Bold(true)
+	hook := RenderHook{PostANSI: func(s string) string { return s + " ✨" }}
+
+	r := Renderer{}.StrictASCII(true).Hooks(hook)
+	got := r.Render(style, "naïve ★ 日")
+	require.True(t, isASCII(got), "%q", got)
+	require.Contains(t, got, "naive * ??")
+	require.Contains(t, got, "+")
+	require.Equal(t, GlyphsASCII, r.Glyphs())
+
+	require.Contains(t, Renderer{}.Hooks(hook).Render(style, "★"), "★")
+}
+
+func TestNewRenderer_StrictASCII(t *testing.T) {
+	t.Setenv("TERM", "xterm-256color")
+	t.Setenv("LC_ALL", "en_US.UTF-8")
+	t.Setenv(ASCIIEnv, "")
+	require.Equal(t, "日", NewRenderer().Render(NewStyle(), "日"))
+
+	t.Setenv(ASCIIEnv, "1")
+	require.Equal(t, "??", NewRenderer().Render(NewStyle(), "日"))
+
+	t.Setenv(ASCIIEnv, "")
+	t.Setenv("TERM", "dumb")
+	require.Equal(t, "??", NewRenderer().Render(NewStyle(), "日"), "detected ASCII-only terminals are strict")
+}
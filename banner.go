@@ -0,0 +1,115 @@
+package tuistyles
+
+import (
+	"strings"
+
+	"github.com/orchard9/tui-styles/internal/ansi"
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+// Banner renders text in large letters for headers and splash screens.
+//
+// Gradient colors the letters left to right; Style is applied to the whole
+// banner, so it can add a background, padding, or a border around it. When
+// Gradient is empty the letters take Style's foreground.
+//
+// Example:
+//
+//	banner := Banner{
+//	    Text:     "Hello",
+//	    Font:     FontCompact(),
+//	    Gradient: []Color{"#FF5F87", "#5FAFFF"},
+//	}
+//	fmt.Println(banner.Render(80))
+type Banner struct {
+	Text     string
+	Font     *Font   // FontBlock when nil
+	Gradient []Color // Color stops blended across the banner's width
+	Style    Style
+}
+
+// Render draws the banner within width cells (0 or less means no limit).
+//
+// Lines in Text stay separate banner lines, separated by a blank row, and
+// lines too wide for width are wrapped at spaces. If a single word still
+// does not fit, the text is drawn at normal size instead, with the same
+// gradient and style.
+func (b Banner) Render(width int) string {
+	if b.Text == "" {
+		return ""
+	}
+
+	font := b.Font
+	if font == nil {
+		font = FontBlock()
+	}
+
+	var rows []string
+	for i, line := range b.lines(font, width) {
+		if width > 0 && font.lineWidth(line) > width {
+			return b.Style.Render(colorColumns(padRows(strings.Split(b.Text, "\n")), b.Gradient))
+		}
+		if i > 0 {
+			rows = append(rows, "") // keep stacked lines from touching
+		}
+		rows = append(rows, font.renderLine(line)...)
+	}
+
+	return b.Style.Render(colorColumns(padRows(rows), b.Gradient))
+}
+
+// lines splits Text into banner lines, wrapping words to fit width
+func (b Banner) lines(font *Font, width int) []string {
+	var lines []string
+	for _, paragraph := range strings.Split(b.Text, "\n") {
+		words := strings.Fields(paragraph)
+		if width <= 0 || len(words) == 0 {
+			lines = append(lines, paragraph)
+			continue
+		}
+
+		current := words[0]
+		for _, word := range words[1:] {
+			if font.lineWidth(current+" "+word) <= width {
+				current += " " + word
+				continue
+			}
+			lines = append(lines, current)
+			current = word
+		}
+		lines = append(lines, current)
+	}
+	return lines
+}
+
+// colorColumns colors the visible cells of equal-width plain rows by
+// column, spreading stops across the full width. Blank cells are left
+// uncolored, and rows end with a default-foreground code rather than a
+// full reset so an enclosing style's background carries through.
+func colorColumns(rows []string, stops []Color) string {
+	if len(stops) == 0 || len(rows) == 0 {
+		return strings.Join(rows, "\n")
+	}
+
+	colors := Gradient(measure.Width(rows[0]), stops...)
+	out := make([]string, len(rows))
+	for i, row := range rows {
+		var sb strings.Builder
+		var current Color
+		col := 0
+		measure.EachGrapheme(row, func(cluster string, width int) bool {
+			if cluster != " " && colors[col] != current {
+				current = colors[col]
+				sb.WriteString(ansi.ForegroundColor(string(current)))
+			}
+			sb.WriteString(cluster)
+			col += width
+			return true
+		})
+		if current != "" {
+			sb.WriteString(ansi.DefaultForeground())
+		}
+		out[i] = sb.String()
+	}
+	return strings.Join(out, "\n")
+}
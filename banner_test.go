@@ -0,0 +1,63 @@
+package tuistyles
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+func TestBanner_Render(t *testing.T) {
+	got := Banner{Text: "HI", Font: FontCompact()}.Render(0)
+
+	require.Equal(t, strings.Join([]string{
+		"█   █ ▀█▀",
+		"█▀▀▀█  █ ",
+		"▀   ▀ ▀▀▀",
+	}, "\n"), got)
+}
+
+func TestBanner_DefaultFont(t *testing.T) {
+	got := Banner{Text: "I"}.Render(0)
+
+	require.Equal(t, "███\n █ \n █ \n █ \n███", got)
+}
+
+func TestBanner_Empty(t *testing.T) {
+	require.Empty(t, Banner{}.Render(80))
+}
+
+func TestBanner_WrapsWords(t *testing.T) {
+	got := Banner{Text: "HI HI", Font: FontCompact()}.Render(12)
+	lines := strings.Split(got, "\n")
+
+	require.Len(t, lines, 7, "two banner lines separated by a blank row")
+	require.Equal(t, lines[0], lines[4])
+	require.Empty(t, strings.TrimSpace(lines[3]))
+	require.LessOrEqual(t, measure.MaxWidth(got), 12)
+}
+
+func TestBanner_FallsBackToPlainText(t *testing.T) {
+	got := Banner{Text: "WIDE", Font: FontCompact()}.Render(10)
+
+	require.Equal(t, "WIDE", got)
+}
+
+func TestBanner_Gradient(t *testing.T) {
+	got := Banner{Text: "I", Gradient: []Color{"#FF0000", "#0000FF"}}.Render(0)
+	lines := strings.Split(got, "\n")
+
+	require.Equal(t, "\x1b[38;2;255;0;0m█\x1b[38;2;188;0;188m█\x1b[38;2;0;0;255m█\x1b[39m", lines[0])
+	require.Equal(t, " \x1b[38;2;188;0;188m█ \x1b[39m", lines[1], "blank cells stay uncolored")
+	require.Equal(t, 3, measure.MaxWidth(got))
+}
+
+func TestBanner_StyleKeepsBackground(t *testing.T) {
+	style := NewStyle().Background(Color("#202020")).Padding(0, 1)
+	got := Banner{Text: "I", Gradient: []Color{"#FF0000"}, Style: style}.Render(0)
+
+	require.NotContains(t, strings.Split(got, "\n")[0], "\x1b[0m\x1b[38", "no reset between background and glyph colors")
+	require.Equal(t, 5, measure.MaxWidth(got))
+}
@@ -123,3 +123,57 @@ func HiddenBorder() Border {
 		BottomRight: " ",
 	}
 }
+
+// ASCIIBorder returns a 7-bit border (+, -, |) that renders on any terminal
+func ASCIIBorder() Border {
+	return Border{
+		Top:         "-",
+		Bottom:      "-",
+		Left:        "|",
+		Right:       "|",
+		TopLeft:     "+",
+		TopRight:    "+",
+		BottomLeft:  "+",
+		BottomRight: "+",
+	}
+}
+
+// namedBorders maps the stable names of predefined borders to their constructors
+var namedBorders = []struct {
+	name   string
+	border func() Border
+}{
+	{"normal", NormalBorder},
+	{"rounded", RoundedBorder},
+	{"thick", ThickBorder},
+	{"double", DoubleBorder},
+	{"block", BlockBorder},
+	{"outer-half-block", OuterHalfBlockBorder},
+	{"inner-half-block", InnerHalfBlockBorder},
+	{"hidden", HiddenBorder},
+	{"ascii", ASCIIBorder},
+}
+
+// BorderByName returns the predefined border with the given name
+// ("normal", "rounded", "thick", "double", "block", "outer-half-block",
+// "inner-half-block", "hidden", "ascii"). The second result is false if no
+// predefined border has that name.
+func BorderByName(name string) (Border, bool) {
+	for _, nb := range namedBorders {
+		if nb.name == name {
+			return nb.border(), true
+		}
+	}
+	return Border{}, false
+}
+
+// BorderName returns the name of a predefined border, or false if b is a
+// custom border
+func BorderName(b Border) (string, bool) {
+	for _, nb := range namedBorders {
+		if nb.border() == b {
+			return nb.name, true
+		}
+	}
+	return "", false
+}
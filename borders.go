@@ -126,3 +126,118 @@ func (s Style) BorderLeft(v bool) Style {
 	s2.borderLeft = &v
 	return s2
 }
+
+// borderColors returns the foreground and background colors for one border
+// side, preferring per-side colors over the whole-border colors
+func (s Style) borderColors(side boxSide) (fg, bg *Color) {
+	fg, bg = s.borderForeground, s.borderBackground
+
+	var sideFg, sideBg *Color
+	switch side {
+	case sideTop:
+		sideFg, sideBg = s.borderTopForeground, s.borderTopBackground
+	case sideRight:
+		sideFg, sideBg = s.borderRightForeground, s.borderRightBackground
+	case sideBottom:
+		sideFg, sideBg = s.borderBottomForeground, s.borderBottomBackground
+	case sideLeft:
+		sideFg, sideBg = s.borderLeftForeground, s.borderLeftBackground
+	}
+
+	if sideFg != nil {
+		fg = sideFg
+	}
+	if sideBg != nil {
+		bg = sideBg
+	}
+	return fg, bg
+}
+
+// BorderTopForeground sets the line color of the top border edge and its corners,
+// overriding BorderForeground for that edge.
+//
+// Returns a new Style with borderTopForeground set, leaving the original unchanged.
+func (s Style) BorderTopForeground(c Color) Style {
+	s2 := s
+	s2.borderTopForeground = &c
+	return s2
+}
+
+// BorderRightForeground sets the line color of the right border edge,
+// overriding BorderForeground for that edge.
+//
+// Returns a new Style with borderRightForeground set, leaving the original unchanged.
+func (s Style) BorderRightForeground(c Color) Style {
+	s2 := s
+	s2.borderRightForeground = &c
+	return s2
+}
+
+// BorderBottomForeground sets the line color of the bottom border edge and its
+// corners, overriding BorderForeground for that edge.
+//
+// Returns a new Style with borderBottomForeground set, leaving the original unchanged.
+func (s Style) BorderBottomForeground(c Color) Style {
+	s2 := s
+	s2.borderBottomForeground = &c
+	return s2
+}
+
+// BorderLeftForeground sets the line color of the left border edge,
+// overriding BorderForeground for that edge.
+//
+// Returns a new Style with borderLeftForeground set, leaving the original unchanged.
+//
+// Example:
+//
+//	// Callout: accent-colored left edge, neutral elsewhere
+//	gray, _ := NewColor("gray")
+//	blue, _ := NewColor("blue")
+//	s := NewStyle().Border(ThickBorder(), false, false, false, true).
+//	    BorderForeground(gray).
+//	    BorderLeftForeground(blue)
+func (s Style) BorderLeftForeground(c Color) Style {
+	s2 := s
+	s2.borderLeftForeground = &c
+	return s2
+}
+
+// BorderTopBackground sets the background color of the top border edge and its
+// corners, overriding BorderBackground for that edge.
+//
+// Returns a new Style with borderTopBackground set, leaving the original unchanged.
+func (s Style) BorderTopBackground(c Color) Style {
+	s2 := s
+	s2.borderTopBackground = &c
+	return s2
+}
+
+// BorderRightBackground sets the background color of the right border edge,
+// overriding BorderBackground for that edge.
+//
+// Returns a new Style with borderRightBackground set, leaving the original unchanged.
+func (s Style) BorderRightBackground(c Color) Style {
+	s2 := s
+	s2.borderRightBackground = &c
+	return s2
+}
+
+// BorderBottomBackground sets the background color of the bottom border edge and
+// its corners, overriding BorderBackground for that edge.
+//
+// Returns a new Style with borderBottomBackground set, leaving the original unchanged.
+func (s Style) BorderBottomBackground(c Color) Style {
+	s2 := s
+	s2.borderBottomBackground = &c
+	return s2
+}
+
+// BorderLeftBackground sets the background color of the left border edge,
+// overriding BorderBackground for that edge.
+//
+// Returns a new Style with borderLeftBackground set, leaving the original unchanged.
+func (s Style) BorderLeftBackground(c Color) Style {
+	s2 := s
+	s2.borderLeftBackground = &c
+	return s2
+}
@@ -1,6 +1,7 @@
 package tuistyles
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -228,3 +229,68 @@ func TestBorder_WithOtherStyles(t *testing.T) {
 	require.Equal(t, thick, *s.borderType)
 	require.Equal(t, red, *s.foreground)
 }
+
+// TestBorder_PerSideForeground tests that per-side colors override the border color.
+func TestBorder_PerSideForeground(t *testing.T) {
+	gray := Color("gray")
+	blue := Color("blue")
+	s := NewStyle().
+		Border(NormalBorder()).
+		BorderForeground(gray).
+		BorderLeftForeground(blue)
+
+	lines := strings.Split(s.Render("x"), "\n")
+	require.Len(t, lines, 3)
+
+	// Left edge uses the accent color, right edge keeps the border color
+	require.Equal(t, blue.ToANSI()+"│"+"\x1b[0m"+"x"+gray.ToANSI()+"│"+"\x1b[0m", lines[1])
+
+	// Top edge and corners keep the border color
+	require.Contains(t, lines[0], gray.ToANSI()+"┌")
+	require.NotContains(t, lines[0], blue.ToANSI())
+}
+
+// TestBorder_PerSideSetters tests every per-side color setter targets its own side.
+func TestBorder_PerSideSetters(t *testing.T) {
+	c := Color("red")
+	base := NewStyle().Border(NormalBorder())
+
+	tests := []struct {
+		name   string
+		style  Style
+		side   boxSide
+		wantFg bool
+	}{
+		{"top foreground", base.BorderTopForeground(c), sideTop, true},
+		{"right foreground", base.BorderRightForeground(c), sideRight, true},
+		{"bottom foreground", base.BorderBottomForeground(c), sideBottom, true},
+		{"left foreground", base.BorderLeftForeground(c), sideLeft, true},
+		{"top background", base.BorderTopBackground(c), sideTop, false},
+		{"right background", base.BorderRightBackground(c), sideRight, false},
+		{"bottom background", base.BorderBottomBackground(c), sideBottom, false},
+		{"left background", base.BorderLeftBackground(c), sideLeft, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for side := sideTop; side <= sideLeft; side++ {
+				fg, bg := tt.style.borderColors(side)
+				got := fg
+				if !tt.wantFg {
+					got = bg
+				}
+				if side == tt.side {
+					require.NotNil(t, got)
+					require.Equal(t, c, *got)
+				} else {
+					require.Nil(t, got)
+				}
+			}
+		})
+	}
+
+	// Original style is unchanged
+	fg, bg := base.borderColors(sideLeft)
+	require.Nil(t, fg)
+	require.Nil(t, bg)
+}
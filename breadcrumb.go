@@ -0,0 +1,114 @@
+package tuistyles
+
+import (
+	"strings"
+
+	"github.com/orchard9/tui-styles/internal/ansi"
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+// Breadcrumb renders a navigation trail such as "Home ▸ Projects ▸ api",
+// eliding middle items when it does not fit ("Home ▸ … ▸ api"). Items may
+// be pre-styled; widths are measured without escape sequences.
+//
+// Example:
+//
+//	crumbs := Breadcrumb{Items: []string{"Home", "Projects", "tui-styles", "README.md"}}
+//	fmt.Println(crumbs.Render(30))
+type Breadcrumb struct {
+	Items     []string
+	Separator string // Placed between items with a space each side; "▸" by default
+	Theme     Theme
+
+	ItemStyle      Style // Every item but the last; unstyled by default
+	CurrentStyle   Style // The last item; bold by default
+	SeparatorStyle Style // Separators and the ellipsis; muted by default
+}
+
+// ellipsis stands in for elided breadcrumb items and path segments
+const ellipsis = "…"
+
+// Render draws the trail within width cells; 0 or less means no limit.
+//
+// Middle items are dropped first, keeping the first item and as many of
+// the last items as fit. If only the last item fits it is shown alone, and
+// if even that is too wide it is truncated.
+func (b Breadcrumb) Render(width int) string {
+	if len(b.Items) == 0 {
+		return ""
+	}
+
+	theme := b.Theme.WithDefaults()
+	separator := b.Separator
+	if separator == "" {
+		separator = "▸"
+	}
+	sepStyle := orDefault(b.SeparatorStyle, NewStyle().Foreground(theme.Muted))
+	current := orDefault(b.CurrentStyle, NewStyle().Bold(true))
+
+	items := make([]string, len(b.Items))
+	for i, item := range b.Items {
+		if i == len(b.Items)-1 {
+			items[i] = current.Render(item)
+		} else {
+			items[i] = b.ItemStyle.Render(item)
+		}
+	}
+
+	return joinElided(items, " "+sepStyle.Render(separator)+" ", sepStyle.Render(ellipsis), width)
+}
+
+// ShortenPath fits a slash-separated path into width cells by replacing
+// middle segments with "…", keeping the root and the final segments:
+//
+//	ShortenPath("/usr/local/lib/go/src/net/http", 20) // "/…/go/src/net/http"
+//
+// A width of 0 or less returns the path unchanged.
+func ShortenPath(path string, width int) string {
+	return joinElided(strings.Split(path, "/"), "/", ellipsis, width)
+}
+
+// joinElided joins items with sep, replacing a run of middle items with
+// more when the result would be wider than width
+func joinElided(items []string, sep, more string, width int) string {
+	full := strings.Join(items, sep)
+	if width <= 0 || measure.Width(full) <= width {
+		return full
+	}
+
+	last := items[len(items)-1]
+	sepWidth, moreWidth := measure.Width(sep), measure.Width(more)
+	fits := func(head, tail int) bool {
+		used := moreWidth
+		for _, item := range items[:head] {
+			used += measure.Width(item) + sepWidth
+		}
+		for _, item := range items[len(items)-tail:] {
+			used += sepWidth + measure.Width(item)
+		}
+		return used <= width
+	}
+
+	// Keep the first item and grow the kept tail from the end
+	if len(items) > 2 && fits(1, 1) {
+		tail := 1
+		for tail+2 < len(items) && fits(1, tail+1) {
+			tail++
+		}
+		parts := append([]string{items[0], more}, items[len(items)-tail:]...)
+		return strings.Join(parts, sep)
+	}
+
+	if fits(0, 1) {
+		return more + sep + last
+	}
+	if measure.Width(last) <= width {
+		return last
+	}
+
+	cut, _ := measure.SplitAt(last, width-measure.Width(ellipsis))
+	if strings.Contains(cut, "\x1b[") {
+		cut += ansi.Reset()
+	}
+	return cut + ellipsis
+}
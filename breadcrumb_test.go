@@ -0,0 +1,88 @@
+package tuistyles
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+func TestBreadcrumb_Render(t *testing.T) {
+	items := []string{"Home", "Projects", "tui-styles", "docs", "README.md"}
+	tests := []struct {
+		name  string
+		width int
+		want  string
+	}{
+		{"unlimited", 0, "Home ▸ Projects ▸ tui-styles ▸ docs ▸ README.md"},
+		{"fits exactly", 47, "Home ▸ Projects ▸ tui-styles ▸ docs ▸ README.md"},
+		{"elides one", 40, "Home ▸ … ▸ tui-styles ▸ docs ▸ README.md"},
+		{"elides several", 27, "Home ▸ … ▸ docs ▸ README.md"},
+		{"first and last", 20, "Home ▸ … ▸ README.md"},
+		{"last only", 13, "… ▸ README.md"},
+		{"bare last", 9, "README.md"},
+		{"truncated last", 6, "READM…"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Breadcrumb{Items: items}.Render(tt.width)
+			require.Equal(t, tt.want, measure.StripANSI(got))
+			if tt.width > 0 {
+				require.LessOrEqual(t, measure.Width(got), tt.width)
+			}
+		})
+	}
+}
+
+func TestBreadcrumb_Styles(t *testing.T) {
+	crumbs := Breadcrumb{
+		Items:          []string{"a", "b"},
+		Separator:      "/",
+		ItemStyle:      NewStyle().Italic(true),
+		CurrentStyle:   NewStyle().Underline(true),
+		SeparatorStyle: NewStyle().Faint(true),
+	}
+
+	require.Equal(t, "\x1b[3ma\x1b[0m \x1b[2m/\x1b[0m \x1b[4mb\x1b[0m", crumbs.Render(0))
+}
+
+func TestBreadcrumb_DefaultStyles(t *testing.T) {
+	got := Breadcrumb{Items: []string{"a", "b"}}.Render(0)
+
+	require.Equal(t, "a \x1b[90m▸\x1b[0m \x1b[1mb\x1b[0m", got)
+}
+
+func TestBreadcrumb_StyledTruncation(t *testing.T) {
+	got := Breadcrumb{Items: []string{"a", "overflowing"}}.Render(5)
+
+	require.Equal(t, "over…", measure.StripANSI(got))
+	require.Equal(t, 5, measure.Width(got))
+	require.Contains(t, got, "\x1b[0m…", "style is closed before the ellipsis")
+}
+
+func TestBreadcrumb_Empty(t *testing.T) {
+	require.Empty(t, Breadcrumb{}.Render(10))
+}
+
+func TestShortenPath(t *testing.T) {
+	tests := []struct {
+		path  string
+		width int
+		want  string
+	}{
+		{"/usr/local/lib/go/src/net/http", 0, "/usr/local/lib/go/src/net/http"},
+		{"/usr/local/lib/go/src/net/http", 20, "/…/go/src/net/http"},
+		{"/usr/local/lib/go/src/net/http", 7, "/…/http"},
+		{"/usr/local/lib/go/src/net/http", 6, "…/http"},
+		{"~/projects/tui-styles/wrap.go", 20, "~/…/wrap.go"},
+		{"relative/path/to/file.txt", 19, "relative/…/file.txt"},
+		{"relative/path/to/file.txt", 17, "…/file.txt"},
+		{"short", 3, "sh…"},
+	}
+
+	for _, tt := range tests {
+		require.Equal(t, tt.want, ShortenPath(tt.path, tt.width), "%s in %d", tt.path, tt.width)
+	}
+}
@@ -0,0 +1,146 @@
+package tuistyles
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+// Calendar renders a month grid with a title, a weekday header, and one row
+// per week:
+//
+//	    October 2026
+//	Su Mo Tu We Th Fr Sa
+//	             1  2  3
+//	 4  5  6  7  8  9 10
+//	...
+//
+// Style fields left unset fall back to defaults derived from Theme, so a
+// zero Calendar with only Month set renders with today bold in the theme's
+// primary color and the selected day reversed.
+//
+// Example:
+//
+//	cal := Calendar{Month: time.Now(), Today: time.Now()}
+//	fmt.Println(JoinHorizontal(Top, cal.Render(0), "  ", next.Render(0)))
+type Calendar struct {
+	Month        time.Time    // Any date in the month to show
+	Today        time.Time    // Highlighted with TodayStyle; zero for none
+	Selected     time.Time    // Highlighted with SelectedStyle; zero for none
+	FirstWeekday time.Weekday // Leftmost column; Sunday by default
+	ShowOutside  bool         // Show the days of neighboring months in OutsideStyle
+	FixedWeeks   bool         // Always render six week rows, so months line up side by side
+	Theme        Theme
+
+	TitleStyle    Style
+	HeaderStyle   Style
+	DayStyle      Style
+	WeekendStyle  Style
+	OutsideStyle  Style
+	TodayStyle    Style
+	SelectedStyle Style
+}
+
+// calendarMinWidth is the natural grid width: seven 2-cell days and six gaps
+const calendarMinWidth = 7*2 + 6
+
+// Render draws the month. Days are spread evenly across width; widths below
+// the natural 20 cells, including 0, render at the natural width.
+func (c Calendar) Render(width int) string {
+	theme := c.Theme.WithDefaults()
+	title := orDefault(c.TitleStyle, NewStyle().Bold(true))
+	header := orDefault(c.HeaderStyle, NewStyle().Foreground(theme.Muted))
+	outside := orDefault(c.OutsideStyle, NewStyle().Faint(true))
+	today := orDefault(c.TodayStyle, NewStyle().Bold(true).Foreground(theme.Primary))
+	selected := orDefault(c.SelectedStyle, NewStyle().Reverse(true))
+
+	width = max(width, calendarMinWidth)
+	cellWidth := (width - 6) / 7
+	gridWidth := cellWidth*7 + 6
+	indent := strings.Repeat(" ", (width-gridWidth)/2)
+	cell := func(text string, style Style) string {
+		return style.Render(strings.Repeat(" ", cellWidth-len(text)) + text)
+	}
+
+	first := time.Date(c.Month.Year(), c.Month.Month(), 1, 0, 0, 0, 0, c.Month.Location())
+	lines := []string{
+		title.Width(width).Align(Center).Render(first.Format("January 2006")),
+	}
+
+	headers := make([]string, 7)
+	for i := range headers {
+		headers[i] = cell(c.weekday(i).String()[:2], header)
+	}
+	lines = append(lines, c.padRow(indent+strings.Join(headers, " "), width))
+
+	// Start on the first column's weekday on or before the 1st
+	offset := (int(first.Weekday()) - int(c.FirstWeekday) + 7) % 7
+	day := first.AddDate(0, 0, -offset)
+	weeks := 6
+	if !c.FixedWeeks {
+		weeks = (offset + daysIn(first) + 6) / 7
+	}
+
+	for range weeks {
+		days := make([]string, 7)
+		for i := range days {
+			inMonth := day.Month() == first.Month()
+			style := c.DayStyle
+			switch {
+			case !inMonth && !c.ShowOutside:
+				days[i] = strings.Repeat(" ", cellWidth)
+				day = day.AddDate(0, 0, 1)
+				continue
+			case !inMonth:
+				style = outside
+			case sameDay(day, c.Selected):
+				style = selected
+			case sameDay(day, c.Today):
+				style = today
+			case day.Weekday() == time.Saturday || day.Weekday() == time.Sunday:
+				style = orDefault(c.WeekendStyle, c.DayStyle)
+			}
+			days[i] = cell(strconv.Itoa(day.Day()), style)
+			day = day.AddDate(0, 0, 1)
+		}
+		lines = append(lines, c.padRow(indent+strings.Join(days, " "), width))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// weekday returns the weekday shown in column i
+func (c Calendar) weekday(i int) time.Weekday {
+	return time.Weekday((int(c.FirstWeekday) + i) % 7)
+}
+
+// padRow right-pads a grid row to the full calendar width
+func (c Calendar) padRow(row string, width int) string {
+	return row + strings.Repeat(" ", max(width-measure.Width(row), 0))
+}
+
+// daysIn returns the number of days in the month of t
+func daysIn(t time.Time) int {
+	return time.Date(t.Year(), t.Month()+1, 0, 0, 0, 0, 0, t.Location()).Day()
+}
+
+// sameDay reports whether a and b fall on the same calendar date; a zero b
+// never matches
+func sameDay(a, b time.Time) bool {
+	if b.IsZero() {
+		return false
+	}
+	ay, am, ad := a.Date()
+	by, bm, bd := b.Date()
+	return ay == by && am == bm && ad == bd
+}
+
+// orDefault returns s, or def if s has nothing set
+func orDefault(s, def Style) Style {
+	if s == (Style{}) {
+		return def
+	}
+	return s
+}
@@ -0,0 +1,94 @@
+package tuistyles
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+var october2026 = time.Date(2026, time.October, 16, 0, 0, 0, 0, time.UTC)
+
+func TestCalendar_Render(t *testing.T) {
+	got := measure.StripANSI(Calendar{Month: october2026}.Render(0))
+
+	require.Equal(t, strings.Join([]string{
+		"    October 2026    ",
+		"Su Mo Tu We Th Fr Sa",
+		"             1  2  3",
+		" 4  5  6  7  8  9 10",
+		"11 12 13 14 15 16 17",
+		"18 19 20 21 22 23 24",
+		"25 26 27 28 29 30 31",
+	}, "\n"), got)
+}
+
+func TestCalendar_FirstWeekday(t *testing.T) {
+	got := measure.StripANSI(Calendar{Month: october2026, FirstWeekday: time.Monday}.Render(0))
+	lines := strings.Split(got, "\n")
+
+	require.Equal(t, "Mo Tu We Th Fr Sa Su", lines[1])
+	require.Equal(t, "          1  2  3  4", lines[2])
+	require.Equal(t, "26 27 28 29 30 31   ", lines[6])
+}
+
+func TestCalendar_ShowOutsideAndFixedWeeks(t *testing.T) {
+	feb := time.Date(2026, time.February, 1, 0, 0, 0, 0, time.UTC)
+
+	plain := measure.StripANSI(Calendar{Month: feb}.Render(0))
+	require.Len(t, strings.Split(plain, "\n"), 2+4, "February 2026 starts on Sunday and fills four weeks")
+
+	got := Calendar{Month: feb, ShowOutside: true, FixedWeeks: true}.Render(0)
+	lines := strings.Split(measure.StripANSI(got), "\n")
+	require.Len(t, lines, 2+6)
+	require.Equal(t, " 1  2  3  4  5  6  7", lines[2])
+	require.Equal(t, " 8  9 10 11 12 13 14", lines[7])
+	require.Contains(t, got, "\x1b[2m 1\x1b[0m", "March days are faint")
+}
+
+func TestCalendar_Highlights(t *testing.T) {
+	selected := october2026.AddDate(0, 0, 2)
+	cal := Calendar{
+		Month:         october2026,
+		Today:         october2026,
+		Selected:      selected,
+		TodayStyle:    NewStyle().Underline(true),
+		SelectedStyle: NewStyle().Bold(true),
+		WeekendStyle:  NewStyle().Italic(true),
+	}
+	got := cal.Render(0)
+
+	require.Contains(t, got, "\x1b[4m16\x1b[0m")
+	require.Contains(t, got, "\x1b[1m18\x1b[0m", "selected wins over the weekend style")
+	require.Contains(t, got, "\x1b[3m17\x1b[0m")
+	require.NotContains(t, got, "\x1b[3m16", "today wins over the weekend style")
+}
+
+func TestCalendar_DefaultStyles(t *testing.T) {
+	got := Calendar{Month: october2026, Today: october2026, Selected: october2026.AddDate(0, 0, 1)}.Render(0)
+
+	require.Contains(t, got, "\x1b[1;35m16\x1b[0m", "today is bold in the primary color")
+	require.Contains(t, got, "\x1b[7m17\x1b[0m", "selected is reversed")
+}
+
+func TestCalendar_Width(t *testing.T) {
+	got := Calendar{Month: october2026}.Render(30)
+	lines := strings.Split(measure.StripANSI(got), "\n")
+
+	for _, line := range lines {
+		require.Equal(t, 30, measure.Width(line))
+	}
+	require.Equal(t, "  Su  Mo  Tu  We  Th  Fr  Sa", strings.TrimRight(lines[1], " "), "cells widen and the grid is centered")
+	require.Equal(t, calendarMinWidth, measure.MaxWidth(Calendar{Month: october2026}.Render(5)))
+}
+
+func TestCalendar_SideBySide(t *testing.T) {
+	oct := Calendar{Month: october2026, FixedWeeks: true}.Render(0)
+	nov := Calendar{Month: october2026.AddDate(0, 1, 0), FixedWeeks: true}.Render(0)
+	both := JoinHorizontal(Top, oct, "  ", nov)
+
+	require.Equal(t, []int{42, 42, 42, 42, 42, 42, 42, 42}, measure.WidthPerLine(both))
+}
@@ -0,0 +1,155 @@
+package tuistyles
+
+import "strings"
+
+// CalloutKind selects the icon, default title, and accent color of a Callout
+type CalloutKind int
+
+const (
+	// CalloutNote highlights information the reader should notice
+	CalloutNote CalloutKind = iota
+	// CalloutTip suggests a better way of doing something
+	CalloutTip
+	// CalloutWarning flags something that may cause problems
+	CalloutWarning
+	// CalloutError reports a failure
+	CalloutError
+	// CalloutQuote is a plain blockquote: muted bar, no icon or default title
+	CalloutQuote
+)
+
+// String returns human-readable callout kind name
+func (k CalloutKind) String() string {
+	switch k {
+	case CalloutNote:
+		return "Note"
+	case CalloutTip:
+		return "Tip"
+	case CalloutWarning:
+		return "Warning"
+	case CalloutError:
+		return "Error"
+	case CalloutQuote:
+		return "Quote"
+	default:
+		return "Unknown"
+	}
+}
+
+// icon returns the default icon for the kind ("" for quotes)
+func (k CalloutKind) icon() string {
+	switch k {
+	case CalloutNote:
+		return "ℹ"
+	case CalloutTip:
+		return "★"
+	case CalloutWarning:
+		return "⚠"
+	case CalloutError:
+		return "✖"
+	default:
+		return ""
+	}
+}
+
+// accent returns the theme color used for the kind's bar and title
+func (k CalloutKind) accent(t Theme) Color {
+	switch k {
+	case CalloutNote:
+		return t.Info
+	case CalloutTip:
+		return t.Success
+	case CalloutWarning:
+		return t.Warning
+	case CalloutError:
+		return t.Error
+	default:
+		return t.Muted
+	}
+}
+
+// Callout is an admonition block: a colored bar down the left edge, an icon
+// and title line, and a word-wrapped body.
+//
+// Title defaults to the kind's name (quotes have no default title) and Icon
+// to the kind's symbol; set Icon to a single space to hide it. The zero
+// Theme renders with DefaultTheme.
+//
+// Example:
+//
+//	c := Callout{Kind: CalloutWarning, Body: "This deletes all local data."}
+//	fmt.Println(c.Render(60))
+//	// ┃ ⚠ Warning
+//	// ┃ This deletes all local data.
+type Callout struct {
+	Kind  CalloutKind
+	Title string
+	Icon  string
+	Body  string
+	Theme Theme
+}
+
+// Render draws the callout no wider than width cells, wrapping the body to
+// fit. A width of 0 or less leaves lines at their natural length.
+func (c Callout) Render(width int) string {
+	theme := c.Theme.WithDefaults()
+	accent := c.Kind.accent(theme)
+
+	bar := NewStyle().
+		Border(ThickBorder(), false, false, false, true).
+		BorderForeground(accent).
+		PaddingLeft(1)
+
+	// The bar and its padding take two cells
+	inner := 0
+	if width > 0 {
+		inner = max(width-2, 1)
+	}
+
+	var lines []string
+	if heading := c.heading(); heading != "" {
+		lines = append(lines, NewStyle().Bold(true).Foreground(accent).Render(Wrap(heading, inner)))
+	}
+	if c.Body != "" {
+		body := Wrap(c.Body, inner)
+		if c.Kind == CalloutQuote {
+			body = NewStyle().Italic(true).Render(body)
+		}
+		lines = append(lines, body)
+	}
+
+	return bar.Render(strings.Join(lines, "\n"))
+}
+
+// heading returns the icon and title line, or "" if the callout has neither
+func (c Callout) heading() string {
+	icon := c.Icon
+	if icon == "" {
+		icon = c.Kind.icon()
+	}
+	icon = strings.TrimSpace(icon)
+
+	title := c.Title
+	if title == "" && c.Kind != CalloutQuote {
+		title = c.Kind.String()
+	}
+
+	switch {
+	case icon == "":
+		return title
+	case title == "":
+		return icon
+	default:
+		return icon + " " + title
+	}
+}
+
+// Blockquote renders text as a quote with a muted bar, wrapped to width
+// (0 for no wrapping).
+//
+// Example:
+//
+//	fmt.Println(Blockquote("Simplicity is prerequisite for reliability.", 40))
+func Blockquote(text string, width int) string {
+	return Callout{Kind: CalloutQuote, Body: text}.Render(width)
+}
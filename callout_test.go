@@ -0,0 +1,87 @@
+package tuistyles
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+func TestCallout_Render(t *testing.T) {
+	tests := []struct {
+		name    string
+		callout Callout
+		want    []string
+	}{
+		{
+			name:    "note",
+			callout: Callout{Kind: CalloutNote, Body: "Saved."},
+			want:    []string{"┃ ℹ Note", "┃ Saved."},
+		},
+		{
+			name:    "custom title and icon",
+			callout: Callout{Kind: CalloutTip, Title: "Pro tip", Icon: ">", Body: "Use -v."},
+			want:    []string{"┃ > Pro tip", "┃ Use -v."},
+		},
+		{
+			name:    "icon hidden",
+			callout: Callout{Kind: CalloutError, Icon: " ", Body: "Failed."},
+			want:    []string{"┃ Error", "┃ Failed."},
+		},
+		{
+			name:    "title only",
+			callout: Callout{Kind: CalloutWarning},
+			want:    []string{"┃ ⚠ Warning"},
+		},
+		{
+			name:    "quote has no heading",
+			callout: Callout{Kind: CalloutQuote, Body: "Less is more."},
+			want:    []string{"┃ Less is more."},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := measure.StripANSI(tt.callout.Render(0))
+			lines := strings.Split(got, "\n")
+			for i := range lines {
+				lines[i] = strings.TrimRight(lines[i], " ")
+			}
+			require.Equal(t, tt.want, lines)
+		})
+	}
+}
+
+func TestCallout_WrapsToWidth(t *testing.T) {
+	c := Callout{Kind: CalloutWarning, Body: "This deletes all local data and cannot be undone."}
+	got := c.Render(20)
+
+	lines := strings.Split(got, "\n")
+	require.Greater(t, len(lines), 2, "body should wrap onto several lines")
+	for _, line := range lines {
+		require.LessOrEqual(t, measure.Width(line), 20)
+	}
+}
+
+func TestCallout_ThemeAccent(t *testing.T) {
+	theme := Theme{Warning: Color("#FF8800")}
+	got := Callout{Kind: CalloutWarning, Body: "x", Theme: theme}.Render(0)
+
+	require.Contains(t, got, Color("#FF8800").ToANSI(), "bar and title should use the theme accent")
+}
+
+func TestBlockquote(t *testing.T) {
+	got := Blockquote("Simplicity is prerequisite for reliability.", 20)
+
+	require.Contains(t, got, DefaultTheme().Muted.ToANSI())
+	require.Contains(t, measure.StripANSI(got), "┃ Simplicity is")
+	require.Contains(t, got, "\x1b[3m", "quote body should be italic")
+}
+
+func TestCalloutKind_String(t *testing.T) {
+	require.Equal(t, "Note", CalloutNote.String())
+	require.Equal(t, "Quote", CalloutQuote.String())
+	require.Equal(t, "Unknown", CalloutKind(99).String())
+}
@@ -0,0 +1,208 @@
+// Package capabilities describes what the attached terminal can display, so
+// the rest of tuistyles (and applications) can consult one struct instead of
+// repeating environment heuristics.
+//
+// Detect gathers what the environment and the terminfo database advertise.
+// Query refines that by asking the terminal itself, for programs that
+// already have it in raw mode:
+//
+//	caps := capabilities.Detect()
+//	if caps.Colors >= capabilities.Color256 { ... }
+//	if caps.SynchronizedOutput { ... }
+package capabilities
+
+import (
+	"os"
+	"strconv"
+	"strings"
+)
+
+// ColorLevel is the range of colors a terminal can display.
+type ColorLevel int
+
+const (
+	ColorNone ColorLevel = iota // No color (TERM=dumb)
+	Color16                     // The 16 basic ANSI colors
+	Color256                    // xterm 256-color codes
+	ColorTrue                   // 24-bit colors
+)
+
+// String returns the level's name.
+func (l ColorLevel) String() string {
+	switch l {
+	case ColorNone:
+		return "none"
+	case Color16:
+		return "16"
+	case Color256:
+		return "256"
+	case ColorTrue:
+		return "truecolor"
+	default:
+		return "unknown"
+	}
+}
+
+// UnicodeLevel is how much of Unicode the terminal's font can be expected to
+// draw.
+type UnicodeLevel int
+
+const (
+	UnicodeASCII      UnicodeLevel = iota // ASCII only
+	UnicodeBoxDrawing                     // Basic box drawing and block elements
+	UnicodeFull                           // Rounded corners, quadrants, braille, emoji
+)
+
+// String returns the level's name.
+func (l UnicodeLevel) String() string {
+	switch l {
+	case UnicodeASCII:
+		return "ascii"
+	case UnicodeBoxDrawing:
+		return "box-drawing"
+	case UnicodeFull:
+		return "full"
+	default:
+		return "unknown"
+	}
+}
+
+// Capabilities lists the features of a terminal.
+type Capabilities struct {
+	Colors             ColorLevel   // Colors the terminal can display
+	Unicode            UnicodeLevel // Glyphs the terminal's font can draw
+	Hyperlinks         bool         // OSC 8 hyperlinks
+	SynchronizedOutput bool         // Synchronized updates (mode 2026)
+	KittyGraphics      bool         // Kitty graphics protocol
+	ITerm2Images       bool         // iTerm2 inline image protocol
+	Multiplexer        bool         // Running inside tmux or screen
+	NerdFont           bool         // Font has Nerd Font icons (from NERD_FONT; fonts cannot be queried)
+}
+
+// Detect returns the capabilities advertised by the environment (see
+// FromEnv), refined by the terminfo entry for $TERM when one is installed:
+// a terminfo entry with the Tc or RGB extension means true color, and its
+// color count raises the level when the environment says less.
+func Detect() Capabilities {
+	c := FromEnv(os.Getenv)
+	if info, ok := loadTerminfo(os.Getenv("TERM"), os.Getenv); ok {
+		c = c.withTerminfo(info)
+	}
+	return c
+}
+
+// FromEnv returns the capabilities advertised by environment variables
+// read through getenv, such as TERM, COLORTERM, TERM_PROGRAM, and the
+// locale. It does not touch the terminal or the filesystem, so it also
+// suits environments forwarded from a remote client.
+//
+// Terminals are recognized by the variables they set (KITTY_WINDOW_ID,
+// TERM_PROGRAM=iTerm.app, WT_SESSION, VTE_VERSION, ...). No terminal
+// reports its font, so NerdFont is only set when the user says so with
+// NERD_FONT=1 (any true value strconv.ParseBool accepts). Inside tmux or
+// screen, image protocols and synchronized output are reported as
+// unsupported, since those multiplexers do not pass them through by
+// default.
+func FromEnv(getenv func(string) string) Capabilities {
+	term := getenv("TERM")
+	program := getenv("TERM_PROGRAM")
+
+	nerdFont, _ := strconv.ParseBool(getenv("NERD_FONT")) //nolint:errcheck // unset or invalid means no
+	c := Capabilities{
+		Colors:      envColors(getenv),
+		Unicode:     envUnicode(getenv),
+		Multiplexer: getenv("TMUX") != "" || strings.HasPrefix(term, "screen") || strings.HasPrefix(term, "tmux"),
+		NerdFont:    nerdFont,
+	}
+
+	kitty := getenv("KITTY_WINDOW_ID") != "" || term == "xterm-kitty"
+	ghostty := program == "ghostty" || term == "xterm-ghostty"
+	iterm := program == "iTerm.app" || getenv("LC_TERMINAL") == "iTerm2"
+	wezterm := program == "WezTerm"
+	windowsTerminal := getenv("WT_SESSION") != ""
+	vte := envVersion(getenv("VTE_VERSION")) >= 5000
+	foot := term == "foot" || strings.HasPrefix(term, "foot-")
+	alacritty := term == "alacritty"
+
+	c.Hyperlinks = kitty || ghostty || iterm || wezterm || windowsTerminal || vte || foot || alacritty ||
+		program == "vscode" || program == "Hyper" || getenv("KONSOLE_VERSION") != ""
+	c.SynchronizedOutput = kitty || ghostty || iterm || wezterm || windowsTerminal || foot || alacritty ||
+		program == "contour"
+	c.KittyGraphics = kitty || ghostty
+	c.ITerm2Images = iterm || wezterm || program == "mintty"
+
+	if c.Multiplexer {
+		c.SynchronizedOutput = false
+		c.KittyGraphics = false
+		c.ITerm2Images = false
+	}
+	return c
+}
+
+// envColors reads the color level from COLORTERM and TERM
+func envColors(getenv func(string) string) ColorLevel {
+	term := getenv("TERM")
+	if term == "dumb" {
+		return ColorNone
+	}
+	switch strings.ToLower(getenv("COLORTERM")) {
+	case "truecolor", "24bit":
+		return ColorTrue
+	}
+	if getenv("WT_SESSION") != "" {
+		return ColorTrue
+	}
+	if strings.Contains(term, "256color") {
+		return Color256
+	}
+	return Color16
+}
+
+// envUnicode reads the glyph level from TERM and the locale: TERM=dumb or
+// a non-UTF-8 locale means ASCII, and the Linux virtual console's default
+// font only has the basic box drawing set
+func envUnicode(getenv func(string) string) UnicodeLevel {
+	term := getenv("TERM")
+	if term == "dumb" {
+		return UnicodeASCII
+	}
+	for _, key := range []string{"LC_ALL", "LC_CTYPE", "LANG"} {
+		if v := strings.ToLower(getenv(key)); v != "" {
+			if !strings.Contains(v, "utf-8") && !strings.Contains(v, "utf8") {
+				return UnicodeASCII
+			}
+			break
+		}
+	}
+	if term == "linux" {
+		return UnicodeBoxDrawing
+	}
+	return UnicodeFull
+}
+
+// envVersion parses a numeric version variable such as VTE_VERSION=7600,
+// returning 0 when unset or malformed
+func envVersion(v string) int {
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		return 0
+	}
+	return n
+}
+
+// withTerminfo raises the color level to what a terminfo entry declares
+func (c Capabilities) withTerminfo(info terminfo) Capabilities {
+	level := ColorNone
+	switch {
+	case info.trueColor:
+		level = ColorTrue
+	case info.colors >= 256:
+		level = Color256
+	case info.colors >= 8:
+		level = Color16
+	}
+	if level > c.Colors {
+		c.Colors = level
+	}
+	return c
+}
@@ -0,0 +1,249 @@
+package capabilities
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// env returns a getenv function reading from vars
+func env(vars map[string]string) func(string) string {
+	return func(k string) string { return vars[k] }
+}
+
+func TestFromEnv(t *testing.T) {
+	tests := []struct {
+		name string
+		vars map[string]string
+		want Capabilities
+	}{
+		{
+			name: "basic xterm",
+			vars: map[string]string{"TERM": "xterm", "LANG": "en_US.UTF-8"},
+			want: Capabilities{Colors: Color16, Unicode: UnicodeFull},
+		},
+		{
+			name: "dumb",
+			vars: map[string]string{"TERM": "dumb", "COLORTERM": "truecolor"},
+			want: Capabilities{Colors: ColorNone, Unicode: UnicodeASCII},
+		},
+		{
+			name: "256 colors in a latin-1 locale",
+			vars: map[string]string{"TERM": "xterm-256color", "LC_ALL": "en_US.ISO-8859-1", "LANG": "en_US.UTF-8"},
+			want: Capabilities{Colors: Color256, Unicode: UnicodeASCII},
+		},
+		{
+			name: "linux console",
+			vars: map[string]string{"TERM": "linux"},
+			want: Capabilities{Colors: Color16, Unicode: UnicodeBoxDrawing},
+		},
+		{
+			name: "kitty",
+			vars: map[string]string{"TERM": "xterm-kitty", "COLORTERM": "truecolor"},
+			want: Capabilities{Colors: ColorTrue, Unicode: UnicodeFull, Hyperlinks: true, SynchronizedOutput: true, KittyGraphics: true},
+		},
+		{
+			name: "iterm2",
+			vars: map[string]string{"TERM": "xterm-256color", "TERM_PROGRAM": "iTerm.app", "COLORTERM": "truecolor"},
+			want: Capabilities{Colors: ColorTrue, Unicode: UnicodeFull, Hyperlinks: true, SynchronizedOutput: true, ITerm2Images: true},
+		},
+		{
+			name: "windows terminal",
+			vars: map[string]string{"WT_SESSION": "abc"},
+			want: Capabilities{Colors: ColorTrue, Unicode: UnicodeFull, Hyperlinks: true, SynchronizedOutput: true},
+		},
+		{
+			name: "gnome terminal",
+			vars: map[string]string{"TERM": "xterm-256color", "VTE_VERSION": "7600"},
+			want: Capabilities{Colors: Color256, Unicode: UnicodeFull, Hyperlinks: true},
+		},
+		{
+			name: "nerd font",
+			vars: map[string]string{"TERM": "xterm", "NERD_FONT": "1"},
+			want: Capabilities{Colors: Color16, Unicode: UnicodeFull, NerdFont: true},
+		},
+		{
+			name: "kitty inside tmux",
+			vars: map[string]string{"TERM": "tmux-256color", "TMUX": "/tmp/tmux", "KITTY_WINDOW_ID": "1"},
+			want: Capabilities{Colors: Color256, Unicode: UnicodeFull, Hyperlinks: true, Multiplexer: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, FromEnv(env(tt.vars)))
+		})
+	}
+}
+
+func TestLevelStrings(t *testing.T) {
+	require.Equal(t, "truecolor", ColorTrue.String())
+	require.Equal(t, "none", ColorNone.String())
+	require.Equal(t, "unknown", ColorLevel(9).String())
+	require.Equal(t, "box-drawing", UnicodeBoxDrawing.String())
+	require.Equal(t, "unknown", UnicodeLevel(-1).String())
+}
+
+// compileTerminfo builds a compiled terminfo entry with the given
+// max_colors (omitted when negative) and extended boolean names
+func compileTerminfo(magic, colors int, extBools ...string) []byte {
+	var b bytes.Buffer
+	numSize := 2
+	if magic == terminfoMagic32 {
+		numSize = 4
+	}
+	put := func(size int, v int) {
+		if size == 4 {
+			binary.Write(&b, binary.LittleEndian, int32(v))
+		} else {
+			binary.Write(&b, binary.LittleEndian, int16(v))
+		}
+	}
+
+	names := "test|test terminal\x00"
+	numCount := 0
+	if colors >= 0 {
+		numCount = maxColorsIndex + 1
+	}
+	for _, v := range []int{magic, len(names), 1, numCount, 1, 2} {
+		put(2, v)
+	}
+	b.WriteString(names)
+	b.WriteByte(1) // one standard boolean
+	if b.Len()%2 == 1 {
+		b.WriteByte(0)
+	}
+	for i := 0; i < numCount; i++ {
+		if i == maxColorsIndex {
+			put(numSize, colors)
+		} else {
+			put(numSize, -1)
+		}
+	}
+	put(2, 0)              // one string offset
+	b.WriteString("x\x00") // string table
+
+	if len(extBools) == 0 {
+		return b.Bytes()
+	}
+	table := strings.Join(extBools, "\x00") + "\x00"
+	for _, v := range []int{len(extBools), 0, 0, len(extBools), len(table)} {
+		put(2, v)
+	}
+	for range extBools {
+		b.WriteByte(1)
+	}
+	if len(extBools)%2 == 1 {
+		b.WriteByte(0)
+	}
+	offset := 0
+	for _, name := range extBools {
+		put(2, offset)
+		offset += len(name) + 1
+	}
+	b.WriteString(table)
+	return b.Bytes()
+}
+
+func TestParseTerminfo(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+		want terminfo
+	}{
+		{"256 colors", compileTerminfo(terminfoMagic16, 256), terminfo{colors: 256}},
+		{"no colors", compileTerminfo(terminfoMagic16, -1), terminfo{colors: -1}},
+		{"32-bit numbers", compileTerminfo(terminfoMagic32, 0x1000000), terminfo{colors: 0x1000000}},
+		{"Tc extension", compileTerminfo(terminfoMagic16, 256, "AX", "Tc"), terminfo{colors: 256, trueColor: true}},
+		{"RGB extension", compileTerminfo(terminfoMagic32, 256, "RGB"), terminfo{colors: 256, trueColor: true}},
+		{"other extensions", compileTerminfo(terminfoMagic16, 8, "AX", "XT", "Su"), terminfo{colors: 8}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseTerminfo(tt.data)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+
+	_, err := parseTerminfo([]byte{1, 2, 3})
+	require.Error(t, err)
+	_, err = parseTerminfo([]byte{0x2a, 0x01, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0})
+	require.Error(t, err, "bad magic")
+	_, err = parseTerminfo(compileTerminfo(terminfoMagic16, 256)[:20])
+	require.Error(t, err, "truncated")
+}
+
+func TestLoadTerminfo(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "7a"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "7a", "zterm"), compileTerminfo(terminfoMagic16, 256, "Tc"), 0o644))
+
+	getenv := env(map[string]string{"TERMINFO": dir})
+	info, ok := loadTerminfo("zterm", getenv)
+	require.True(t, ok)
+	require.Equal(t, terminfo{colors: 256, trueColor: true}, info)
+
+	_, ok = loadTerminfo("missing", getenv)
+	require.False(t, ok)
+	_, ok = loadTerminfo("../zterm", getenv)
+	require.False(t, ok)
+}
+
+func TestWithTerminfo(t *testing.T) {
+	c := Capabilities{Colors: Color16}
+	require.Equal(t, ColorTrue, c.withTerminfo(terminfo{colors: 256, trueColor: true}).Colors)
+	require.Equal(t, Color256, c.withTerminfo(terminfo{colors: 256}).Colors)
+	require.Equal(t, Color16, c.withTerminfo(terminfo{colors: -1}).Colors, "never lowers")
+}
+
+// fakeTTY records queries and answers with a canned reply
+type fakeTTY struct {
+	io.Reader
+	written bytes.Buffer
+}
+
+func (f *fakeTTY) Write(p []byte) (int, error) { return f.written.Write(p) }
+
+func TestQuery(t *testing.T) {
+	tty := &fakeTTY{Reader: strings.NewReader("\x1b[?2026;2$y\x1b_Gi=31;OK\x1b\\\x1b[?62;22c")}
+
+	got, err := Capabilities{Colors: Color256}.Query(tty, time.Second)
+	require.NoError(t, err)
+	require.Equal(t, Capabilities{Colors: Color256, SynchronizedOutput: true, KittyGraphics: true}, got)
+	require.Equal(t, querySyncOutput+queryKitty+queryDeviceAttr, tty.written.String())
+}
+
+func TestQuery_Unsupported(t *testing.T) {
+	tty := &fakeTTY{Reader: strings.NewReader("\x1b[?2026;0$y\x1b[?1;2c")}
+
+	got, err := Capabilities{SynchronizedOutput: true, KittyGraphics: true}.Query(tty, time.Second)
+	require.NoError(t, err)
+	require.Equal(t, Capabilities{}, got)
+}
+
+func TestQuery_Timeout(t *testing.T) {
+	r, w := io.Pipe()
+	defer w.Close()
+	tty := &fakeTTY{Reader: r}
+
+	c := Capabilities{Colors: ColorTrue}
+	got, err := c.Query(tty, 10*time.Millisecond)
+	require.ErrorIs(t, err, ErrQueryTimeout)
+	require.Equal(t, c, got)
+}
+
+func TestHasDeviceAttrReply(t *testing.T) {
+	require.True(t, hasDeviceAttrReply("\x1b[?62;22c"))
+	require.True(t, hasDeviceAttrReply("\x1b[?2026;2$y\x1b[?1;2c"))
+	require.False(t, hasDeviceAttrReply("\x1b[?2026;2$y"))
+	require.False(t, hasDeviceAttrReply("\x1b[?62;2"))
+}
@@ -0,0 +1,97 @@
+package capabilities
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"time"
+)
+
+// Feature queries sent by Query. The primary device attributes request
+// goes last: every terminal answers it, so its reply marks the end of the
+// answers to the others.
+const (
+	querySyncOutput = "\x1b[?2026$p"
+	queryKitty      = "\x1b_Gi=31,s=1,v=1,a=q,t=d,f=24;AAAA\x1b\\"
+	queryDeviceAttr = "\x1b[c"
+)
+
+// ErrQueryTimeout is returned by Query when the terminal does not finish
+// answering in time.
+var ErrQueryTimeout = errors.New("capabilities: terminal did not answer in time")
+
+// Query asks the terminal on rw which of synchronized output and the Kitty
+// graphics protocol it supports, and returns c with those fields set from
+// the answers. Terminals that ignore a query leave the field unsupported.
+//
+// The terminal must be in raw mode so its answers can be read without the
+// user pressing Enter, and nothing else may read from it meanwhile. When
+// no complete answer arrives within timeout, Query returns c unchanged and
+// ErrQueryTimeout; the read blocked on rw is left running and consumes the
+// late answer.
+//
+// Example:
+//
+//	state, _ := xterm.MakeRaw(fd)
+//	caps, err := capabilities.Detect().Query(tty, 100*time.Millisecond)
+//	xterm.Restore(fd, state)
+func (c Capabilities) Query(rw io.ReadWriter, timeout time.Duration) (Capabilities, error) {
+	if _, err := io.WriteString(rw, querySyncOutput+queryKitty+queryDeviceAttr); err != nil {
+		return c, err
+	}
+
+	replies := make(chan string, 1)
+	go func() {
+		replies <- readReplies(rw)
+	}()
+
+	select {
+	case answer := <-replies:
+		return c.withReplies(answer), nil
+	case <-time.After(timeout):
+		return c, ErrQueryTimeout
+	}
+}
+
+// readReplies reads until the device attributes reply (ESC [ ? ... c) or
+// the end of input
+func readReplies(r io.Reader) string {
+	var b strings.Builder
+	buf := make([]byte, 256)
+	for {
+		n, err := r.Read(buf)
+		b.Write(buf[:n])
+		if hasDeviceAttrReply(b.String()) || err != nil {
+			return b.String()
+		}
+	}
+}
+
+// hasDeviceAttrReply reports whether s contains a complete primary device
+// attributes reply
+func hasDeviceAttrReply(s string) bool {
+	i := strings.Index(s, "\x1b[?")
+	for i >= 0 {
+		rest := s[i+3:]
+		end := strings.IndexFunc(rest, func(r rune) bool { return (r < '0' || r > '9') && r != ';' })
+		if end >= 0 && rest[end] == 'c' {
+			return true
+		}
+		next := strings.Index(rest, "\x1b[?")
+		if next < 0 {
+			return false
+		}
+		i += 3 + next
+	}
+	return false
+}
+
+// withReplies sets the queried fields from the terminal's answers: a DECRPM
+// report for mode 2026 with status 1 (set) or 2 (reset) means synchronized
+// output is recognized, and an OK for image id 31 means Kitty graphics work
+func (c Capabilities) withReplies(answer string) Capabilities {
+	c.SynchronizedOutput = strings.Contains(answer, "\x1b[?2026;1$y") ||
+		strings.Contains(answer, "\x1b[?2026;2$y")
+	c.KittyGraphics = strings.Contains(answer, "\x1b_Gi=31;OK")
+	return c
+}
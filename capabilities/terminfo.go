@@ -0,0 +1,190 @@
+package capabilities
+
+import (
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// terminfo holds the entries of a compiled terminfo description that
+// capability detection uses
+type terminfo struct {
+	colors    int  // max_colors, or -1 when absent
+	trueColor bool // The Tc or RGB extended capability is present
+}
+
+// Magic numbers of the compiled terminfo formats: the legacy format stores
+// numbers in 16 bits, the extended-number format in 32 bits
+const (
+	terminfoMagic16 = 0o432
+	terminfoMagic32 = 0o1036
+)
+
+// maxColorsIndex is the position of max_colors among the standard numeric
+// capabilities
+const maxColorsIndex = 13
+
+// loadTerminfo finds and parses the compiled terminfo entry for term,
+// searching the directories ncurses searches
+func loadTerminfo(term string, getenv func(string) string) (terminfo, bool) {
+	if term == "" || strings.ContainsAny(term, `/\`) {
+		return terminfo{}, false
+	}
+
+	for _, dir := range terminfoDirs(getenv) {
+		for _, sub := range []string{term[:1], fmt.Sprintf("%x", term[0])} {
+			data, err := os.ReadFile(filepath.Join(dir, sub, term))
+			if err != nil {
+				continue
+			}
+			if info, err := parseTerminfo(data); err == nil {
+				return info, true
+			}
+		}
+	}
+	return terminfo{}, false
+}
+
+// terminfoDirs lists the terminfo search path in order of precedence
+func terminfoDirs(getenv func(string) string) []string {
+	var dirs []string
+	if d := getenv("TERMINFO"); d != "" {
+		dirs = append(dirs, d)
+	}
+	if home := getenv("HOME"); home != "" {
+		dirs = append(dirs, filepath.Join(home, ".terminfo"))
+	}
+	for _, d := range strings.Split(getenv("TERMINFO_DIRS"), ":") {
+		if d != "" {
+			dirs = append(dirs, d)
+		}
+	}
+	return append(dirs, "/etc/terminfo", "/lib/terminfo", "/usr/share/terminfo", "/usr/lib/terminfo")
+}
+
+// parseTerminfo reads max_colors and the true color extensions from a
+// compiled terminfo entry (see term(5))
+func parseTerminfo(data []byte) (terminfo, error) {
+	r := terminfoReader{data: data}
+	header := r.shorts(6)
+	if r.err != nil {
+		return terminfo{}, r.err
+	}
+
+	numSize := 2
+	switch header[0] {
+	case terminfoMagic16:
+	case terminfoMagic32:
+		numSize = 4
+	default:
+		return terminfo{}, fmt.Errorf("terminfo: bad magic %#o", header[0])
+	}
+	namesSize, boolCount, numCount, strCount, strTableSize := header[1], header[2], header[3], header[4], header[5]
+
+	r.skip(namesSize + boolCount)
+	r.align()
+	nums := r.numbers(numCount, numSize)
+	r.skip(strCount*2 + strTableSize)
+	if r.err != nil {
+		return terminfo{}, r.err
+	}
+
+	info := terminfo{colors: -1}
+	if maxColorsIndex < len(nums) && nums[maxColorsIndex] >= 0 {
+		info.colors = nums[maxColorsIndex]
+	}
+
+	// The extended section is optional
+	r.align()
+	if r.pos >= len(r.data) {
+		return info, nil
+	}
+	ext := r.shorts(5)
+	if r.err != nil {
+		return info, nil //nolint:nilerr // a damaged extended section leaves the standard entries usable
+	}
+	extBools, extNums, extStrs, tableSize := ext[0], ext[1], ext[2], ext[4]
+
+	boolValues := r.bytes(extBools)
+	r.align()
+	r.skip(extNums*numSize + extStrs*2 + (extBools+extNums+extStrs)*2)
+	table := r.bytes(tableSize)
+	if r.err != nil {
+		return info, nil //nolint:nilerr // as above
+	}
+
+	// The table holds the string values followed by the capability names
+	// (booleans, then numbers, then strings), each NUL-terminated
+	tokens := strings.Split(strings.TrimSuffix(string(table), "\x00"), "\x00")
+	nameCount := extBools + extNums + extStrs
+	if len(tokens) < nameCount {
+		return info, nil
+	}
+	names := tokens[len(tokens)-nameCount:]
+	for i, name := range names {
+		if name != "Tc" && name != "RGB" {
+			continue
+		}
+		if i >= extBools || boolValues[i] == 1 {
+			info.trueColor = true
+		}
+	}
+	return info, nil
+}
+
+// terminfoReader reads little-endian values, remembering the first error
+type terminfoReader struct {
+	data []byte
+	pos  int
+	err  error
+}
+
+// bytes returns the next n bytes
+func (r *terminfoReader) bytes(n int) []byte {
+	if r.err != nil {
+		return nil
+	}
+	if n < 0 || r.pos+n > len(r.data) {
+		r.err = fmt.Errorf("terminfo: truncated at byte %d", r.pos)
+		return nil
+	}
+	b := r.data[r.pos : r.pos+n]
+	r.pos += n
+	return b
+}
+
+// skip advances past n bytes
+func (r *terminfoReader) skip(n int) {
+	r.bytes(n)
+}
+
+// align advances to an even offset, as sections are aligned to 16 bits
+func (r *terminfoReader) align() {
+	if r.pos%2 == 1 && r.pos < len(r.data) {
+		r.pos++
+	}
+}
+
+// shorts reads n signed 16-bit values
+func (r *terminfoReader) shorts(n int) []int {
+	return r.numbers(n, 2)
+}
+
+// numbers reads n signed values of size bytes each
+func (r *terminfoReader) numbers(n, size int) []int {
+	b := r.bytes(n * size)
+	if b == nil {
+		return nil
+	}
+	values := make([]int, n)
+	for i := range values {
+		if size == 4 {
+			values[i] = int(int32(binary.LittleEndian.Uint32(b[i*4:])))
+		} else {
+			values[i] = int(int16(binary.LittleEndian.Uint16(b[i*2:])))
+		}
+	}
+	return values
+}
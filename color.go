@@ -1,6 +1,7 @@
 package tuistyles
 
 import (
+	"errors"
 	"fmt"
 	"regexp"
 	"strconv"
@@ -14,16 +15,40 @@ type Color string
 
 var hexColorRegex = regexp.MustCompile(`^#([0-9A-Fa-f]{3}|[0-9A-Fa-f]{6})$`)
 
-// NewColor creates a Color with validation
+// Errors returned by NewColor, Color.Validate, and Style.Err. They are
+// wrapped with the offending value; test for them with errors.Is.
+var (
+	// ErrEmptyColor reports an empty color string
+	ErrEmptyColor = errors.New("color cannot be empty")
+	// ErrInvalidHex reports a "#" color that is not #RGB or #RRGGBB
+	ErrInvalidHex = errors.New("invalid hex color")
+	// ErrUnknownName reports a color that is neither hex, a known ANSI name,
+	// nor a number
+	ErrUnknownName = errors.New("unknown color name")
+	// ErrOutOfRange256 reports an ANSI color code outside 0-255
+	ErrOutOfRange256 = errors.New("ANSI color code out of range (0-255)")
+)
+
+// NewColor creates a Color with validation.
+//
+// Accepts hex (#RGB or #RRGGBB, normalized to uppercase #RRGGBB), ANSI names
+// (case-insensitive, normalized to lowercase), and ANSI 256-color codes.
+// Invalid input returns an error wrapping ErrEmptyColor, ErrInvalidHex,
+// ErrUnknownName, or ErrOutOfRange256.
+//
+// Example:
+//
+//	c, err := NewColor("#F00") // "#FF0000"
+//	if errors.Is(err, ErrInvalidHex) { ... }
 func NewColor(s string) (Color, error) {
 	if s == "" {
-		return "", fmt.Errorf("color cannot be empty")
+		return "", ErrEmptyColor
 	}
 
 	// Validate hex (#RRGGBB or #RGB)
 	if strings.HasPrefix(s, "#") {
 		if !hexColorRegex.MatchString(s) {
-			return "", fmt.Errorf("invalid hex color: %s", s)
+			return "", fmt.Errorf("%w: %s", ErrInvalidHex, s)
 		}
 		// Normalize to uppercase and expand 3-digit to 6-digit
 		normalized := normalizeHex(s)
@@ -38,12 +63,37 @@ func NewColor(s string) (Color, error) {
 	// Validate ANSI 256-color code
 	if code, err := strconv.Atoi(s); err == nil {
 		if code < 0 || code > 255 {
-			return "", fmt.Errorf("ANSI color code out of range (0-255): %d", code)
+			return "", fmt.Errorf("%w: %d", ErrOutOfRange256, code)
 		}
 		return Color(s), nil
 	}
 
-	return "", fmt.Errorf("invalid color: %s (must be hex, ANSI name, or ANSI code 0-255)", s)
+	return "", fmt.Errorf("%w: %s (must be hex, ANSI name, or ANSI code 0-255)", ErrUnknownName, s)
+}
+
+// MustColor is like NewColor but panics on invalid input. It is meant for
+// package-level constants and theme definitions whose values are known to
+// be valid.
+//
+// Example:
+//
+//	var accent = MustColor("#7D56F4")
+func MustColor(s string) Color {
+	c, err := NewColor(s)
+	if err != nil {
+		panic(fmt.Sprintf("tuistyles: MustColor(%q): %v", s, err))
+	}
+	return c
+}
+
+// Validate reports whether c is a color NewColor would accept.
+//
+// A Color converted directly from a string (Color("#GGG")) is not checked
+// when it is created; invalid colors render as no color at all. Validate
+// returns the error NewColor would have returned, or nil.
+func (c Color) Validate() error {
+	_, err := NewColor(string(c))
+	return err
 }
 
 // ToANSI converts Color to ANSI foreground escape sequence
@@ -88,9 +138,10 @@ func NewAdaptiveColor(light, dark string) (AdaptiveColor, error) {
 }
 
 // ToColor returns the appropriate color for the current terminal background
+// (see HasDarkBackground)
 func (ac AdaptiveColor) ToColor() Color {
-	if ansi.IsLightTerminal() {
-		return ac.Light
+	if HasDarkBackground() {
+		return ac.Dark
 	}
-	return ac.Dark
+	return ac.Light
 }
@@ -1,6 +1,7 @@
 package tuistyles
 
 import (
+	"errors"
 	"testing"
 )
 
@@ -244,3 +245,66 @@ func TestAdaptiveColorToColor(t *testing.T) {
 		}
 	})
 }
+
+func TestNewColor_Errors(t *testing.T) {
+	tests := []struct {
+		input string
+		want  error
+	}{
+		{"", ErrEmptyColor},
+		{"#GGG", ErrInvalidHex},
+		{"#FF", ErrInvalidHex},
+		{"notacolor", ErrUnknownName},
+		{"FF0000", ErrUnknownName},
+		{"256", ErrOutOfRange256},
+		{"-1", ErrOutOfRange256},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			_, err := NewColor(tt.input)
+			if !errors.Is(err, tt.want) {
+				t.Errorf("NewColor(%q) error = %v, want %v", tt.input, err, tt.want)
+			}
+			if verr := Color(tt.input).Validate(); !errors.Is(verr, tt.want) {
+				t.Errorf("Color(%q).Validate() = %v, want %v", tt.input, verr, tt.want)
+			}
+		})
+	}
+
+	if err := Color("#FF0000").Validate(); err != nil {
+		t.Errorf("Validate() on a valid color = %v", err)
+	}
+}
+
+func TestMustColor(t *testing.T) {
+	if got := MustColor("#f00"); got != "#FF0000" {
+		t.Errorf("MustColor(#f00) = %q, want #FF0000", got)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("MustColor(#GGG) did not panic")
+		}
+	}()
+	MustColor("#GGG")
+}
+
+func TestStyle_Err(t *testing.T) {
+	if err := NewStyle().Foreground(Color("red")).Border(NormalBorder()).Err(); err != nil {
+		t.Errorf("Err() on valid style = %v", err)
+	}
+
+	s := NewStyle().
+		Foreground(Color("#GGG")).
+		Background(Color("blue")).
+		BorderLeftForeground(Color("999"))
+	err := s.Err()
+	if !errors.Is(err, ErrInvalidHex) || !errors.Is(err, ErrOutOfRange256) {
+		t.Fatalf("Err() = %v, want ErrInvalidHex and ErrOutOfRange256", err)
+	}
+	want := "Foreground: invalid hex color: #GGG\nBorderLeftForeground: ANSI color code out of range (0-255): 999"
+	if err.Error() != want {
+		t.Errorf("Err() =\n%s\nwant\n%s", err, want)
+	}
+}
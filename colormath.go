@@ -0,0 +1,170 @@
+package tuistyles
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/orchard9/tui-styles/internal/ansi"
+	"github.com/orchard9/tui-styles/internal/colorspace"
+)
+
+// RGB returns the red, green, and blue components of the color.
+//
+// Hex colors are exact. ANSI names and 256-color codes resolve through the
+// xterm default palette, which approximates what most terminals display.
+// ok is false if the color cannot be parsed.
+//
+// Example:
+//
+//	r, g, b, _ := Color("#FF8000").RGB() // 255, 128, 0
+func (c Color) RGB() (r, g, b uint8, ok bool) {
+	ri, gi, bi, ok := ansi.ColorToRGB(string(c))
+	if !ok {
+		return 0, 0, 0, false
+	}
+	return uint8(ri), uint8(gi), uint8(bi), true //nolint:gosec // G115: palette values are 0-255
+}
+
+// Luminance returns the WCAG relative luminance of the color, from 0 (black)
+// to 1 (white). Unparseable colors are treated as black.
+func (c Color) Luminance() float64 {
+	r, g, b, _ := c.RGB()
+	return relativeLuminance(colorspace.SRGBToLinear(r), colorspace.SRGBToLinear(g), colorspace.SRGBToLinear(b))
+}
+
+// NewRGB returns the hex Color with the given 8-bit red, green, and blue
+// components.
+//
+// Example:
+//
+//	NewRGB(255, 128, 0) // "#FF8000"
+func NewRGB(r, g, b uint8) Color {
+	return hexFromRGB(r, g, b)
+}
+
+// NewHSL returns the hex Color for hue h in degrees and saturation s and
+// lightness l from 0 to 1.
+//
+// Hue wraps around, so -30 and 330 are the same; saturation and lightness
+// are clamped. Stepping the hue gives evenly spaced palettes.
+//
+// Example:
+//
+//	NewHSL(120, 1, 0.5) // "#00FF00"
+//
+//	for i := range 6 {
+//		palette = append(palette, NewHSL(float64(i)*60, 0.7, 0.6))
+//	}
+func NewHSL(h, s, l float64) Color {
+	return hexFromUnit(colorspace.HSLToRGB(h, s, l))
+}
+
+// NewHSV returns the hex Color for hue h in degrees and saturation s and
+// value v from 0 to 1. Hue wraps around; saturation and value are clamped.
+//
+// Example:
+//
+//	NewHSV(210, 0.5, 1) // "#80BFFF"
+func NewHSV(h, s, v float64) Color {
+	return hexFromUnit(colorspace.HSVToRGB(h, s, v))
+}
+
+// HSL returns the color's hue in degrees (0-360) and saturation and
+// lightness (0-1). Grays have hue and saturation 0. ok is false if the color
+// cannot be parsed (see RGB).
+//
+// Example:
+//
+//	h, s, l, _ := Color("#FF0000").HSL() // 0, 1, 0.5
+//	lighter := NewHSL(h, s, l+0.2)
+func (c Color) HSL() (h, s, l float64, ok bool) {
+	r, g, b, ok := c.RGB()
+	if !ok {
+		return 0, 0, 0, false
+	}
+	h, s, l = colorspace.RGBToHSL(float64(r)/255, float64(g)/255, float64(b)/255)
+	return h, s, l, true
+}
+
+// HSV returns the color's hue in degrees (0-360) and saturation and value
+// (0-1). Grays have hue and saturation 0. ok is false if the color cannot be
+// parsed (see RGB).
+func (c Color) HSV() (h, s, v float64, ok bool) {
+	r, g, b, ok := c.RGB()
+	if !ok {
+		return 0, 0, 0, false
+	}
+	h, s, v = colorspace.RGBToHSV(float64(r)/255, float64(g)/255, float64(b)/255)
+	return h, s, v, true
+}
+
+// hexFromUnit formats sRGB channels in the range 0-1 as a #RRGGBB Color
+func hexFromUnit(r, g, b float64) Color {
+	return hexFromRGB(unitToByte(r), unitToByte(g), unitToByte(b))
+}
+
+// unitToByte scales a channel from 0-1 to 0-255, rounding to nearest
+func unitToByte(v float64) uint8 {
+	return uint8(math.Round(math.Max(0, math.Min(1, v)) * 255))
+}
+
+// hexFromRGB formats 8-bit channels as a normalized #RRGGBB Color
+func hexFromRGB(r, g, b uint8) Color {
+	return Color(fmt.Sprintf("#%02X%02X%02X", r, g, b))
+}
+
+// relativeLuminance computes WCAG relative luminance from linear RGB
+func relativeLuminance(r, g, b float64) float64 {
+	return 0.2126*r + 0.7152*g + 0.0722*b
+}
+
+// colorDistance returns the CIE76 distance between two colors (unparseable colors act as black)
+func colorDistance(a, b Color) float64 {
+	return a.lab().Distance(b.lab())
+}
+
+// lab converts the color to CIE L*a*b* (unparseable colors act as black)
+func (c Color) lab() colorspace.Lab {
+	r, g, b, _ := c.RGB()
+	return colorspace.RGBToLab(r, g, b)
+}
+
+// Gradient returns n colors blending evenly through stops, first to last.
+//
+// Blending happens in linear RGB, which keeps midpoints from going muddy.
+// A single stop yields n copies of it; no stops or n < 1 yields nil.
+// Unparseable stops act as black.
+//
+// Example:
+//
+//	Gradient(3, Color("#FF0000"), Color("#0000FF")) // red, purple, blue
+func Gradient(n int, stops ...Color) []Color {
+	if n < 1 || len(stops) == 0 {
+		return nil
+	}
+
+	colors := make([]Color, n)
+	for i := range colors {
+		if n == 1 || len(stops) == 1 {
+			colors[i] = stops[0]
+			continue
+		}
+
+		pos := float64(i) / float64(n-1) * float64(len(stops)-1)
+		seg := min(int(pos), len(stops)-2)
+		colors[i] = blendLinear(stops[seg], stops[seg+1], pos-float64(seg))
+	}
+	return colors
+}
+
+// blendLinear mixes a toward b by t (0..1) in linear RGB
+func blendLinear(a, b Color, t float64) Color {
+	ar, ag, ab, _ := a.RGB()
+	br, bg, bb, _ := b.RGB()
+
+	mix := func(x, y uint8) uint8 {
+		lx, ly := colorspace.SRGBToLinear(x), colorspace.SRGBToLinear(y)
+		return colorspace.LinearToSRGB(lx + (ly-lx)*t)
+	}
+	return hexFromRGB(mix(ar, br), mix(ag, bg), mix(ab, bb))
+}
@@ -0,0 +1,89 @@
+package tuistyles
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGradient(t *testing.T) {
+	tests := []struct {
+		name  string
+		n     int
+		stops []Color
+		want  []Color
+	}{
+		{"no stops", 3, nil, nil},
+		{"zero length", 0, []Color{"#FF0000"}, nil},
+		{"single stop", 2, []Color{"#FF0000"}, []Color{"#FF0000", "#FF0000"}},
+		{"single color", 1, []Color{"#FF0000", "#0000FF"}, []Color{"#FF0000"}},
+		{"endpoints", 2, []Color{"#FF0000", "#0000FF"}, []Color{"#FF0000", "#0000FF"}},
+		{"linear midpoint", 3, []Color{"#000000", "#FFFFFF"}, []Color{"#000000", "#BCBCBC", "#FFFFFF"}},
+		{"through stops", 3, []Color{"#FF0000", "#00FF00", "#0000FF"}, []Color{"#FF0000", "#00FF00", "#0000FF"}},
+		{"names resolve", 2, []Color{"red", "#FFFFFF"}, []Color{"#CD0000", "#FFFFFF"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, Gradient(tt.n, tt.stops...))
+		})
+	}
+}
+
+func TestNewRGB(t *testing.T) {
+	require.Equal(t, Color("#FF8000"), NewRGB(255, 128, 0))
+	require.Equal(t, Color("#000000"), NewRGB(0, 0, 0))
+}
+
+func TestNewHSL(t *testing.T) {
+	tests := []struct {
+		h, s, l float64
+		want    Color
+	}{
+		{0, 1, 0.5, "#FF0000"},
+		{120, 1, 0.5, "#00FF00"},
+		{240, 1, 0.5, "#0000FF"},
+		{360, 1, 0.5, "#FF0000"},
+		{-120, 1, 0.5, "#0000FF"},
+		{0, 0, 0.5, "#808080"},
+		{30, 1, 2, "#FFFFFF"},
+		{200, 0.5, 0.4, "#337799"},
+	}
+
+	for _, tt := range tests {
+		require.Equal(t, tt.want, NewHSL(tt.h, tt.s, tt.l), "NewHSL(%v, %v, %v)", tt.h, tt.s, tt.l)
+	}
+}
+
+func TestNewHSV(t *testing.T) {
+	require.Equal(t, Color("#80BFFF"), NewHSV(210, 0.5, 1))
+	require.Equal(t, Color("#FFFF00"), NewHSV(60, 1, 1))
+	require.Equal(t, Color("#000000"), NewHSV(60, 1, 0))
+}
+
+func TestColor_HSLAndHSV(t *testing.T) {
+	h, s, l, ok := Color("#FF0000").HSL()
+	require.True(t, ok)
+	require.InDelta(t, 0, h, 1e-9)
+	require.InDelta(t, 1, s, 1e-9)
+	require.InDelta(t, 0.5, l, 1e-9)
+
+	h, s, v, ok := Color("#80BFFF").HSV()
+	require.True(t, ok)
+	require.InDelta(t, 210, h, 0.5)
+	require.InDelta(t, 0.5, s, 0.01)
+	require.InDelta(t, 1, v, 1e-9)
+
+	_, _, _, ok = Color("nope").HSL()
+	require.False(t, ok)
+	_, _, _, ok = Color("nope").HSV()
+	require.False(t, ok)
+
+	// Round trip through HSL keeps hex colors exact
+	for _, c := range []Color{"#7D56F4", "#123456", "#FEDCBA", "#808080"} {
+		h, s, l, _ := c.HSL()
+		require.Equal(t, c, NewHSL(h, s, l))
+		h, s, v, _ := c.HSV()
+		require.Equal(t, c, NewHSV(h, s, v))
+	}
+}
@@ -53,3 +53,22 @@ func (s Style) SetString(colorStr string) (Style, error) {
 	}
 	return s.Foreground(c), nil
 }
+
+// AutoForeground enables automatic foreground selection.
+//
+// When enabled and no explicit foreground is set, the text color is chosen
+// from the background's luminance (see Color.ContrastingText), so themes
+// cannot produce unreadable combinations like yellow-on-white. An explicit
+// Foreground always takes precedence.
+//
+// Returns a new Style with autoForeground set to v, leaving the original unchanged.
+//
+// Example:
+//
+//	s := NewStyle().Background(Color("#FFFF00")).AutoForeground(true)
+//	fmt.Println(s.Render("Readable")) // black text on yellow
+func (s Style) AutoForeground(v bool) Style {
+	s2 := s
+	s2.autoForeground = &v
+	return s2
+}
@@ -0,0 +1,74 @@
+package tuistyles
+
+import (
+	"strings"
+
+	"github.com/orchard9/tui-styles/internal/ansi"
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+// Columns flows text into n newspaper-style columns that fit in width
+// cells, separated by gap spaces.
+//
+// The text is word-wrapped to the column width, then split into columns of
+// equal height (the last may be shorter), reading top to bottom and then
+// left to right. Escape sequences are carried along as in Wrap, and styling
+// that continues from one line to the next is re-opened on the next line
+// and closed at the end of each line, so it never spills into the gap or
+// a neighboring column.
+//
+// Every line is padded to the full block width: n column widths plus the
+// gaps, which is width or up to n-1 cells less when width does not divide
+// evenly. Columns are at least one cell wide. Empty text renders as "".
+//
+// Example:
+//
+//	fmt.Println(Columns(changelog, 3, 4, 120))
+func Columns(text string, n, gap, width int) string {
+	if text == "" {
+		return ""
+	}
+	n = max(n, 1)
+	gap = max(gap, 0)
+	colWidth := max((width-gap*(n-1))/n, 1)
+
+	lines := closeLineStyles(strings.Split(Wrap(text, colWidth), "\n"))
+	height := (len(lines) + n - 1) / n
+
+	// Fewer lines than columns leaves the trailing columns empty
+	separator := strings.Repeat(" ", gap)
+	rows := make([]string, height)
+	for row := range rows {
+		var b strings.Builder
+		for col := 0; col < n; col++ {
+			if col > 0 {
+				b.WriteString(separator)
+			}
+			line := ""
+			if i := col*height + row; i < len(lines) {
+				line = lines[i]
+			}
+			b.WriteString(line)
+			b.WriteString(strings.Repeat(" ", max(colWidth-measure.Width(line), 0)))
+		}
+		rows[row] = b.String()
+	}
+	return strings.Join(rows, "\n")
+}
+
+// closeLineStyles makes each line self-contained: styling still open from
+// earlier lines is re-opened at its start, and styling open at its end is
+// reset there
+func closeLineStyles(lines []string) []string {
+	out := make([]string, len(lines))
+	open := ""
+	for i, line := range lines {
+		line = open + line
+		open = measure.OpenSGR(line)
+		if open != "" {
+			line += ansi.Reset()
+		}
+		out[i] = line
+	}
+	return out
+}
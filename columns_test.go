@@ -0,0 +1,57 @@
+package tuistyles
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+func TestColumns(t *testing.T) {
+	text := "one two three four five six seven eight nine ten"
+
+	got := Columns(text, 2, 3, 31)
+	require.Equal(t, strings.Join([]string{
+		"one two three    seven eight   ",
+		"four five six    nine ten      ",
+	}, "\n"), got)
+}
+
+func TestColumns_Balanced(t *testing.T) {
+	text := "a\nb\nc\nd\ne\nf\ng"
+
+	got := Columns(text, 3, 1, 5)
+	require.Equal(t, strings.Join([]string{
+		"a d g",
+		"b e  ",
+		"c f  ",
+	}, "\n"), got)
+}
+
+func TestColumns_FewerLinesThanColumns(t *testing.T) {
+	require.Equal(t, "ab      ", Columns("ab", 3, 1, 8))
+}
+
+func TestColumns_ClosesStylesPerLine(t *testing.T) {
+	red := NewStyle().Foreground(Color("red")).Render("aaa bbb ccc ddd")
+
+	got := Columns(red, 2, 2, 16)
+	for i, line := range strings.Split(got, "\n") {
+		require.Empty(t, measure.OpenSGR(line), "line %d leaves styling open: %q", i, line)
+		require.Equal(t, 16, measure.Width(line), "line %d", i)
+	}
+	require.Equal(t, "aaa bbb  ccc ddd", measure.StripANSI(got))
+}
+
+func TestColumns_Edges(t *testing.T) {
+	require.Equal(t, "", Columns("", 3, 1, 40))
+	require.Equal(t, "ab\ncd", Columns("ab cd", 0, 1, 2), "n below 1 means one column")
+	require.Equal(t, []int{3, 3}, measure.WidthPerLine(Columns("abc", 2, 1, 1)), "columns stay one cell wide")
+}
+
+func TestCloseLineStyles(t *testing.T) {
+	got := closeLineStyles([]string{"\x1b[1ma", "b", "c\x1b[0m", "d"})
+	require.Equal(t, []string{"\x1b[1ma\x1b[0m", "\x1b[1mb\x1b[0m", "\x1b[1mc\x1b[0m", "d"}, got)
+}
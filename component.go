@@ -0,0 +1,31 @@
+package tuistyles
+
+// Component is a view that renders itself at whatever width its container
+// gives it, so containers can share out the space they have top-down
+// instead of every view hardcoding a Width.
+//
+// A container asks each child for its MinWidth, decides the widths, and
+// then calls Render with them. Render(0) asks a component for its natural
+// width: as wide as its content, with nothing wrapped or truncated.
+//
+// Table, List, Panel, and Grid are components, and a Grid of components is
+// itself one, so layouts nest. A Table can hold components in its cells
+// too (see CellBlock).
+//
+// Example:
+//
+//	grid := Grid{Columns: 2, Gap: 1, Cells: []Component{
+//		Panel{Title: "CPU", Body: cpu},
+//		Panel{Title: "Memory", Body: mem},
+//		Table{Headers: []string{"Host", "Status"}, Rows: hosts},
+//	}}
+//	fmt.Println(grid.Render(termWidth))
+type Component interface {
+	// Render draws the component within width cells; 0 or less means
+	// its natural width
+	Render(width int) string
+
+	// MinWidth returns the narrowest width the component can render at
+	// without overflowing
+	MinWidth() int
+}
@@ -0,0 +1,81 @@
+package tuistyles
+
+import (
+	"sync/atomic"
+
+	"github.com/orchard9/tui-styles/internal/ansi"
+)
+
+// Package-wide settings. Styles, Renderers, and Themes are immutable values
+// and need no locking; these few defaults are the only shared mutable state
+// in the package. Each is stored atomically, so any goroutine may read or
+// change them at any time. A change is seen by renders that start after it;
+// a render already in progress may finish with the old value.
+var (
+	darkBackground  atomic.Pointer[bool]     // nil: detect from the environment
+	defaultTheme    atomic.Pointer[Theme]    // nil: the built-in theme
+	defaultRenderer atomic.Pointer[Renderer] // nil: created on first use
+)
+
+// HasDarkBackground reports whether the terminal background is dark, which
+// decides the color AdaptiveColor picks. Unless SetHasDarkBackground has
+// been called, it is detected from the TERM_BACKGROUND and COLORFGBG
+// environment variables on every call, defaulting to dark.
+func HasDarkBackground() bool {
+	if dark := darkBackground.Load(); dark != nil {
+		return *dark
+	}
+	return !ansi.IsLightTerminal()
+}
+
+// SetHasDarkBackground overrides background detection for the whole
+// package, for example after querying the terminal for its background
+// color. It is safe to call from any goroutine.
+//
+// Example:
+//
+//	tuistyles.SetHasDarkBackground(cfg.Appearance != "light")
+func SetHasDarkBackground(dark bool) {
+	darkBackground.Store(&dark)
+}
+
+// SetDefaultTheme replaces the theme DefaultTheme returns, and with it the
+// colors components fall back to (see Theme.WithDefaults). Colors t leaves
+// empty keep their built-in values, so SetDefaultTheme(Theme{}) restores the
+// built-in theme. It is safe to call from any goroutine.
+//
+// Example:
+//
+//	tuistyles.SetDefaultTheme(Theme{Primary: Color("#7C3AED")})
+func SetDefaultTheme(t Theme) {
+	t = t.fill(builtinTheme())
+	defaultTheme.Store(&t)
+}
+
+// DefaultRenderer returns the package's shared Renderer: the one last given
+// to SetDefaultRenderer, or else one configured from the environment with
+// NewRenderer the first time it is needed. It is safe to call from any
+// goroutine.
+//
+// Example:
+//
+//	fmt.Println(tuistyles.DefaultRenderer().Render(style, "Hello"))
+func DefaultRenderer() Renderer {
+	if r := defaultRenderer.Load(); r != nil {
+		return *r
+	}
+	r := NewRenderer()
+	defaultRenderer.CompareAndSwap(nil, &r)
+	return *defaultRenderer.Load()
+}
+
+// SetDefaultRenderer replaces the shared Renderer, for example with one
+// that has a different color profile or the new terminal size after a
+// resize. It is safe to call from any goroutine.
+//
+// Example:
+//
+//	tuistyles.SetDefaultRenderer(tuistyles.DefaultRenderer().TerminalSize(w, h))
+func SetDefaultRenderer(r Renderer) {
+	defaultRenderer.Store(&r)
+}
@@ -0,0 +1,74 @@
+package tuistyles
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHasDarkBackground(t *testing.T) {
+	t.Cleanup(func() { darkBackground.Store(nil) })
+	ac := AdaptiveColor{Light: Color("black"), Dark: Color("white")}
+
+	t.Setenv("TERM_BACKGROUND", "light")
+	require.False(t, HasDarkBackground(), "detected from the environment")
+	require.Equal(t, Color("black"), ac.ToColor())
+
+	SetHasDarkBackground(true)
+	require.True(t, HasDarkBackground(), "the override wins over detection")
+	require.Equal(t, Color("white"), ac.ToColor())
+}
+
+func TestSetDefaultTheme(t *testing.T) {
+	t.Cleanup(func() { defaultTheme.Store(nil) })
+	builtin := DefaultTheme()
+
+	SetDefaultTheme(Theme{Primary: Color("#7C3AED")})
+	got := DefaultTheme()
+	require.Equal(t, Color("#7C3AED"), got.Primary)
+	require.Equal(t, builtin.Error, got.Error, "empty colors keep their built-in values")
+	require.Equal(t, Color("#7C3AED"), Theme{}.WithDefaults().Primary, "components fall back to the new default")
+
+	SetDefaultTheme(Theme{})
+	require.Equal(t, builtin, DefaultTheme())
+}
+
+func TestDefaultRenderer(t *testing.T) {
+	t.Cleanup(func() { defaultRenderer.Store(nil) })
+
+	first := DefaultRenderer()
+	require.Equal(t, first.Profile(), DefaultRenderer().Profile(), "created once and reused")
+
+	SetDefaultRenderer(first.ColorProfile(ProfileANSI16).TerminalSize(100, 30))
+	w, h := DefaultRenderer().Size()
+	require.Equal(t, ProfileANSI16, DefaultRenderer().Profile())
+	require.Equal(t, []int{100, 30}, []int{w, h})
+}
+
+func TestConfig_ConcurrentAccess(t *testing.T) {
+	t.Cleanup(func() {
+		darkBackground.Store(nil)
+		defaultTheme.Store(nil)
+		defaultRenderer.Store(nil)
+	})
+
+	// Run with -race: settings change while components render
+	var wg sync.WaitGroup
+	for i := range 8 {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			SetHasDarkBackground(i%2 == 0)
+			SetDefaultTheme(Theme{Primary: Color("red")})
+			SetDefaultRenderer(DefaultRenderer().TerminalSize(80+i, 24))
+		}()
+		go func() {
+			defer wg.Done()
+			_ = HasDarkBackground()
+			_ = List{Items: []string{"a", "b"}}.Render(20)
+			_ = DefaultRenderer().Render(NewStyle().Bold(true), "x")
+		}()
+	}
+	wg.Wait()
+}
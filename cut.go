@@ -0,0 +1,73 @@
+package tuistyles
+
+import (
+	"strings"
+
+	"github.com/orchard9/tui-styles/internal/ansi"
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+// CutANSI returns the cells [from, to) of a styled single-line string,
+// keeping its styling: SGR sequences in effect at from are re-opened at
+// the start of the result, and a reset is appended when styling is still
+// open at the end, so the cut can be placed anywhere without bleeding.
+// This is what viewports and horizontal scrolling need to show a window
+// of pre-rendered lines.
+//
+// A wide character that straddles either boundary cannot be split, so each
+// of its cells inside the range becomes a space, keeping the result exactly
+// to-from cells wide when s reaches to. A negative from counts as 0, and an
+// empty range returns "".
+//
+// Example:
+//
+//	line := NewStyle().Foreground(Color("red")).Render("error: disk full")
+//	fmt.Println(CutANSI(line, 7, 11)) // "disk", still red
+func CutANSI(s string, from, to int) string {
+	from = max(from, 0)
+	if to <= from {
+		return ""
+	}
+
+	var before, b strings.Builder
+	col := 0
+	done := false
+	for _, seg := range measure.Segments(s) {
+		if done {
+			break
+		}
+		if seg.Escape {
+			switch {
+			case col <= from:
+				before.WriteString(seg.Text)
+			case col < to:
+				b.WriteString(seg.Text)
+			}
+			continue
+		}
+
+		measure.EachGrapheme(seg.Text, func(cluster string, width int) bool {
+			start, end := col, col+width
+			col = end
+			switch {
+			case end <= from:
+				return true
+			case start >= to:
+				done = true
+				return false
+			case start >= from && end <= to:
+				b.WriteString(cluster)
+			default:
+				// Wide character straddling a boundary: pad its visible cells
+				b.WriteString(strings.Repeat(" ", min(end, to)-max(start, from)))
+			}
+			return true
+		})
+	}
+
+	cut := measure.OpenSGR(before.String()) + b.String()
+	if measure.OpenSGR(cut) != "" {
+		cut += ansi.Reset()
+	}
+	return cut
+}
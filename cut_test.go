@@ -0,0 +1,48 @@
+package tuistyles
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCutANSI(t *testing.T) {
+	const (
+		red   = "\x1b[31m"
+		bold  = "\x1b[1m"
+		reset = "\x1b[0m"
+	)
+
+	tests := []struct {
+		name     string
+		s        string
+		from, to int
+		want     string
+	}{
+		{"plain", "hello world", 6, 11, "world"},
+		{"empty range", "hello", 3, 3, ""},
+		{"negative from", "hello", -2, 2, "he"},
+		{"past end", "hello", 3, 10, "lo"},
+		{"inside style", red + "hello" + reset, 1, 4, red + "ell" + reset},
+		{"style ends inside", red + "ab" + reset + "cd", 1, 4, red + "b" + reset + "cd"},
+		{"style starts inside", "ab" + red + "cd" + reset, 0, 3, "ab" + red + "c" + reset},
+		{"stacked styles", red + "a" + bold + "bc" + reset, 2, 3, red + bold + "c" + reset},
+		{"closed before cut", red + "ab" + reset + "cd", 2, 4, "cd"},
+		{"wide character at start", "你好世界", 1, 5, " 好 "},
+		{"wide character inside", "a你b", 0, 4, "a你b"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, CutANSI(tt.s, tt.from, tt.to))
+		})
+	}
+}
+
+func TestCutANSI_Width(t *testing.T) {
+	line := NewStyle().Foreground(Color("#ff0000")).Render("日本語 text") + " plain"
+	for from := 0; from < Width(line); from++ {
+		cut := CutANSI(line, from, from+4)
+		require.Equal(t, min(4, Width(line)-from), Width(cut), "from %d", from)
+	}
+}
@@ -0,0 +1,138 @@
+package tuistyles
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/orchard9/tui-styles/internal/ansi"
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+// DebugEnv is the environment variable that turns on debug rendering for
+// renderers created with NewRenderer. Any value strconv.ParseBool accepts as
+// true (such as "1") enables it.
+const DebugEnv = "TUISTYLES_DEBUG"
+
+// debugGuideStyle colors the outline and size label drawn in debug mode
+var debugGuideStyle = NewStyle().Foreground(Color("magenta"))
+
+// debugPaddingStyle colors the markers drawn over padding in debug mode
+var debugPaddingStyle = NewStyle().Foreground(Color("magenta")).Faint(true)
+
+// debugPaddingMarker fills padding cells in debug mode
+const debugPaddingMarker = "·"
+
+// Debug turns on layout guides for everything rendered with the Renderer.
+//
+// In debug mode each box is surrounded by a dashed outline whose top edge is
+// labeled with the box's rendered size (width×height, excluding the
+// outline), and padding cells are filled with dots so padding can be told
+// apart from content and alignment space. The outline adds one cell on every
+// side, so debug output is two columns wider and two lines taller than the
+// box it describes; the label reports the real size.
+//
+// Debug mode is also enabled when TUISTYLES_DEBUG=1 is set (see DebugEnv).
+//
+// Returns a new Renderer, leaving the original unchanged.
+//
+// Example:
+//
+//	r := NewRenderer().Debug(true)
+//	fmt.Println(r.Render(NewStyle().Padding(1, 2).Width(20), "Why so wide?"))
+func (r Renderer) Debug(v bool) Renderer {
+	r2 := r
+	r2.debug = v
+	return r2
+}
+
+// Debugging reports whether the renderer draws layout guides.
+func (r Renderer) Debugging() bool {
+	return r.debug
+}
+
+// renderDebug renders str with s like Style.Render, marking padding cells
+// and outlining the finished box
+func renderDebug(s Style, str string) string {
+	core := s
+	core.paddingTop, core.paddingRight, core.paddingBottom, core.paddingLeft = nil, nil, nil, nil
+	core.borderType = nil
+	core.shadow = nil
+
+	content := core.Render(str)
+	if s.hasPadding() {
+		content = markPadding(s.applyPadding(content),
+			intOrZero(s.paddingTop), intOrZero(s.paddingRight),
+			intOrZero(s.paddingBottom), intOrZero(s.paddingLeft))
+	}
+	if s.hasBorder() {
+		content = s.applyBorder(content)
+	}
+	if s.hasShadow() {
+		content = s.applyShadow(content)
+	}
+	return debugOutline(content)
+}
+
+// markPadding replaces the padding cells of an already padded block with
+// debug markers
+func markPadding(content string, top, right, bottom, left int) string {
+	lines := strings.Split(content, "\n")
+	width := measure.MaxWidth(content)
+	full := debugPaddingStyle.Render(strings.Repeat(debugPaddingMarker, width))
+	leftMarks := debugPaddingStyle.Render(strings.Repeat(debugPaddingMarker, left))
+	rightMarks := debugPaddingStyle.Render(strings.Repeat(debugPaddingMarker, right))
+
+	for i, line := range lines {
+		if i < top || i >= len(lines)-bottom {
+			lines[i] = full
+			continue
+		}
+		_, rest := measure.SplitAt(line, left)
+		inner, _ := measure.SplitAt(rest, width-left-right)
+		if strings.Contains(inner, "\x1b") {
+			inner += ansi.Reset()
+		}
+		lines[i] = leftMarks + inner + rightMarks
+	}
+	return strings.Join(lines, "\n")
+}
+
+// debugOutline draws a dashed outline around content, labeling the top edge
+// with the content's size when it fits
+func debugOutline(content string) string {
+	width, height := Size(content)
+	if content == "" {
+		height = 0
+	}
+
+	top := strings.Repeat("┄", width)
+	if label := fmt.Sprintf("%d×%d", width, height); measure.Width(label) <= width {
+		top = label + strings.Repeat("┄", width-measure.Width(label))
+	}
+
+	var b strings.Builder
+	b.WriteString(debugGuideStyle.Render("┌" + top + "┐"))
+	if content != "" {
+		side := debugGuideStyle.Render("┆")
+		for _, line := range strings.Split(content, "\n") {
+			b.WriteString("\n")
+			b.WriteString(side)
+			b.WriteString(line)
+			if w := measure.Width(line); w < width {
+				b.WriteString(strings.Repeat(" ", width-w))
+			}
+			b.WriteString(side)
+		}
+	}
+	b.WriteString("\n")
+	b.WriteString(debugGuideStyle.Render("└" + strings.Repeat("┄", width) + "┘"))
+	return b.String()
+}
+
+// intOrZero dereferences an optional size, treating unset as 0
+func intOrZero(p *int) int {
+	if p == nil {
+		return 0
+	}
+	return *p
+}
@@ -0,0 +1,95 @@
+package tuistyles
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+func TestRenderer_Debug(t *testing.T) {
+	r := Renderer{}.GlyphSupport(GlyphsFull).Debug(true)
+	require.True(t, r.Debugging())
+	require.False(t, r.Debug(false).Debugging())
+
+	tests := []struct {
+		name  string
+		style Style
+		input string
+		want  []string
+	}{
+		{
+			name:  "plain text",
+			style: NewStyle(),
+			input: "Hi",
+			want: []string{
+				"┌┄┄┐",
+				"┆Hi┆",
+				"└┄┄┘",
+			},
+		},
+		{
+			name:  "padding marked and size labeled",
+			style: NewStyle().Padding(1, 2),
+			input: "Hi",
+			want: []string{
+				"┌6×3┄┄┄┐",
+				"┆······┆",
+				"┆··Hi··┆",
+				"┆······┆",
+				"└┄┄┄┄┄┄┘",
+			},
+		},
+		{
+			name:  "alignment space is not padding",
+			style: NewStyle().Width(6).Align(Center).PaddingLeft(1),
+			input: "ab",
+			want: []string{
+				"┌7×1┄┄┄┄┐",
+				"┆·  ab  ┆",
+				"└┄┄┄┄┄┄┄┘",
+			},
+		},
+		{
+			name:  "border inside outline",
+			style: NewStyle().Border(NormalBorder()).Padding(0, 1),
+			input: "ab",
+			want: []string{
+				"┌6×3┄┄┄┐",
+				"┆┌────┐┆",
+				"┆│·ab·│┆",
+				"┆└────┘┆",
+				"└┄┄┄┄┄┄┘",
+			},
+		},
+		{
+			name:  "empty",
+			style: NewStyle(),
+			input: "",
+			want: []string{
+				"┌┐",
+				"└┘",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := measure.StripANSI(r.Render(tt.style, tt.input))
+			require.Equal(t, strings.Join(tt.want, "\n"), got)
+		})
+	}
+}
+
+func TestRenderer_DebugOff(t *testing.T) {
+	s := NewStyle().Padding(1).Border(NormalBorder())
+	require.Equal(t, s.Render("x"), Renderer{}.GlyphSupport(GlyphsFull).Render(s, "x"))
+}
+
+func TestRenderer_DebugAdaptsBorders(t *testing.T) {
+	r := Renderer{}.GlyphSupport(GlyphsASCII).Debug(true)
+	got := measure.StripANSI(r.Render(NewStyle().Border(RoundedBorder()), "x"))
+	require.Contains(t, got, "+-+")
+}
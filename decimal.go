@@ -0,0 +1,87 @@
+package tuistyles
+
+import (
+	"strings"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+// defaultDecimalSeparator is the separator Decimal alignment uses when none
+// is set
+const defaultDecimalSeparator = "."
+
+// DecimalSeparator sets the separator that Align(Decimal) lines up, such as
+// "," for European number formats. The default is ".".
+//
+// Returns a new Style with decimalSeparator set, leaving the original
+// unchanged.
+//
+// Example:
+//
+//	s := NewStyle().Width(12).Align(Decimal).DecimalSeparator(",")
+//	fmt.Println(s.Render("1,5\n12,25\n300"))
+func (s Style) DecimalSeparator(sep string) Style {
+	s2 := s
+	s2.decimalSeparator = &sep
+	return s2
+}
+
+// AlignDecimal pads values so their decimal separators (sep, or "." when
+// sep is empty) fall in the same column and every result has the same
+// width: integer parts are padded on the left and fractional parts on the
+// right. A value without the separator lines up as a whole number. Escape
+// sequences do not count toward the width, so styled values align too.
+//
+// Use it for number columns built outside Style.Render; a style with
+// Align(Decimal) does the same for the lines it renders.
+//
+// Example:
+//
+//	AlignDecimal([]string{"3.14159", "42", "-0.5"}, "")
+//	// ["  3.14159", " 42     ", " -0.5    "]
+func AlignDecimal(values []string, sep string) []string {
+	if sep == "" {
+		sep = defaultDecimalSeparator
+	}
+	return alignDecimal(values, sep, 0, func(_, n int) string { return strings.Repeat(" ", max(n, 0)) })
+}
+
+// alignDecimal implements AlignDecimal, right-aligning the lined-up block
+// within width cells and drawing the padding that starts at column col
+// with pad
+func alignDecimal(lines []string, sep string, width int, pad func(col, n int) string) []string {
+	intWidths := make([]int, len(lines))
+	fracWidths := make([]int, len(lines))
+	maxInt, maxFrac := 0, 0
+	for i, line := range lines {
+		plain := measure.StripANSI(line)
+		whole := len(plain)
+		if idx := strings.Index(plain, sep); idx >= 0 {
+			whole = idx
+		}
+		intWidths[i] = measure.Width(plain[:whole])
+		fracWidths[i] = measure.Width(plain[whole:])
+		maxInt = max(maxInt, intWidths[i])
+		maxFrac = max(maxFrac, fracWidths[i])
+	}
+
+	offset := max(width-maxInt-maxFrac, 0)
+	out := make([]string, len(lines))
+	for i, line := range lines {
+		left := offset + maxInt - intWidths[i]
+		out[i] = pad(0, left) + line + pad(left+intWidths[i]+fracWidths[i], maxFrac-fracWidths[i])
+	}
+	return out
+}
+
+// applyDecimalAlignment lines up the separators of content's lines and
+// right-aligns them within the style's width, drawing the padding like
+// alignment space
+func (s Style) applyDecimalAlignment(content string) string {
+	sep := defaultDecimalSeparator
+	if s.decimalSeparator != nil && *s.decimalSeparator != "" {
+		sep = *s.decimalSeparator
+	}
+	lines := alignDecimal(strings.Split(content, "\n"), sep, intOrZero(s.width), s.makeAlignmentSpace)
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,55 @@
+package tuistyles
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+func TestAlignDecimal(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []string
+		sep    string
+		want   []string
+	}{
+		{"mixed", []string{"3.14159", "42", "-0.5"}, "", []string{" 3.14159", "42      ", "-0.5    "}},
+		{"comma", []string{"1,5", "1.234,25"}, ",", []string{"    1,5 ", "1.234,25"}},
+		{"no separators", []string{"7", "1000"}, ".", []string{"   7", "1000"}},
+		{"styled", []string{"\x1b[31m1.5\x1b[0m", "10.25"}, "", []string{" \x1b[31m1.5\x1b[0m ", "10.25"}},
+		{"wide digits", []string{"１.５", "10.25"}, "", []string{"１.５", "10.25"}},
+		{"empty", nil, "", []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, AlignDecimal(tt.values, tt.sep))
+		})
+	}
+}
+
+func TestRender_AlignDecimal(t *testing.T) {
+	got := NewStyle().Width(10).Align(Decimal).Render("1.5\n120\n-3.125")
+	require.Equal(t, strings.Join([]string{
+		"     1.5  ",
+		"   120    ",
+		"    -3.125",
+	}, "\n"), got)
+
+	// Without a width the block is as wide as its widest line
+	got = NewStyle().Align(Decimal).Render("1.5\n120")
+	require.Equal(t, "  1.5\n120  ", got)
+
+	got = NewStyle().Width(6).Align(Decimal).DecimalSeparator(",").Border(NormalBorder()).Render("0,25\n10")
+	require.Equal(t, []int{8, 8, 8, 8}, measure.WidthPerLine(got))
+	require.Contains(t, got, "│  0,25│\n│ 10   │")
+}
+
+func TestRender_AlignDecimalBackground(t *testing.T) {
+	got := NewStyle().Width(4).Align(Decimal).Background(Color("blue")).Render("1.5")
+	require.Equal(t, 4, measure.Width(got))
+	require.True(t, strings.HasPrefix(got, Color("blue").ToANSIBackground()+" "+"\x1b[0m"), got)
+}
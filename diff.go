@@ -0,0 +1,285 @@
+package tuistyles
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// DiffLayout selects how a DiffView arranges old and new lines
+type DiffLayout int
+
+const (
+	// DiffUnified shows one column with removed and added lines interleaved
+	DiffUnified DiffLayout = iota
+	// DiffSideBySide shows old lines on the left and new lines on the right
+	DiffSideBySide
+)
+
+// String returns human-readable layout name
+func (l DiffLayout) String() string {
+	switch l {
+	case DiffUnified:
+		return "Unified"
+	case DiffSideBySide:
+		return "SideBySide"
+	default:
+		return "Unknown"
+	}
+}
+
+// DiffView renders a unified diff (the output of git diff or diff -u) with
+// line-number gutters and colored backgrounds for added and removed lines.
+//
+// Lines wider than the view are truncated with "…"; every row is padded to
+// the full width so line backgrounds form solid bars. Added and removed
+// lines keep their + and - markers, so the diff stays readable without
+// color. The zero Theme renders with DefaultTheme.
+//
+// Example:
+//
+//	out, _ := exec.Command("git", "diff").Output()
+//	fmt.Println(DiffView{Diff: string(out), Layout: DiffSideBySide}.Render(120))
+type DiffView struct {
+	Diff   string
+	Layout DiffLayout
+	Theme  Theme
+}
+
+// diffLineKind classifies a line of a unified diff
+type diffLineKind int
+
+const (
+	diffContext diffLineKind = iota
+	diffAdded
+	diffRemoved
+	diffHunk
+	diffHeader
+	diffNote
+)
+
+// diffLine is one parsed line of a unified diff with its line numbers
+// (0 when the line does not exist on that side)
+type diffLine struct {
+	kind           diffLineKind
+	text           string
+	oldNum, newNum int
+}
+
+// hunkRegex matches a hunk header and captures the starting line numbers
+var hunkRegex = regexp.MustCompile(`^@@ -(\d+)(?:,\d+)? \+(\d+)(?:,\d+)? @@`)
+
+// parseDiff splits a unified diff into classified, numbered lines
+func parseDiff(diff string) []diffLine {
+	diff = strings.ReplaceAll(strings.TrimSuffix(diff, "\n"), "\t", "    ")
+	if diff == "" {
+		return nil
+	}
+
+	var lines []diffLine
+	oldNum, newNum := 0, 0
+	inHunk := false
+
+	for _, line := range strings.Split(diff, "\n") {
+		switch {
+		case hunkRegex.MatchString(line):
+			m := hunkRegex.FindStringSubmatch(line)
+			oldNum, _ = strconv.Atoi(m[1])
+			newNum, _ = strconv.Atoi(m[2])
+			inHunk = true
+			lines = append(lines, diffLine{kind: diffHunk, text: line})
+		case !inHunk || strings.HasPrefix(line, "diff "):
+			inHunk = false
+			lines = append(lines, diffLine{kind: diffHeader, text: line})
+		case strings.HasPrefix(line, "+"):
+			lines = append(lines, diffLine{kind: diffAdded, text: line[1:], newNum: newNum})
+			newNum++
+		case strings.HasPrefix(line, "-"):
+			lines = append(lines, diffLine{kind: diffRemoved, text: line[1:], oldNum: oldNum})
+			oldNum++
+		case strings.HasPrefix(line, "\\"):
+			lines = append(lines, diffLine{kind: diffNote, text: line})
+		default:
+			lines = append(lines, diffLine{kind: diffContext, text: strings.TrimPrefix(line, " "), oldNum: oldNum, newNum: newNum})
+			oldNum++
+			newNum++
+		}
+	}
+
+	return lines
+}
+
+// diffStyles holds the styles derived from a theme for one render
+type diffStyles struct {
+	gutter, added, removed, hunk, header, note Style
+}
+
+// newDiffStyles derives diff styles from the theme
+func newDiffStyles(t Theme) diffStyles {
+	return diffStyles{
+		gutter:  NewStyle().Foreground(t.Muted),
+		added:   NewStyle().Background(t.Success).Foreground(t.Success.ContrastingText()),
+		removed: NewStyle().Background(t.Error).Foreground(t.Error.ContrastingText()),
+		hunk:    NewStyle().Foreground(t.Info),
+		header:  NewStyle().Bold(true),
+		note:    NewStyle().Italic(true).Foreground(t.Muted),
+	}
+}
+
+// Render draws the diff at width cells (0 for the natural width of the
+// longest line).
+func (d DiffView) Render(width int) string {
+	lines := parseDiff(d.Diff)
+	if len(lines) == 0 {
+		return ""
+	}
+
+	styles := newDiffStyles(d.Theme.WithDefaults())
+	numWidth := 1
+	for _, line := range lines {
+		numWidth = max(numWidth, len(strconv.Itoa(max(line.oldNum, line.newNum))))
+	}
+
+	if d.Layout == DiffSideBySide {
+		return renderSideBySide(lines, styles, numWidth, width)
+	}
+	return renderUnified(lines, styles, numWidth, width)
+}
+
+// renderUnified draws all lines in one column with old and new numbers
+func renderUnified(lines []diffLine, styles diffStyles, numWidth, width int) string {
+	rows := make([]StyledString, len(lines))
+	for i, line := range lines {
+		switch line.kind {
+		case diffAdded, diffRemoved, diffContext:
+			gutter := fmt.Sprintf("%s %s │ ", lineNumber(line.oldNum, numWidth), lineNumber(line.newNum, numWidth))
+			rows[i] = Styled(gutter, styles.gutter).Append(diffMarker(line.kind)+line.text, styles.body(line.kind))
+		default:
+			rows[i] = Styled(line.text, styles.body(line.kind))
+		}
+	}
+
+	return strings.Join(fitRows(rows, styles, lines, width), "\n")
+}
+
+// renderSideBySide draws old lines on the left and new lines on the right,
+// pairing removed lines with the added lines that replace them
+func renderSideBySide(lines []diffLine, styles diffStyles, numWidth, width int) string {
+	var left, right []StyledString
+	var leftLines, rightLines []diffLine
+	var removed, added []diffLine
+
+	side := func(line diffLine, num int) StyledString {
+		if line.kind != diffAdded && line.kind != diffRemoved && line.kind != diffContext {
+			return Styled(line.text, styles.body(line.kind))
+		}
+		gutter := lineNumber(num, numWidth) + " │ "
+		return Styled(gutter, styles.gutter).Append(diffMarker(line.kind)+line.text, styles.body(line.kind))
+	}
+	blank := diffLine{kind: diffHeader}
+	push := func(l, r diffLine) {
+		left = append(left, side(l, l.oldNum))
+		right = append(right, side(r, r.newNum))
+		leftLines = append(leftLines, l)
+		rightLines = append(rightLines, r)
+	}
+	flush := func() {
+		for i := 0; i < max(len(removed), len(added)); i++ {
+			l, r := blank, blank
+			if i < len(removed) {
+				l = removed[i]
+			}
+			if i < len(added) {
+				r = added[i]
+			}
+			push(l, r)
+		}
+		removed, added = nil, nil
+	}
+
+	for _, line := range lines {
+		switch line.kind {
+		case diffRemoved:
+			removed = append(removed, line)
+		case diffAdded:
+			added = append(added, line)
+		case diffContext:
+			flush()
+			push(line, line)
+		default:
+			flush()
+			push(line, blank)
+		}
+	}
+	flush()
+
+	leftWidth, rightWidth := 0, 0
+	if width > 0 {
+		leftWidth = max((width-1)/2, 1)
+		rightWidth = max(width-1-leftWidth, 1)
+	}
+
+	leftCol := strings.Join(fitRows(left, styles, leftLines, leftWidth), "\n")
+	rightCol := strings.Join(fitRows(right, styles, rightLines, rightWidth), "\n")
+	sep := strings.TrimSuffix(strings.Repeat(styles.gutter.Render("│")+"\n", len(left)), "\n")
+	return JoinHorizontal(Top, leftCol, sep, rightCol)
+}
+
+// fitRows truncates rows wider than width and pads every row to width (or
+// the widest row when width is 0), extending the line's background
+func fitRows(rows []StyledString, styles diffStyles, lines []diffLine, width int) []string {
+	if width <= 0 {
+		for _, row := range rows {
+			width = max(width, row.Width())
+		}
+	}
+
+	rendered := make([]string, len(rows))
+	for i, row := range rows {
+		row = row.Truncate(width, "…")
+		if pad := width - row.Width(); pad > 0 {
+			row = row.Append(strings.Repeat(" ", pad), styles.body(lines[i].kind))
+		}
+		rendered[i] = row.Render()
+	}
+	return rendered
+}
+
+// body returns the style for the text of a line of the given kind
+func (s diffStyles) body(kind diffLineKind) Style {
+	switch kind {
+	case diffAdded:
+		return s.added
+	case diffRemoved:
+		return s.removed
+	case diffHunk:
+		return s.hunk
+	case diffHeader:
+		return s.header
+	case diffNote:
+		return s.note
+	default:
+		return NewStyle()
+	}
+}
+
+// diffMarker returns the +/- column for a line
+func diffMarker(kind diffLineKind) string {
+	switch kind {
+	case diffAdded:
+		return "+"
+	case diffRemoved:
+		return "-"
+	default:
+		return " "
+	}
+}
+
+// lineNumber right-aligns n in width cells, or blanks the column for 0
+func lineNumber(n, width int) string {
+	if n == 0 {
+		return strings.Repeat(" ", width)
+	}
+	return fmt.Sprintf("%*d", width, n)
+}
@@ -0,0 +1,99 @@
+package tuistyles
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+const sampleDiff = `diff --git a/main.go b/main.go
+--- a/main.go
++++ b/main.go
+@@ -1,4 +1,4 @@
+ package main
+-import "fmt"
++import "log"
+ func main() {
+-	fmt.Println("hi")
++	log.Println("hello there, this line is long")
+`
+
+// plainRows strips styling and trailing spaces from rendered rows
+func plainRows(s string) []string {
+	rows := strings.Split(measure.StripANSI(s), "\n")
+	for i := range rows {
+		rows[i] = strings.TrimRight(rows[i], " ")
+	}
+	return rows
+}
+
+func TestParseDiff(t *testing.T) {
+	lines := parseDiff(sampleDiff)
+
+	require.Len(t, lines, 10)
+	require.Equal(t, diffLine{kind: diffHunk, text: "@@ -1,4 +1,4 @@"}, lines[3])
+	require.Equal(t, diffLine{kind: diffContext, text: "package main", oldNum: 1, newNum: 1}, lines[4])
+	require.Equal(t, diffLine{kind: diffRemoved, text: `import "fmt"`, oldNum: 2}, lines[5])
+	require.Equal(t, diffLine{kind: diffAdded, text: `import "log"`, newNum: 2}, lines[6])
+	require.Equal(t, diffLine{kind: diffContext, text: "func main() {", oldNum: 3, newNum: 3}, lines[7])
+	require.Equal(t, "    fmt.Println(\"hi\")", lines[8].text, "tabs expand to four spaces")
+}
+
+func TestDiffView_Unified(t *testing.T) {
+	got := DiffView{Diff: sampleDiff}.Render(0)
+
+	require.Equal(t, []string{
+		"diff --git a/main.go b/main.go",
+		"--- a/main.go",
+		"+++ b/main.go",
+		"@@ -1,4 +1,4 @@",
+		"1 1 │  package main",
+		"2   │ -import \"fmt\"",
+		"  2 │ +import \"log\"",
+		"3 3 │  func main() {",
+		"4   │ -    fmt.Println(\"hi\")",
+		"  4 │ +    log.Println(\"hello there, this line is long\")",
+	}, plainRows(got))
+
+	widths := measure.WidthPerLine(got)
+	for _, w := range widths {
+		require.Equal(t, widths[0], w, "rows should be padded to a common width")
+	}
+}
+
+func TestDiffView_SideBySide(t *testing.T) {
+	got := DiffView{Diff: sampleDiff, Layout: DiffSideBySide}.Render(0)
+	rows := plainRows(got)
+
+	require.Len(t, rows, 8, "removed and added lines share rows")
+	require.Contains(t, rows[5], `2 │ -import "fmt"`)
+	require.Contains(t, rows[5], `2 │ +import "log"`)
+}
+
+func TestDiffView_Width(t *testing.T) {
+	for _, layout := range []DiffLayout{DiffUnified, DiffSideBySide} {
+		t.Run(layout.String(), func(t *testing.T) {
+			got := DiffView{Diff: sampleDiff, Layout: layout}.Render(40)
+			for _, row := range strings.Split(got, "\n") {
+				require.Equal(t, 40, measure.Width(row), "row %q", row)
+			}
+			require.Contains(t, measure.StripANSI(got), "…", "long lines should be truncated")
+		})
+	}
+}
+
+func TestDiffView_Colors(t *testing.T) {
+	theme := Theme{Success: Color("#00AA00"), Error: Color("#AA0000")}
+	got := DiffView{Diff: sampleDiff, Theme: theme}.Render(0)
+
+	// Backgrounds are combined with the foreground in one sequence
+	require.Contains(t, got, strings.TrimPrefix(Color("#00AA00").ToANSIBackground(), "\x1b["))
+	require.Contains(t, got, strings.TrimPrefix(Color("#AA0000").ToANSIBackground(), "\x1b["))
+}
+
+func TestDiffView_Empty(t *testing.T) {
+	require.Empty(t, DiffView{}.Render(40))
+}
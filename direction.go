@@ -0,0 +1,150 @@
+package tuistyles
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/orchard9/tui-styles/internal/bidi"
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+// TextDirection is the base writing direction of a block of text
+type TextDirection int
+
+const (
+	// DirectionAuto detects the direction from the first strongly
+	// directional character (Hebrew or Arabic letters mean right-to-left)
+	DirectionAuto TextDirection = iota
+	// DirectionLTR is left-to-right text (the default when unset)
+	DirectionLTR
+	// DirectionRTL is right-to-left text such as Arabic or Hebrew
+	DirectionRTL
+)
+
+// String returns human-readable direction name
+func (d TextDirection) String() string {
+	switch d {
+	case DirectionAuto:
+		return "Auto"
+	case DirectionLTR:
+		return "LTR"
+	case DirectionRTL:
+		return "RTL"
+	default:
+		return "Unknown"
+	}
+}
+
+// MarshalText encodes the direction as its lowercase name ("auto", "ltr", "rtl").
+func (d TextDirection) MarshalText() ([]byte, error) {
+	if d < DirectionAuto || d > DirectionRTL {
+		return nil, fmt.Errorf("invalid text direction: %d", int(d))
+	}
+	return []byte(strings.ToLower(d.String())), nil
+}
+
+// UnmarshalText decodes a direction name (case-insensitive).
+func (d *TextDirection) UnmarshalText(text []byte) error {
+	name := strings.ToLower(string(text))
+	for candidate := DirectionAuto; candidate <= DirectionRTL; candidate++ {
+		if strings.ToLower(candidate.String()) == name {
+			*d = candidate
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid text direction: %q", string(text))
+}
+
+// Direction sets the base writing direction.
+//
+// In right-to-left text, Align(Left) and Align(Right) mean "start" and
+// "end": they are mirrored, so Left places text against the right edge.
+// Lines of a multi-line block are aligned to the right edge even without a
+// width, so Arabic and Hebrew sit correctly inside borders.
+//
+// Returns a new Style with direction set, leaving the original unchanged.
+//
+// Example:
+//
+//	s := NewStyle().Direction(DirectionAuto).Width(20).Border(RoundedBorder())
+//	fmt.Println(s.Render("שלום עולם")) // hugs the right border
+func (s Style) Direction(d TextDirection) Style {
+	s2 := s
+	s2.direction = &d
+	return s2
+}
+
+// BidiReorder reverses right-to-left runs into visual order before
+// rendering, for terminals that display characters strictly left to right
+// (most do; a few, such as mlterm and Konsole, apply the bidirectional
+// algorithm themselves and need this off). Left-to-right runs such as
+// numbers and Latin words inside RTL text keep their order. Lines that
+// already contain escape sequences are not reordered.
+//
+// Returns a new Style with bidiReorder set, leaving the original unchanged.
+//
+// Example:
+//
+//	s := NewStyle().Direction(DirectionRTL).BidiReorder(true)
+func (s Style) BidiReorder(v bool) Style {
+	s2 := s
+	s2.bidiReorder = &v
+	return s2
+}
+
+// isRTL resolves the style's direction for str
+func (s Style) isRTL(str string) bool {
+	if s.direction == nil {
+		return false
+	}
+
+	switch *s.direction {
+	case DirectionRTL:
+		return true
+	case DirectionAuto:
+		return bidi.FirstStrongIsRTL(measure.StripANSI(str))
+	default:
+		return false
+	}
+}
+
+// forDirection returns the style used to render str: for right-to-left text
+// horizontal alignment is mirrored and the direction is resolved so lines
+// can be reordered
+func (s Style) forDirection(str string) Style {
+	if s.direction == nil {
+		return s
+	}
+
+	if !s.isRTL(str) {
+		ltr := DirectionLTR
+		s.direction = &ltr
+		return s
+	}
+
+	rtl := DirectionRTL
+	s.direction = &rtl
+
+	align := Right
+	if s.align != nil {
+		switch *s.align {
+		case Left:
+			align = Right
+		case Right:
+			align = Left
+		default:
+			align = *s.align
+		}
+	}
+	s.align = &align
+	return s
+}
+
+// visualLine reorders a line for display when bidi reordering is enabled
+func (s Style) visualLine(line string) string {
+	if s.bidiReorder == nil || !*s.bidiReorder || strings.Contains(line, "\x1b") {
+		return line
+	}
+	rtl := s.direction != nil && *s.direction == DirectionRTL
+	return bidi.Reorder(line, rtl)
+}
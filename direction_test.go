@@ -0,0 +1,80 @@
+package tuistyles
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+func TestDirection_MirrorsAlignment(t *testing.T) {
+	tests := []struct {
+		name  string
+		style Style
+		want  string
+	}{
+		{"LTR left", NewStyle().Width(8).Align(Left), "abc     "},
+		{"RTL left means start", NewStyle().Width(8).Align(Left).Direction(DirectionRTL), "     abc"},
+		{"RTL right means end", NewStyle().Width(8).Align(Right).Direction(DirectionRTL), "abc     "},
+		{"RTL center unchanged", NewStyle().Width(7).Align(Center).Direction(DirectionRTL), "  abc  "},
+		{"RTL defaults to start", NewStyle().Width(8).Direction(DirectionRTL), "     abc"},
+		{"explicit LTR", NewStyle().Width(8).Align(Left).Direction(DirectionLTR), "abc     "},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, tt.style.Render("abc"))
+		})
+	}
+}
+
+func TestDirection_Auto(t *testing.T) {
+	s := NewStyle().Width(10).Align(Left).Direction(DirectionAuto)
+
+	require.Equal(t, "hello     ", s.Render("hello"))
+	require.Equal(t, "      שלום", s.Render("שלום"))
+	require.Equal(t, "   12 שלום", s.Render("12 שלום"), "digits are not strong; the Hebrew letters decide")
+}
+
+func TestDirection_RTLInsideBorder(t *testing.T) {
+	s := NewStyle().Direction(DirectionAuto).Border(NormalBorder())
+	got := s.Render("שלום עולם\nשלום")
+
+	lines := strings.Split(got, "\n")
+	require.Equal(t, "│     שלום│", lines[2], "short RTL lines hug the right border")
+	for _, line := range lines {
+		require.Equal(t, 11, measure.Width(line))
+	}
+}
+
+func TestBidiReorder(t *testing.T) {
+	s := NewStyle().Direction(DirectionRTL).BidiReorder(true)
+	require.Equal(t, "םולש", s.Render("שלום"))
+
+	// Reordering is off by default: terminals with BiDi support reorder themselves
+	require.Equal(t, "שלום", NewStyle().Direction(DirectionRTL).Render("שלום"))
+}
+
+func TestBidiReorder_TruncatesLogicalEnd(t *testing.T) {
+	s := NewStyle().Direction(DirectionRTL).BidiReorder(true).MaxWidth(7)
+
+	// Logical "שלום עולם" keeps its start ("שלום") and the ellipsis lands on the visual left
+	require.Equal(t, "...םולש", s.Render("שלום עולם"))
+}
+
+func TestTextDirection_JSON(t *testing.T) {
+	for _, d := range []TextDirection{DirectionAuto, DirectionLTR, DirectionRTL} {
+		data, err := json.Marshal(d)
+		require.NoError(t, err)
+
+		var got TextDirection
+		require.NoError(t, json.Unmarshal(data, &got))
+		require.Equal(t, d, got)
+	}
+
+	_, err := json.Marshal(TextDirection(9))
+	require.Error(t, err)
+}
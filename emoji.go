@@ -0,0 +1,168 @@
+package tuistyles
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+// EmojiMode selects how a Renderer draws emoji. Terminals disagree about
+// how wide many emoji are, so a single emoji can push the rest of a line,
+// and the border after it, out of alignment; the replacement modes swap
+// emoji for text whose width every terminal agrees on.
+type EmojiMode int
+
+const (
+	// EmojiKeep draws emoji as they are (the default)
+	EmojiKeep EmojiMode = iota
+	// EmojiPlaceholder replaces each emoji with a two-cell ASCII stand-in:
+	// "ok" for ✅, "!!" for ⚠️, and "[]" for emoji without one
+	EmojiPlaceholder
+	// EmojiShortcode replaces each emoji with its shortcode, such as
+	// ":check:" for ✅, or ":emoji:" for emoji without one
+	EmojiShortcode
+)
+
+// String returns human-readable emoji mode name
+func (m EmojiMode) String() string {
+	switch m {
+	case EmojiKeep:
+		return "Keep"
+	case EmojiPlaceholder:
+		return "Placeholder"
+	case EmojiShortcode:
+		return "Shortcode"
+	default:
+		return "Unknown"
+	}
+}
+
+// MarshalText encodes the mode as its lowercase name ("keep", "placeholder", "shortcode").
+func (m EmojiMode) MarshalText() ([]byte, error) {
+	if m < EmojiKeep || m > EmojiShortcode {
+		return nil, fmt.Errorf("invalid emoji mode: %d", int(m))
+	}
+	return []byte(strings.ToLower(m.String())), nil
+}
+
+// UnmarshalText decodes an emoji mode name (case-insensitive).
+func (m *EmojiMode) UnmarshalText(text []byte) error {
+	name := strings.ToLower(string(text))
+	for candidate := EmojiKeep; candidate <= EmojiShortcode; candidate++ {
+		if strings.ToLower(candidate.String()) == name {
+			*m = candidate
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid emoji mode: %q", string(text))
+}
+
+// emojiAlternative is the text an emoji is replaced with
+type emojiAlternative struct {
+	shortcode   string
+	placeholder string // Exactly two cells
+}
+
+// emojiAlternatives covers the emoji common in status output. Keys are
+// the emoji without variation selectors.
+var emojiAlternatives = map[string]emojiAlternative{
+	"✅": {"check", "ok"}, "✔": {"check", "ok"}, "☑": {"check", "ok"},
+	"❌": {"x", "no"}, "✖": {"x", "no"}, "❎": {"x", "no"},
+	"⚠": {"warning", "!!"}, "❗": {"exclamation", "!!"}, "❓": {"question", "??"},
+	"🔴": {"red_circle", "()"}, "🟡": {"yellow_circle", "()"}, "🟢": {"green_circle", "()"},
+	"🔵": {"blue_circle", "()"}, "⚪": {"white_circle", "()"}, "⚫": {"black_circle", "()"},
+	"⭐": {"star", "**"}, "✨": {"sparkles", "**"}, "🔥": {"fire", "**"},
+	"🚀": {"rocket", "=>"}, "➡": {"arrow_right", "->"}, "⬅": {"arrow_left", "<-"},
+	"⬆": {"arrow_up", "^^"}, "⬇": {"arrow_down", "vv"},
+	"⏳": {"hourglass", ".."}, "⌛": {"hourglass", ".."}, "⏱": {"stopwatch", ".."},
+	"🐛": {"bug", "#!"}, "📦": {"package", "[]"}, "🔒": {"lock", "[]"}, "🔓": {"unlock", "[]"},
+	"💡": {"bulb", "i "}, "ℹ": {"information", "i "}, "📝": {"memo", "[]"},
+	"🎉": {"tada", "\\o"}, "👍": {"+1", "+1"}, "👎": {"-1", "-1"},
+	"🔧": {"wrench", "[]"}, "⚙": {"gear", "[]"}, "🛑": {"stop", "XX"}, "⛔": {"no_entry", "XX"},
+}
+
+// ReplaceEmoji replaces every emoji in s according to mode, leaving escape
+// sequences and other text untouched. EmojiKeep returns s unchanged.
+//
+// Emoji are recognized as grapheme clusters that are drawn as emoji:
+// pictographs, flags, keycaps, and symbols with an emoji variation
+// selector. Text-style symbols such as ✓ and → are kept.
+//
+// Example:
+//
+//	ReplaceEmoji("✅ build  ⚠️ lint", EmojiPlaceholder) // "ok build  !! lint"
+func ReplaceEmoji(s string, mode EmojiMode) string {
+	if mode != EmojiPlaceholder && mode != EmojiShortcode {
+		return s
+	}
+
+	var b strings.Builder
+	b.Grow(len(s))
+	for _, seg := range measure.Segments(s) {
+		if seg.Escape {
+			b.WriteString(seg.Text)
+			continue
+		}
+		measure.EachGrapheme(seg.Text, func(cluster string, width int) bool {
+			if !isEmoji(cluster, width) {
+				b.WriteString(cluster)
+				return true
+			}
+			alt, ok := emojiAlternatives[strings.ReplaceAll(cluster, "\ufe0f", "")]
+			switch {
+			case mode == EmojiShortcode && ok:
+				b.WriteString(":" + alt.shortcode + ":")
+			case mode == EmojiShortcode:
+				b.WriteString(":emoji:")
+			case ok:
+				b.WriteString(alt.placeholder)
+			default:
+				b.WriteString("[]")
+			}
+			return true
+		})
+	}
+	return b.String()
+}
+
+// isEmoji reports whether a grapheme cluster of the given width is drawn
+// as an emoji
+func isEmoji(cluster string, width int) bool {
+	r, _ := utf8.DecodeRuneInString(cluster)
+	switch {
+	case strings.ContainsRune(cluster, '\ufe0f'), strings.ContainsRune(cluster, '\u20e3'):
+		// Emoji presentation selector, or a keycap
+		return true
+	case r >= 0x1f000 && r <= 0x1faff:
+		// Pictographs, emoticons, transport symbols, and flags
+		return true
+	case r >= 0x2300 && r <= 0x2bff:
+		// Symbols and dingbats with emoji presentation are drawn wide
+		return width == 2
+	default:
+		return false
+	}
+}
+
+// Emoji sets how the renderer draws emoji in the text it renders (see
+// EmojiMode). The replacement happens before layout, so widths, alignment,
+// and borders account for it.
+//
+// Returns a new Renderer, leaving the original unchanged.
+//
+// Example:
+//
+//	r := NewRenderer().Emoji(EmojiPlaceholder)
+//	fmt.Println(r.Render(panel, "✅ api\n❌ db"))
+func (r Renderer) Emoji(mode EmojiMode) Renderer {
+	r2 := r
+	r2.emoji = mode
+	return r2
+}
+
+// EmojiMode returns the renderer's emoji mode.
+func (r Renderer) EmojiMode() EmojiMode {
+	return r.emoji
+}
@@ -0,0 +1,62 @@
+package tuistyles
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplaceEmoji(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		mode EmojiMode
+		want string
+	}{
+		{"keep", "✅ ok", EmojiKeep, "✅ ok"},
+		{"placeholder", "✅ build  ⚠\ufe0f lint", EmojiPlaceholder, "ok build  !! lint"},
+		{"placeholder unknown", "\U0001f984!", EmojiPlaceholder, "[]!"},
+		{"shortcode", "\U0001f680 deploy", EmojiShortcode, ":rocket: deploy"},
+		{"shortcode unknown", "\U0001f984", EmojiShortcode, ":emoji:"},
+		{"flag", "\U0001f1e9\U0001f1ea", EmojiPlaceholder, "[]"},
+		{"ZWJ sequence", "\U0001f468\u200d\U0001f4bb dev", EmojiPlaceholder, "[] dev"},
+		{"keycap", "1\ufe0f\u20e3", EmojiPlaceholder, "[]"},
+		{"text symbols kept", "✓ → ★ ©", EmojiPlaceholder, "✓ → ★ ©"},
+		{"escapes kept", "\x1b[32m✅\x1b[0m", EmojiShortcode, "\x1b[32m:check:\x1b[0m"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, ReplaceEmoji(tt.in, tt.mode))
+		})
+	}
+}
+
+func TestEmojiAlternatives_PlaceholderWidth(t *testing.T) {
+	for emoji, alt := range emojiAlternatives {
+		require.Equal(t, 2, Width(alt.placeholder), "placeholder for %q", emoji)
+	}
+}
+
+func TestEmojiMode_Text(t *testing.T) {
+	for _, mode := range []EmojiMode{EmojiKeep, EmojiPlaceholder, EmojiShortcode} {
+		text, err := mode.MarshalText()
+		require.NoError(t, err)
+
+		var decoded EmojiMode
+		require.NoError(t, decoded.UnmarshalText(text))
+		require.Equal(t, mode, decoded)
+	}
+
+	_, err := EmojiMode(5).MarshalText()
+	require.Error(t, err)
+}
+
+func TestRenderer_Emoji(t *testing.T) {
+	style := NewStyle().Border(NormalBorder())
+	r := Renderer{}.Emoji(EmojiPlaceholder)
+
+	require.Equal(t, EmojiPlaceholder, r.EmojiMode())
+	require.Equal(t, style.Render("ok api\nno db"), r.Render(style, "✅ api\n❌ db"))
+	require.Equal(t, style.Render("✅"), Renderer{}.Render(style, "✅"))
+}
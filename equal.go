@@ -0,0 +1,69 @@
+package tuistyles
+
+import (
+	"encoding/json"
+	"hash/fnv"
+	"reflect"
+)
+
+// Equal reports whether s and other set the same properties to the same
+// values.
+//
+// Styles built separately compare equal when they describe the same look,
+// unlike ==, which compares the internal pointers. A property that is unset
+// differs from one explicitly set to its zero value, as in MarshalJSON.
+//
+// Example:
+//
+//	a := NewStyle().Bold(true).Padding(1)
+//	b := NewStyle().Padding(1).Bold(true)
+//	a.Equal(b) // true
+func (s Style) Equal(other Style) bool {
+	return reflect.DeepEqual(s.toJSON(), other.toJSON())
+}
+
+// Hash returns a hash of the style's properties for use as a cache key.
+//
+// Styles that are Equal have the same hash. Different styles can collide, so
+// caches should confirm a hit with Equal when exactness matters.
+//
+// Example:
+//
+//	key := style.Hash() ^ contentHash
+//	if cached, ok := cache[key]; ok { ... }
+func (s Style) Hash() uint64 {
+	h := fnv.New64a()
+	data, _ := json.Marshal(s.toJSON()) //nolint:errcheck // styleJSON holds only plain values
+	_, _ = h.Write(data)
+	return h.Sum64()
+}
+
+// Diff returns the names of the properties that differ between s and other,
+// in declaration order, or nil when the styles are Equal.
+//
+// Names match the builder methods that set each property (for example
+// "Bold", "PaddingLeft", "BorderTopForeground"), except that the border
+// style set by Border is reported as "Border" and its edges as "BorderTop"
+// and so on.
+//
+// Example:
+//
+//	a := NewStyle().Bold(true).Padding(1)
+//	b := NewStyle().Padding(1, 2)
+//	a.Diff(b) // [Bold PaddingRight PaddingLeft]
+func (s Style) Diff(other Style) []string {
+	a := reflect.ValueOf(s.toJSON())
+	b := reflect.ValueOf(other.toJSON())
+
+	var diff []string
+	for i := 0; i < a.NumField(); i++ {
+		name := a.Type().Field(i).Name
+		if name == "Version" {
+			continue
+		}
+		if !reflect.DeepEqual(a.Field(i).Interface(), b.Field(i).Interface()) {
+			diff = append(diff, name)
+		}
+	}
+	return diff
+}
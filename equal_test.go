@@ -0,0 +1,71 @@
+package tuistyles
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStyle_Equal(t *testing.T) {
+	red := Color("red")
+	blue := Color("blue")
+
+	tests := []struct {
+		name  string
+		a, b  Style
+		equal bool
+	}{
+		{"empty", NewStyle(), NewStyle(), true},
+		{"built separately", NewStyle().Bold(true).Padding(1), NewStyle().Padding(1).Bold(true), true},
+		{"same colors", NewStyle().Foreground(red), NewStyle().Foreground(Color("red")), true},
+		{"same border", NewStyle().Border(RoundedBorder()), NewStyle().Border(RoundedBorder()), true},
+		{"different value", NewStyle().Foreground(red), NewStyle().Foreground(blue), false},
+		{"unset vs zero", NewStyle(), NewStyle().Bold(false), false},
+		{"different border", NewStyle().Border(RoundedBorder()), NewStyle().Border(NormalBorder()), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.equal, tt.a.Equal(tt.b))
+			require.Equal(t, tt.equal, tt.b.Equal(tt.a))
+			if tt.equal {
+				require.Equal(t, tt.a.Hash(), tt.b.Hash())
+				require.Nil(t, tt.a.Diff(tt.b))
+			} else {
+				require.NotEqual(t, tt.a.Hash(), tt.b.Hash())
+				require.NotEmpty(t, tt.a.Diff(tt.b))
+			}
+		})
+	}
+}
+
+func TestStyle_Diff(t *testing.T) {
+	a := NewStyle().Bold(true).Padding(1).Border(RoundedBorder())
+	b := NewStyle().Padding(1, 2).Border(RoundedBorder()).BorderForeground(Color("cyan"))
+
+	require.Equal(t, []string{"Bold", "PaddingRight", "PaddingLeft", "BorderForeground"}, a.Diff(b))
+	require.Equal(t, a.Diff(b), b.Diff(a))
+}
+
+func TestStyle_DiffCoversEveryField(t *testing.T) {
+	// Every property must be visible to Equal, Hash, and Diff. A style with
+	// everything set differs from the empty style in every property.
+	full := NewStyle().
+		Bold(true).Italic(true).Underline(true).Strikethrough(true).Faint(true).Blink(true).Reverse(true).
+		Foreground(Color("red")).Background(Color("blue")).AutoForeground(true).
+		Width(1).Height(1).MaxWidth(1).MaxHeight(1).WidthPercent(1).HeightPercent(1).TruncateMode(TruncateCenter).TruncateExact(true).
+		Align(Center).AlignVertical(Center).DecimalSeparator(",").
+		Direction(DirectionRTL).BidiReorder(true).Hyphenate(true).LongWords(Overflow).
+		Padding(1).Margin(1).
+		PaddingTopBackground(Color("red")).PaddingRightBackground(Color("red")).
+		PaddingBottomBackground(Color("red")).PaddingLeftBackground(Color("red")).
+		Border(NormalBorder()).BorderForeground(Color("red")).BorderBackground(Color("red")).
+		BorderTopForeground(Color("red")).BorderRightForeground(Color("red")).
+		BorderBottomForeground(Color("red")).BorderLeftForeground(Color("red")).
+		BorderTopBackground(Color("red")).BorderRightBackground(Color("red")).
+		BorderBottomBackground(Color("red")).BorderLeftBackground(Color("red")).
+		Shadow(true).ShadowColor(Color("red")).BackgroundPattern("░")
+
+	require.Len(t, full.Diff(NewStyle()), reflect.TypeOf(Style{}).NumField())
+}
@@ -39,11 +39,18 @@ Users:     1,234
 Active:      567
 Pending:     123
 Inactive:    544
-
-CPU:        45%
-Memory:     67%
-Disk:       82%
-Network:    23%`
+`
+
+	// Resource gauges share a label column so the bars line up
+	for _, m := range []struct {
+		name    string
+		percent float64
+	}{
+		{"CPU:", 45}, {"Memory:", 67}, {"Disk:", 82}, {"Network:", 23},
+	} {
+		gauge := tuistyles.Gauge{Label: m.name, LabelWidth: 10, Percent: m.percent}
+		metricsContent += "\n" + gauge.Render(38)
+	}
 
 	leftPanel := tuistyles.NewStyle().
 		Border(tuistyles.RoundedBorder()).
@@ -107,13 +114,19 @@ Uptime:     47 days
 		Render(logsTitle + "\n" + logsContent)
 
 	// Footer
-	footerStyle := tuistyles.NewStyle().
-		Foreground(gray).
+	help := tuistyles.HelpBar{
+		Bindings: []tuistyles.KeyBinding{
+			{Key: "q", Description: "quit"},
+			{Key: "r", Description: "refresh"},
+			{Key: "h", Description: "help"},
+		},
+		Align:            tuistyles.Center,
+		DescriptionStyle: tuistyles.NewStyle().Foreground(gray),
+		SeparatorStyle:   tuistyles.NewStyle().Foreground(gray),
+	}
+	footer := tuistyles.NewStyle().
 		Padding(1, 0).
-		Width(82).
-		Align(tuistyles.Center)
-
-	footer := footerStyle.Render("Press Q to quit • Press R to refresh • Press H for help")
+		Render(help.Render(82))
 
 	// Compose dashboard
 	panels := tuistyles.JoinHorizontal(tuistyles.Top, leftPanel, "  ", rightPanel)
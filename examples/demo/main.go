@@ -8,9 +8,8 @@ import (
 )
 
 func main() {
-	fmt.Println("\n╔════════════════════════════════════════════════════════════════════════════╗")
-	fmt.Println("║                        TUI STYLES DEMO - v1.0.0                            ║")
-	fmt.Println("╚════════════════════════════════════════════════════════════════════════════╝\n")
+	fmt.Println()
+	fmt.Println(demoBanner("TUI STYLES", "DEMO - v1.0.0"))
 
 	// Section 1: Text Attributes
 	printSection("1. TEXT ATTRIBUTES", demoTextAttributes())
@@ -33,10 +32,25 @@ func main() {
 	// Section 7: Real-World Example - Dashboard
 	printSection("7. DASHBOARD EXAMPLE", demoDashboard())
 
-	fmt.Println("\n╔════════════════════════════════════════════════════════════════════════════╗")
-	fmt.Println("║          TUI Styles - Complete Terminal Styling Library for Go             ║")
-	fmt.Println("║                  github.com/orchard9/tui-styles                            ║")
-	fmt.Println("╚════════════════════════════════════════════════════════════════════════════╝\n")
+	fmt.Println()
+	fmt.Println(demoBanner("TUI STYLES", "Complete Terminal Styling Library for Go\ngithub.com/orchard9/tui-styles"))
+}
+
+// demoBanner renders a gradient block-letter title with a subtitle, centered in 80 columns
+func demoBanner(title, subtitle string) string {
+	banner := tuistyles.Banner{
+		Text:     title,
+		Font:     tuistyles.FontCompact(),
+		Gradient: []tuistyles.Color{"#FF5F87", "#AF87FF", "#5FAFFF"},
+	}
+	sub := tuistyles.NewStyle().
+		Faint(true).
+		Render(subtitle)
+
+	return tuistyles.NewStyle().
+		Width(80).
+		Align(tuistyles.Center).
+		Render(banner.Render(80) + "\n\n" + sub)
 }
 
 func printSection(title, content string) {
@@ -494,12 +508,21 @@ Cache Hit: 94%`
 		Render(alerts)
 
 	// Footer
-	footer := tuistyles.NewStyle().
+	updated := tuistyles.NewStyle().
 		Foreground(tuistyles.Color("gray")).
-		Padding(1, 0).
 		Width(76).
 		Align(tuistyles.Center).
-		Render("Last updated: 2025-11-23 14:30:00 UTC  •  Press R to refresh")
+		Render("Last updated: 2025-11-23 14:30:00 UTC")
+	help := tuistyles.HelpBar{
+		Bindings: []tuistyles.KeyBinding{
+			{Key: "r", Description: "refresh"},
+			{Key: "q", Description: "quit"},
+		},
+		Align: tuistyles.Center,
+	}
+	footer := tuistyles.NewStyle().
+		Padding(1, 0).
+		Render(updated + "\n" + help.Render(76))
 
 	// Compose everything
 	dashboard := tuistyles.JoinVertical(
@@ -0,0 +1,358 @@
+package tuistyles
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+// Font is a set of large glyphs for Banner.
+//
+// Use one of the built-in fonts (FontBlock, FontCompact) or load a figlet
+// font with LoadFigletFont. A Font is immutable and safe to share.
+type Font struct {
+	height  int
+	spacing int // blank columns between glyphs
+	glyphs  map[rune][]string
+}
+
+// Height returns the number of rows in every glyph.
+func (f *Font) Height() int {
+	return f.height
+}
+
+// Glyph returns the rows of the glyph for r, padded to a common width.
+// Lowercase letters fall back to uppercase glyphs when the font has none.
+func (f *Font) Glyph(r rune) ([]string, bool) {
+	rows, ok := f.glyph(r)
+	return slices.Clone(rows), ok
+}
+
+// glyph looks up r without copying
+func (f *Font) glyph(r rune) ([]string, bool) {
+	if rows, ok := f.glyphs[r]; ok {
+		return rows, true
+	}
+	rows, ok := f.glyphs[unicode.ToUpper(r)]
+	return rows, ok
+}
+
+// renderLine sets a single line of text, returning Height rows of equal
+// width. Characters without a glyph are drawn as '?' when the font has one
+// and skipped otherwise.
+func (f *Font) renderLine(text string) []string {
+	rows := make([]strings.Builder, f.height)
+	first := true
+	for _, r := range text {
+		glyph, ok := f.glyph(r)
+		if !ok {
+			if glyph, ok = f.glyphs['?']; !ok {
+				continue
+			}
+		}
+
+		if !first {
+			for i := range rows {
+				rows[i].WriteString(strings.Repeat(" ", f.spacing))
+			}
+		}
+		first = false
+		for i := range rows {
+			rows[i].WriteString(glyph[i])
+		}
+	}
+
+	out := make([]string, f.height)
+	for i := range rows {
+		out[i] = rows[i].String()
+	}
+	return out
+}
+
+// lineWidth returns the width of text set in this font
+func (f *Font) lineWidth(text string) int {
+	return measure.Width(f.renderLine(text)[0])
+}
+
+// FontBlock returns a 5-row font drawn with full blocks.
+//
+// It covers A-Z (lowercase is drawn as uppercase), 0-9, and common
+// punctuation.
+func FontBlock() *Font {
+	builtinFonts()
+	return fontBlock
+}
+
+// FontCompact returns a 3-row font drawn with half blocks.
+//
+// It has the same shapes and coverage as FontBlock at roughly half the
+// height, so letters look closer to square in most terminals.
+func FontCompact() *Font {
+	builtinFonts()
+	return fontCompact
+}
+
+var (
+	fontBlock    *Font
+	fontCompact  *Font
+	builtinFonts = sync.OnceFunc(func() {
+		fontBlock = bitmapFont(blockPixels, "█")
+		fontCompact = halfBlockFont(blockPixels)
+	})
+)
+
+// bitmapFont turns pixel rows ('#' set) into glyphs, one cell per pixel
+func bitmapFont(bitmaps map[rune][]string, pixel string) *Font {
+	f := &Font{height: bitmapHeight, spacing: 1, glyphs: make(map[rune][]string, len(bitmaps))}
+	for r, rows := range bitmaps {
+		glyph := make([]string, len(rows))
+		for i, row := range rows {
+			glyph[i] = strings.NewReplacer("#", pixel, ".", " ").Replace(row)
+		}
+		f.glyphs[r] = glyph
+	}
+	return f
+}
+
+// halfBlockFont packs two pixel rows into each text row with ▀, ▄ and █
+func halfBlockFont(bitmaps map[rune][]string) *Font {
+	height := (bitmapHeight + 1) / 2
+	f := &Font{height: height, spacing: 1, glyphs: make(map[rune][]string, len(bitmaps))}
+	for r, rows := range bitmaps {
+		glyph := make([]string, height)
+		for i := range glyph {
+			top := rows[2*i]
+			bottom := strings.Repeat(".", len(top))
+			if 2*i+1 < len(rows) {
+				bottom = rows[2*i+1]
+			}
+
+			var b strings.Builder
+			for col := range len(top) {
+				switch {
+				case top[col] == '#' && bottom[col] == '#':
+					b.WriteString("█")
+				case top[col] == '#':
+					b.WriteString("▀")
+				case bottom[col] == '#':
+					b.WriteString("▄")
+				default:
+					b.WriteString(" ")
+				}
+			}
+			glyph[i] = b.String()
+		}
+		f.glyphs[r] = glyph
+	}
+	return f
+}
+
+// bitmapHeight is the pixel height of blockPixels glyphs
+const bitmapHeight = 5
+
+// blockPixels holds the shapes shared by FontBlock and FontCompact
+var blockPixels = map[rune][]string{
+	'A':  {".###.", "#...#", "#####", "#...#", "#...#"},
+	'B':  {"####.", "#...#", "####.", "#...#", "####."},
+	'C':  {".####", "#....", "#....", "#....", ".####"},
+	'D':  {"####.", "#...#", "#...#", "#...#", "####."},
+	'E':  {"#####", "#....", "####.", "#....", "#####"},
+	'F':  {"#####", "#....", "####.", "#....", "#...."},
+	'G':  {".####", "#....", "#..##", "#...#", ".####"},
+	'H':  {"#...#", "#...#", "#####", "#...#", "#...#"},
+	'I':  {"###", ".#.", ".#.", ".#.", "###"},
+	'J':  {"..###", "...#.", "...#.", "#..#.", ".##.."},
+	'K':  {"#...#", "#..#.", "###..", "#..#.", "#...#"},
+	'L':  {"#....", "#....", "#....", "#....", "#####"},
+	'M':  {"#...#", "##.##", "#.#.#", "#...#", "#...#"},
+	'N':  {"#...#", "##..#", "#.#.#", "#..##", "#...#"},
+	'O':  {".###.", "#...#", "#...#", "#...#", ".###."},
+	'P':  {"####.", "#...#", "####.", "#....", "#...."},
+	'Q':  {".###.", "#...#", "#.#.#", "#..#.", ".##.#"},
+	'R':  {"####.", "#...#", "####.", "#..#.", "#...#"},
+	'S':  {".####", "#....", ".###.", "....#", "####."},
+	'T':  {"#####", "..#..", "..#..", "..#..", "..#.."},
+	'U':  {"#...#", "#...#", "#...#", "#...#", ".###."},
+	'V':  {"#...#", "#...#", "#...#", ".#.#.", "..#.."},
+	'W':  {"#...#", "#...#", "#.#.#", "##.##", "#...#"},
+	'X':  {"#...#", ".#.#.", "..#..", ".#.#.", "#...#"},
+	'Y':  {"#...#", ".#.#.", "..#..", "..#..", "..#.."},
+	'Z':  {"#####", "...#.", "..#..", ".#...", "#####"},
+	'0':  {".###.", "#..##", "#.#.#", "##..#", ".###."},
+	'1':  {".#.", "##.", ".#.", ".#.", "###"},
+	'2':  {"####.", "....#", ".###.", "#....", "#####"},
+	'3':  {"####.", "....#", ".###.", "....#", "####."},
+	'4':  {"#...#", "#...#", "#####", "....#", "....#"},
+	'5':  {"#####", "#....", "####.", "....#", "####."},
+	'6':  {".###.", "#....", "####.", "#...#", ".###."},
+	'7':  {"#####", "....#", "...#.", "..#..", "..#.."},
+	'8':  {".###.", "#...#", ".###.", "#...#", ".###."},
+	'9':  {".###.", "#...#", ".####", "....#", ".###."},
+	' ':  {"...", "...", "...", "...", "..."},
+	'!':  {"#", "#", "#", ".", "#"},
+	'?':  {".###.", "#...#", "..##.", ".....", "..#.."},
+	'.':  {".", ".", ".", ".", "#"},
+	',':  {"..", "..", "..", ".#", "#."},
+	':':  {".", "#", ".", "#", "."},
+	'\'': {"#", "#", ".", ".", "."},
+	'-':  {"...", "...", "###", "...", "..."},
+	'+':  {"...", ".#.", "###", ".#.", "..."},
+	'=':  {"...", "###", "...", "###", "..."},
+	'_':  {".....", ".....", ".....", ".....", "#####"},
+	'/':  {"....#", "...#.", "..#..", ".#...", "#...."},
+	'(':  {".#", "#.", "#.", "#.", ".#"},
+	')':  {"#.", ".#", ".#", ".#", "#."},
+}
+
+// figletDeutsch are the optional characters that follow the required
+// ASCII set in a figlet font, in file order
+var figletDeutsch = []rune{'Ä', 'Ö', 'Ü', 'ä', 'ö', 'ü', 'ß'}
+
+// LoadFigletFont reads a figlet (.flf) font file.
+//
+// See ParseFigletFont for what is supported.
+func LoadFigletFont(path string) (*Font, error) {
+	file, err := os.Open(path) //nolint:gosec // G304: loading a user-chosen font is the point
+	if err != nil {
+		return nil, fmt.Errorf("open figlet font: %w", err)
+	}
+	defer func() { _ = file.Close() }()
+
+	return ParseFigletFont(file)
+}
+
+// ParseFigletFont reads a figlet font in the flf2a format.
+//
+// The required ASCII characters, the optional German characters, and
+// code-tagged characters are loaded. Glyphs are set at full width: figlet
+// kerning and smushing rules are not applied.
+//
+// Example:
+//
+//	font, err := LoadFigletFont("fonts/standard.flf")
+//	if err != nil {
+//	    return err
+//	}
+//	fmt.Println(Banner{Text: "Hello", Font: font}.Render(0))
+func ParseFigletFont(r io.Reader) (*Font, error) {
+	scanner := bufio.NewScanner(r)
+	next := func() (string, bool) {
+		if !scanner.Scan() {
+			return "", false
+		}
+		return strings.TrimRight(scanner.Text(), "\r"), true
+	}
+
+	header, ok := next()
+	if !ok {
+		return nil, fmt.Errorf("invalid figlet font: missing header")
+	}
+	fields := strings.Fields(header)
+	if len(fields) < 6 || !strings.HasPrefix(fields[0], "flf2a") || len(fields[0]) <= len("flf2a") {
+		return nil, fmt.Errorf("invalid figlet font header: %q", header)
+	}
+	hardblank, _ := utf8.DecodeRuneInString(fields[0][len("flf2a"):])
+	height, err := strconv.Atoi(fields[1])
+	if err != nil || height < 1 {
+		return nil, fmt.Errorf("invalid figlet font height: %q", fields[1])
+	}
+	comments, err := strconv.Atoi(fields[5])
+	if err != nil || comments < 0 {
+		return nil, fmt.Errorf("invalid figlet font comment count: %q", fields[5])
+	}
+	for range comments {
+		if _, ok := next(); !ok {
+			return nil, fmt.Errorf("invalid figlet font: truncated comments")
+		}
+	}
+
+	f := &Font{height: height, glyphs: make(map[rune][]string)}
+	readGlyph := func() ([]string, error) {
+		rows := make([]string, height)
+		for i := range rows {
+			line, ok := next()
+			if !ok {
+				return nil, io.ErrUnexpectedEOF
+			}
+			rows[i] = figletRow(line, hardblank)
+		}
+		return padRows(rows), nil
+	}
+
+	for code := ' '; code <= '~'; code++ {
+		glyph, err := readGlyph()
+		if err != nil {
+			return nil, fmt.Errorf("invalid figlet font: character %q: %w", code, err)
+		}
+		f.glyphs[code] = glyph
+	}
+
+	// Older fonts end after the ASCII set, so the German set is optional
+	for _, code := range figletDeutsch {
+		glyph, err := readGlyph()
+		if err != nil {
+			if err := scanner.Err(); err != nil {
+				return nil, fmt.Errorf("read figlet font: %w", err)
+			}
+			return f, nil
+		}
+		f.glyphs[code] = glyph
+	}
+
+	for {
+		tag, ok := next()
+		if !ok {
+			break
+		}
+		if strings.TrimSpace(tag) == "" {
+			continue
+		}
+		code, err := strconv.ParseInt(strings.Fields(tag)[0], 0, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid figlet font code tag: %q", tag)
+		}
+		glyph, err := readGlyph()
+		if err != nil {
+			return nil, fmt.Errorf("invalid figlet font: character %d: %w", code, err)
+		}
+		if code >= 0 {
+			f.glyphs[rune(code)] = glyph
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read figlet font: %w", err)
+	}
+	return f, nil
+}
+
+// figletRow strips a glyph row's end marks and turns hardblanks into spaces
+func figletRow(line string, hardblank rune) string {
+	if line == "" {
+		return ""
+	}
+	mark, _ := utf8.DecodeLastRuneInString(line)
+	line = strings.TrimRight(line, string(mark))
+	return strings.ReplaceAll(line, string(hardblank), " ")
+}
+
+// padRows right-pads rows with spaces to the widest row
+func padRows(rows []string) []string {
+	width := 0
+	for _, row := range rows {
+		width = max(width, measure.Width(row))
+	}
+	for i, row := range rows {
+		rows[i] = row + strings.Repeat(" ", width-measure.Width(row))
+	}
+	return rows
+}
@@ -0,0 +1,113 @@
+package tuistyles
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+func TestBuiltinFonts(t *testing.T) {
+	for _, font := range []*Font{FontBlock(), FontCompact()} {
+		for r := range blockPixels {
+			glyph, ok := font.Glyph(r)
+			require.True(t, ok, "glyph %q", r)
+			require.Len(t, glyph, font.Height())
+			for _, row := range glyph {
+				require.Equal(t, measure.Width(glyph[0]), measure.Width(row), "glyph %q rows differ in width", r)
+			}
+		}
+	}
+}
+
+func TestFont_Glyph(t *testing.T) {
+	glyph, ok := FontBlock().Glyph('a')
+	require.True(t, ok, "lowercase falls back to uppercase")
+	require.Equal(t, []string{" ███ ", "█   █", "█████", "█   █", "█   █"}, glyph)
+
+	compact, _ := FontCompact().Glyph('A')
+	require.Equal(t, []string{"▄▀▀▀▄", "█▀▀▀█", "▀   ▀"}, compact)
+
+	_, ok = FontBlock().Glyph('€')
+	require.False(t, ok)
+
+	glyph[0] = "changed"
+	again, _ := FontBlock().Glyph('A')
+	require.Equal(t, " ███ ", again[0], "Glyph returns a copy")
+}
+
+// testFiglet is a 2-row flf2a font with '$' hardblanks and '@' end marks
+func testFiglet(extra string) string {
+	var b strings.Builder
+	b.WriteString("flf2a$ 2 2 8 0 1\n")
+	b.WriteString("test font\n")
+	for r := ' '; r <= '~'; r++ {
+		switch r {
+		case ' ':
+			b.WriteString("$@\n$@@\n")
+		case 'H':
+			b.WriteString("|_|@\n| |@@\n")
+		case 'i':
+			b.WriteString("o@\n|$@@\n")
+		default:
+			b.WriteString(string(r) + "@\n" + string(r) + "@@\n")
+		}
+	}
+	b.WriteString(extra)
+	return b.String()
+}
+
+func TestParseFigletFont(t *testing.T) {
+	font, err := ParseFigletFont(strings.NewReader(testFiglet("")))
+	require.NoError(t, err)
+	require.Equal(t, 2, font.Height())
+
+	glyph, ok := font.Glyph('i')
+	require.True(t, ok)
+	require.Equal(t, []string{"o ", "| "}, glyph, "hardblanks become spaces and rows are padded")
+
+	require.Equal(t, "|_|o \n| || ", Banner{Text: "Hi", Font: font}.Render(0))
+}
+
+func TestParseFigletFont_ExtraCharacters(t *testing.T) {
+	var deutsch strings.Builder
+	for range figletDeutsch {
+		deutsch.WriteString("D@\nD@@\n")
+	}
+	font, err := ParseFigletFont(strings.NewReader(testFiglet(deutsch.String() + "0x263A  SMILE\n:)@\n  @@\n")))
+	require.NoError(t, err)
+
+	glyph, ok := font.Glyph('ß')
+	require.True(t, ok)
+	require.Equal(t, []string{"D", "D"}, glyph)
+
+	glyph, ok = font.Glyph('☺')
+	require.True(t, ok)
+	require.Equal(t, []string{":)", "  "}, glyph)
+}
+
+func TestParseFigletFont_Errors(t *testing.T) {
+	tests := map[string]string{
+		"empty":           "",
+		"bad signature":   "tlf2a$ 2 2 8 0 0\n",
+		"bad height":      "flf2a$ x 2 8 0 0\n",
+		"short header":    "flf2a$ 2 2\n",
+		"truncated":       "flf2a$ 2 2 8 0 0\n$@\n$@@\n",
+		"bad code tag":    testFiglet(strings.Repeat("D@\nD@@\n", 7) + "nope\n"),
+		"truncated glyph": testFiglet(strings.Repeat("D@\nD@@\n", 7) + "300\nx@\n"),
+	}
+
+	for name, src := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := ParseFigletFont(strings.NewReader(src))
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestLoadFigletFont(t *testing.T) {
+	_, err := LoadFigletFont("testdata/does-not-exist.flf")
+	require.Error(t, err)
+}
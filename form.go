@@ -0,0 +1,115 @@
+package tuistyles
+
+import (
+	"strings"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+// FormField is one labeled row of a FormLayout
+type FormField struct {
+	Label    string
+	Value    string
+	Required bool   // Marks the label with "*"
+	Error    string // Shown under the value; the field's label turns the error color too
+}
+
+// FormLayout lays out labeled values in two columns, the label column as
+// wide as the longest label, wrapping long values beside it:
+//
+//	Name*  Ada Lovelace
+//	Email  ada@example
+//	       ✖ not a valid address
+//	Notes  a long note wraps under
+//	       its first line
+//
+// The zero Theme renders with DefaultTheme.
+//
+// Example:
+//
+//	form := FormLayout{Fields: []FormField{
+//	    {Label: "Name", Value: name, Required: true},
+//	    {Label: "Email", Value: email, Error: emailErr},
+//	}}
+//	fmt.Println(form.Render(60))
+type FormLayout struct {
+	Fields     []FormField
+	LabelAlign Position // Left (default) or Right within the label column
+	Gap        int      // Cells between the columns; 2 by default
+	Theme      Theme
+
+	LabelStyle    Style // Bold by default
+	ValueStyle    Style // Unstyled by default
+	RequiredStyle Style // Error color by default
+	ErrorStyle    Style // Error color by default
+}
+
+// Render draws the form within width cells, wrapping values to the space
+// beside the labels. A width of 0 or less never wraps.
+func (f FormLayout) Render(width int) string {
+	if len(f.Fields) == 0 {
+		return ""
+	}
+
+	theme := f.Theme.WithDefaults()
+	labelStyle := orDefault(f.LabelStyle, NewStyle().Bold(true))
+	valueStyle := f.ValueStyle
+	requiredStyle := orDefault(f.RequiredStyle, NewStyle().Foreground(theme.Error))
+	errorStyle := orDefault(f.ErrorStyle, NewStyle().Foreground(theme.Error))
+	gap := f.Gap
+	if gap <= 0 {
+		gap = 2
+	}
+
+	labelWidth := 0
+	for _, field := range f.Fields {
+		labelWidth = max(labelWidth, f.labelWidth(field))
+	}
+	valueWidth := 0
+	if width > 0 {
+		valueWidth = max(width-labelWidth-gap, 1)
+	}
+	indent := strings.Repeat(" ", labelWidth+gap)
+
+	var lines []string
+	for _, field := range f.Fields {
+		style := labelStyle
+		if field.Error != "" {
+			style = errorStyle.inheritInline(labelStyle)
+		}
+		label := style.Render(field.Label)
+		if field.Required {
+			label += requiredStyle.Render("*")
+		}
+		pad := strings.Repeat(" ", labelWidth-f.labelWidth(field))
+		if f.LabelAlign == Right {
+			label = pad + label
+		} else {
+			label += pad
+		}
+
+		values := strings.Split(Wrap(field.Value, valueWidth), "\n")
+		for i, value := range values {
+			prefix := indent
+			if i == 0 {
+				prefix = label + strings.Repeat(" ", gap)
+			}
+			lines = append(lines, strings.TrimRight(prefix+valueStyle.Render(value), " "))
+		}
+		if field.Error != "" {
+			for _, line := range strings.Split(Wrap("✖ "+field.Error, valueWidth), "\n") {
+				lines = append(lines, indent+errorStyle.Render(line))
+			}
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// labelWidth returns the width of a field's label with its required marker
+func (f FormLayout) labelWidth(field FormField) int {
+	w := measure.Width(field.Label)
+	if field.Required {
+		w++
+	}
+	return w
+}
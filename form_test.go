@@ -0,0 +1,67 @@
+package tuistyles
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+func TestFormLayout_Render(t *testing.T) {
+	fields := []FormField{
+		{Label: "Name", Value: "Ada Lovelace", Required: true},
+		{Label: "Email", Value: "ada@example", Error: "not a valid address"},
+		{Label: "Notes", Value: "a long note wraps under its first line"},
+	}
+
+	tests := []struct {
+		name  string
+		form  FormLayout
+		width int
+		want  string
+	}{
+		{"empty", FormLayout{}, 40, ""},
+		{
+			name:  "wraps values beside labels",
+			form:  FormLayout{Fields: fields},
+			width: 30,
+			want: "Name*  Ada Lovelace\n" +
+				"Email  ada@example\n" +
+				"       ✖ not a valid address\n" +
+				"Notes  a long note wraps under\n" +
+				"       its first line",
+		},
+		{
+			name:  "right-aligned labels and custom gap",
+			form:  FormLayout{Fields: fields[:2], LabelAlign: Right, Gap: 1},
+			width: 0,
+			want: "Name* Ada Lovelace\n" +
+				"Email ada@example\n" +
+				"      ✖ not a valid address",
+		},
+		{
+			name:  "empty value",
+			form:  FormLayout{Fields: []FormField{{Label: "A"}, {Label: "Long", Value: "x"}}},
+			width: 20,
+			want:  "A\nLong  x",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, measure.StripANSI(tt.form.Render(tt.width)))
+		})
+	}
+}
+
+func TestFormLayout_ErrorStyling(t *testing.T) {
+	theme := DefaultTheme()
+	form := FormLayout{Fields: []FormField{{Label: "Port", Value: "x", Required: true, Error: "must be a number"}}}
+	got := form.Render(0)
+
+	errorStyle := NewStyle().Foreground(theme.Error)
+	require.Contains(t, got, errorStyle.Bold(true).Render("Port"))
+	require.Contains(t, got, errorStyle.Render("*"))
+	require.Contains(t, got, errorStyle.Render("✖ must be a number"))
+}
@@ -0,0 +1,158 @@
+package tuistyles
+
+import (
+	"strings"
+	"testing"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+// fuzzBorders are the borders a fuzzed style picks from; index 0 is none
+var fuzzBorders = []func() Border{nil, NormalBorder, RoundedBorder, DoubleBorder, BlockBorder, ASCIIBorder}
+
+// fuzzStyle builds a style from fuzzer-chosen numbers, keeping sizes small
+// so each input renders quickly
+func fuzzStyle(width, maxWidth, padding, border, align uint8, attrs uint8) Style {
+	s := NewStyle()
+	if width%24 > 0 {
+		s = s.Width(int(width % 24))
+	}
+	if maxWidth%24 > 0 {
+		s = s.MaxWidth(int(maxWidth % 24))
+	}
+	if padding%4 > 0 {
+		s = s.Padding(int(padding%4)-1, int(padding%3))
+	}
+	if b := fuzzBorders[int(border)%len(fuzzBorders)]; b != nil {
+		s = s.Border(b())
+	}
+	switch align % 4 {
+	case 1:
+		s = s.Align(Left)
+	case 2:
+		s = s.Align(Center)
+	case 3:
+		s = s.Align(Right)
+	}
+	if attrs&1 != 0 {
+		s = s.Bold(true)
+	}
+	if attrs&2 != 0 {
+		s = s.Foreground(Color("#7D56F4"))
+	}
+	if attrs&4 != 0 {
+		s = s.Background(Color("236"))
+	}
+	if attrs&8 != 0 {
+		s = s.Underline(true)
+	}
+	return s
+}
+
+// fuzzText keeps the printable characters and newlines of s, the input
+// Render documents support
+func fuzzText(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '\n' || unicode.IsPrint(r) {
+			return r
+		}
+		return -1
+	}, s)
+}
+
+// checkEscapes fails if out contains an escape sequence that is not a
+// complete CSI or OSC sequence, or leaves SGR styling switched on at the
+// end of a line
+func checkEscapes(t *testing.T, out string) {
+	t.Helper()
+	for i, line := range strings.Split(out, "\n") {
+		styled := false
+		for _, seg := range measure.Segments(line) {
+			if !seg.Escape {
+				continue
+			}
+			text := seg.Text
+			switch {
+			case strings.HasPrefix(text, "\x1b]"):
+				if !strings.HasSuffix(text, "\x07") && !strings.HasSuffix(text, "\x1b\\") {
+					t.Fatalf("line %d: unterminated OSC %q", i, text)
+				}
+			case strings.HasPrefix(text, "\x1b["):
+				final := text[len(text)-1]
+				if len(text) < 3 || final < 0x40 || final > 0x7e {
+					t.Fatalf("line %d: unterminated CSI %q", i, text)
+				}
+				if final == 'm' {
+					styled = text != "\x1b[0m" && text != "\x1b[m"
+				}
+			default:
+				t.Fatalf("line %d: unexpected escape %q", i, text)
+			}
+		}
+		if styled {
+			t.Fatalf("line %d: styling not reset at end of line: %q", i, line)
+		}
+	}
+}
+
+func FuzzRender(f *testing.F) {
+	seeds := []string{
+		"Hello, World!",
+		"",
+		"multi\nline\n\ntext",
+		"你好世界 🎉",
+		"👨‍👩‍👧‍👦 family",
+		"🇯🇵🇺🇸 flags",
+		"é combining é",
+		"a\u200bb zero width",
+		"مرحبا بالعالم",
+		"ｆｕｌｌｗｉｄｔｈ",
+		"a very long line of words that must be wrapped when a max width is set",
+	}
+	for i, s := range seeds {
+		f.Add(s, uint8(i*3), uint8(i*5), uint8(i), uint8(i), uint8(i), uint8(i))
+	}
+
+	f.Fuzz(func(t *testing.T, text string, width, maxWidth, padding, border, align, attrs uint8) {
+		if !utf8.ValidString(text) {
+			return
+		}
+		text = fuzzText(text)
+		s := fuzzStyle(width, maxWidth, padding, border, align, attrs)
+		out := s.Render(text)
+
+		checkEscapes(t, out)
+
+		widths := measure.WidthPerLine(out)
+		for i, line := range strings.Split(out, "\n") {
+			cells := 0
+			measure.EachGrapheme(measure.StripANSI(line), func(_ string, w int) bool {
+				cells += w
+				return true
+			})
+			if cells != widths[i] {
+				t.Fatalf("line %d: Width %d but graphemes cover %d cells: %q", i, widths[i], cells, line)
+			}
+		}
+
+		// Content wider than Width overflows by design, so aligned lines
+		// are only checked to reach the width; borders close every line
+		if s.width != nil && s.align != nil && out != "" {
+			minimum := *s.width + s.horizontalFrame()
+			for i, w := range widths {
+				if w < minimum {
+					t.Fatalf("line %d is %d wide, want at least %d:\n%s", i, w, minimum, out)
+				}
+			}
+		}
+		if s.hasBorder() {
+			for i, w := range widths {
+				if w != widths[0] {
+					t.Fatalf("line %d is %d wide, line 0 is %d:\n%s", i, w, widths[0], out)
+				}
+			}
+		}
+	})
+}
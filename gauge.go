@@ -0,0 +1,169 @@
+package tuistyles
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+// GaugeZone colors a gauge whose value is at or below Max percent. Zones
+// are checked in order; values above every zone use the last one.
+type GaugeZone struct {
+	Max   float64
+	Color Color
+}
+
+// DefaultGaugeZones returns the usual utilization zones: the theme's
+// success color up to 60%, warning up to 85%, and error above.
+func DefaultGaugeZones(theme Theme) []GaugeZone {
+	theme = theme.WithDefaults()
+	return []GaugeZone{
+		{Max: 60, Color: theme.Success},
+		{Max: 85, Color: theme.Warning},
+		{Max: 100, Color: theme.Error},
+	}
+}
+
+// Gauge renders a horizontal meter with an optional label, a bar colored by
+// threshold zones, and the percentage:
+//
+//	CPU    ████████▌░░░░░░░░░░░  45%
+//
+// The bar uses eighth blocks for sub-cell precision unless Fill is set.
+//
+// Example:
+//
+//	for _, m := range metrics {
+//	    fmt.Println(Gauge{Label: m.Name, LabelWidth: 8, Percent: m.Value}.Render(40))
+//	}
+type Gauge struct {
+	Percent     float64 // 0-100; values outside are clamped
+	Label       string  // Shown before the bar
+	LabelWidth  int     // Pads the label so stacked gauges line up
+	HidePercent bool    // Omit the trailing percentage
+	Zones       []GaugeZone
+	Segmented   bool // Color each part of the bar by the zone it spans instead of by Percent
+	Ticks       int  // Divisions marked on a ruler under the bar; 0 for none
+	Fill        string
+	Track       string // Unfilled cells; "░" by default
+	Theme       Theme
+}
+
+// gaugeNaturalBar is the bar width used when Render is given no width
+const gaugeNaturalBar = 20
+
+// eighths are partial block characters for 1/8 to 7/8 of a cell
+var eighths = []string{"▏", "▎", "▍", "▌", "▋", "▊", "▉"}
+
+// Render draws the gauge in width cells, or with a 20-cell bar when width
+// is 0. The bar takes whatever the label and percentage leave, at least 1.
+func (g Gauge) Render(width int) string {
+	theme := g.Theme.WithDefaults()
+	zones := g.Zones
+	if len(zones) == 0 {
+		zones = DefaultGaugeZones(theme)
+	}
+	track := g.Track
+	if track == "" {
+		track = "░"
+	}
+	percent := math.Min(math.Max(g.Percent, 0), 100)
+
+	var prefix, suffix string
+	if g.Label != "" || g.LabelWidth > 0 {
+		label := g.Label + strings.Repeat(" ", max(g.LabelWidth-measure.Width(g.Label), 0))
+		prefix = label + " "
+	}
+	if !g.HidePercent {
+		suffix = fmt.Sprintf(" %3.0f%%", percent)
+	}
+
+	barWidth := gaugeNaturalBar
+	if width > 0 {
+		barWidth = max(width-measure.Width(prefix)-measure.Width(suffix), 1)
+	}
+
+	bar := g.renderBar(percent, barWidth, zones, track, theme)
+	out := prefix + bar + suffix
+	if g.Ticks > 0 {
+		out += "\n" + strings.Repeat(" ", measure.Width(prefix)) + g.ruler(barWidth, theme)
+	}
+	return out
+}
+
+// renderBar draws the filled and unfilled cells of the bar
+func (g Gauge) renderBar(percent float64, width int, zones []GaugeZone, track string, theme Theme) string {
+	// Work in eighths of a cell
+	filled := int(math.Round(percent / 100 * float64(width*8)))
+	full, partial := filled/8, filled%8
+	if g.Fill != "" {
+		full, partial = int(math.Round(percent/100*float64(width))), 0
+	}
+
+	cells := make([]string, 0, width)
+	for range full {
+		cells = append(cells, g.fillChar())
+	}
+	if partial > 0 {
+		cells = append(cells, eighths[partial-1])
+	}
+	filledCells := len(cells)
+
+	var b strings.Builder
+	if g.Segmented {
+		// Color cells by the zone at their midpoint, grouping equal neighbors
+		cellZone := func(i int) Color {
+			return zoneColor(zones, (float64(i)+0.5)/float64(width)*100)
+		}
+		for start := 0; start < filledCells; {
+			color := cellZone(start)
+			end := start + 1
+			for end < filledCells && cellZone(end) == color {
+				end++
+			}
+			b.WriteString(NewStyle().Foreground(color).Render(strings.Join(cells[start:end], "")))
+			start = end
+		}
+	} else if filledCells > 0 {
+		b.WriteString(NewStyle().Foreground(zoneColor(zones, percent)).Render(strings.Join(cells, "")))
+	}
+
+	if rest := width - filledCells; rest > 0 {
+		b.WriteString(NewStyle().Foreground(theme.Muted).Render(strings.Repeat(track, rest)))
+	}
+	return b.String()
+}
+
+// fillChar returns the character for a fully filled cell
+func (g Gauge) fillChar() string {
+	if g.Fill != "" {
+		return g.Fill
+	}
+	return "█"
+}
+
+// ruler draws tick marks dividing the bar into Ticks equal parts
+func (g Gauge) ruler(width int, theme Theme) string {
+	marks := []rune(strings.Repeat("─", width))
+	for i := 0; i <= g.Ticks; i++ {
+		pos := min(i*width/g.Ticks, width-1)
+		marks[pos] = '┴'
+	}
+	marks[0], marks[width-1] = '└', '┘'
+	if width == 1 {
+		marks[0] = '│'
+	}
+	return NewStyle().Foreground(theme.Muted).Render(string(marks))
+}
+
+// zoneColor returns the color of the first zone containing percent
+func zoneColor(zones []GaugeZone, percent float64) Color {
+	for _, zone := range zones {
+		if percent <= zone.Max {
+			return zone.Color
+		}
+	}
+	return zones[len(zones)-1].Color
+}
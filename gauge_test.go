@@ -0,0 +1,83 @@
+package tuistyles
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+func TestGauge_Render(t *testing.T) {
+	tests := []struct {
+		name  string
+		gauge Gauge
+		width int
+		want  string
+	}{
+		{"empty", Gauge{Percent: 0}, 14, "░░░░░░░░░   0%"},
+		{"half", Gauge{Percent: 50}, 14, "████▌░░░░  50%"},
+		{"full", Gauge{Percent: 100}, 14, "█████████ 100%"},
+		{"clamped", Gauge{Percent: 140}, 14, "█████████ 100%"},
+		{"negative", Gauge{Percent: -5}, 14, "░░░░░░░░░   0%"},
+		{"label", Gauge{Label: "CPU", Percent: 50}, 18, "CPU ████▌░░░░  50%"},
+		{"label width", Gauge{Label: "CPU", LabelWidth: 6, Percent: 50}, 21, "CPU    ████▌░░░░  50%"},
+		{"hide percent", Gauge{Percent: 25, HidePercent: true}, 8, "██░░░░░░"},
+		{"custom fill", Gauge{Percent: 50, Fill: "#", Track: "-", HidePercent: true}, 8, "####----"},
+		{"natural width", Gauge{Percent: 50}, 0, "██████████░░░░░░░░░░  50%"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.gauge.Render(tt.width)
+			require.Equal(t, tt.want, measure.StripANSI(got))
+			if tt.width > 0 {
+				require.Equal(t, tt.width, measure.Width(got))
+			}
+		})
+	}
+}
+
+func TestGauge_EighthBlocks(t *testing.T) {
+	got := measure.StripANSI(Gauge{Percent: 5, HidePercent: true}.Render(10))
+
+	require.Equal(t, "▌░░░░░░░░░", got, "5% of 10 cells is half a cell")
+}
+
+func TestGauge_Zones(t *testing.T) {
+	tests := []struct {
+		percent float64
+		code    string
+	}{
+		{30, "\x1b[32m"},
+		{60, "\x1b[32m"},
+		{70, "\x1b[33m"},
+		{90, "\x1b[31m"},
+	}
+
+	for _, tt := range tests {
+		got := Gauge{Percent: tt.percent}.Render(20)
+		require.True(t, strings.HasPrefix(got, tt.code), "%v%% starts with %q: %q", tt.percent, tt.code, got)
+	}
+
+	custom := Gauge{Percent: 10, Zones: []GaugeZone{{Max: 50, Color: Color("#00FFFF")}}}.Render(20)
+	require.True(t, strings.HasPrefix(custom, "\x1b[38;2;0;255;255m"))
+
+	above := Gauge{Percent: 90, Zones: []GaugeZone{{Max: 50, Color: Color("blue")}, {Max: 80, Color: Color("magenta")}}}.Render(20)
+	require.True(t, strings.HasPrefix(above, "\x1b[35m"), "values past every zone use the last")
+}
+
+func TestGauge_Segmented(t *testing.T) {
+	got := Gauge{Percent: 100, Segmented: true, HidePercent: true}.Render(20)
+
+	require.Equal(t, "\x1b[32m"+strings.Repeat("█", 12)+"\x1b[0m"+
+		"\x1b[33m"+strings.Repeat("█", 5)+"\x1b[0m"+
+		"\x1b[31m"+strings.Repeat("█", 3)+"\x1b[0m", got)
+}
+
+func TestGauge_Ticks(t *testing.T) {
+	got := measure.StripANSI(Gauge{Label: "Disk", Percent: 50, Ticks: 4, HidePercent: true}.Render(13))
+
+	require.Equal(t, "Disk ████░░░░\n     └─┴─┴─┴┘", got)
+}
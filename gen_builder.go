@@ -0,0 +1,99 @@
+//go:build ignore
+
+// gen_builder.go writes a StyleBuilder method for every exported Style
+// method that returns a Style, so the builder never falls behind the
+// chaining API.
+//
+// Run with: go generate ./...
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"log"
+	"os"
+	"sort"
+	"strings"
+)
+
+const output = "stylebuilder_gen.go"
+
+// notSetters are Style methods that return a Style without setting a
+// property, and have no builder counterpart
+var notSetters = map[string]bool{"DeepCopy": true, "Resolve": true}
+
+func main() {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, ".", func(fi os.FileInfo) bool {
+		name := fi.Name()
+		return !strings.HasSuffix(name, "_test.go") && !strings.HasPrefix(name, "gen_") && name != output
+	}, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var methods []*ast.FuncDecl
+	for _, file := range pkgs["tuistyles"].Files {
+		for _, decl := range file.Decls {
+			if fn, ok := decl.(*ast.FuncDecl); ok && isStyleSetter(fn) {
+				methods = append(methods, fn)
+			}
+		}
+	}
+	sort.Slice(methods, func(i, j int) bool { return methods[i].Name.Name < methods[j].Name.Name })
+
+	var b bytes.Buffer
+	b.WriteString("// Code generated by go run gen_builder.go; DO NOT EDIT.\n\n")
+	b.WriteString("package tuistyles\n")
+	for _, fn := range methods {
+		var params, args []string
+		for _, field := range fn.Type.Params.List {
+			typ := exprString(fset, field.Type)
+			for _, name := range field.Names {
+				params = append(params, name.Name+" "+typ)
+				if strings.HasPrefix(typ, "...") {
+					args = append(args, name.Name+"...")
+				} else {
+					args = append(args, name.Name)
+				}
+			}
+		}
+		name := fn.Name.Name
+		fmt.Fprintf(&b, "\n// %s sets the style being built like Style.%s.\n", name, name)
+		fmt.Fprintf(&b, "func (b *StyleBuilder) %s(%s) *StyleBuilder {\n", name, strings.Join(params, ", "))
+		fmt.Fprintf(&b, "\tb.style = b.style.%s(%s)\n\treturn b\n}\n", name, strings.Join(args, ", "))
+	}
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(output, src, 0o600); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// isStyleSetter reports whether fn is an exported method on Style that
+// returns a Style
+func isStyleSetter(fn *ast.FuncDecl) bool {
+	if fn.Recv == nil || !fn.Name.IsExported() || notSetters[fn.Name.Name] || fn.Type.Results == nil || len(fn.Type.Results.List) != 1 {
+		return false
+	}
+	recv, ok := fn.Recv.List[0].Type.(*ast.Ident)
+	result, ok2 := fn.Type.Results.List[0].Type.(*ast.Ident)
+	return ok && ok2 && recv.Name == "Style" && result.Name == "Style"
+}
+
+// exprString prints a type expression as source
+func exprString(fset *token.FileSet, expr ast.Expr) string {
+	var b bytes.Buffer
+	if err := printer.Fprint(&b, fset, expr); err != nil {
+		log.Fatal(err)
+	}
+	return b.String()
+}
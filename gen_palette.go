@@ -0,0 +1,41 @@
+//go:build ignore
+
+// gen_palette.go precomputes CIE L*a*b* values for the xterm 256-color
+// palette so Color.To256 and Color.To16 avoid per-call conversions.
+//
+// Run with: go generate ./...
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+
+	"github.com/orchard9/tui-styles/internal/ansi"
+	"github.com/orchard9/tui-styles/internal/colorspace"
+)
+
+func main() {
+	var b bytes.Buffer
+	b.WriteString("// Code generated by go run gen_palette.go; DO NOT EDIT.\n\n")
+	b.WriteString("package tuistyles\n\n")
+	b.WriteString("import \"github.com/orchard9/tui-styles/internal/colorspace\"\n\n")
+	b.WriteString("// paletteLab holds the CIE L*a*b* value of each xterm 256-color code\n")
+	b.WriteString("var paletteLab = [256]colorspace.Lab{\n")
+	for code := 0; code < 256; code++ {
+		r, g, bl := ansi.PaletteRGB(code)
+		l := colorspace.RGBToLab(uint8(r), uint8(g), uint8(bl)) //nolint:gosec // G115: palette values are 0-255
+		fmt.Fprintf(&b, "\t{L: %.4f, A: %.4f, B: %.4f}, // %d\n", l.L, l.A, l.B, code)
+	}
+	b.WriteString("}\n")
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("palette_lab.go", src, 0o600); err != nil {
+		log.Fatal(err)
+	}
+}
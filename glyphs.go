@@ -0,0 +1,215 @@
+package tuistyles
+
+import (
+	"os"
+	"strings"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+// GlyphSupport describes which non-ASCII characters a terminal can display
+type GlyphSupport int
+
+const (
+	// GlyphsFull supports all Unicode box drawing and block elements
+	GlyphsFull GlyphSupport = iota
+	// GlyphsBoxDrawing supports only the basic single and double box drawing
+	// set found in legacy console fonts (no rounded corners, heavy lines, or
+	// quadrant blocks)
+	GlyphsBoxDrawing
+	// GlyphsASCII supports 7-bit ASCII only
+	GlyphsASCII
+)
+
+// String returns human-readable glyph support level
+func (g GlyphSupport) String() string {
+	switch g {
+	case GlyphsFull:
+		return "Full"
+	case GlyphsBoxDrawing:
+		return "BoxDrawing"
+	case GlyphsASCII:
+		return "ASCII"
+	default:
+		return "Unknown"
+	}
+}
+
+// boxDrawingFallbacks maps glyphs outside the legacy console set to the
+// closest glyph inside it
+var boxDrawingFallbacks = map[string]string{
+	"╭": "┌", "╮": "┐", "╰": "└", "╯": "┘",
+	"━": "─", "┃": "│", "┏": "┌", "┓": "┐", "┗": "└", "┛": "┘",
+	"▛": "█", "▜": "█", "▙": "█", "▟": "█",
+	"▗": "▄", "▖": "▄", "▝": "▀", "▘": "▀",
+	"┣": "├", "┫": "┤", "┳": "┬", "┻": "┴", "╋": "┼",
+}
+
+// asciiFallbacks maps border, block, bullet, arrow, and punctuation glyphs
+// to 7-bit equivalents of the same width
+var asciiFallbacks = map[string]string{
+	"─": "-", "━": "-", "═": "=",
+	"│": "|", "┃": "|", "║": "|",
+	"┌": "+", "┐": "+", "└": "+", "┘": "+",
+	"╭": "+", "╮": "+", "╰": "+", "╯": "+",
+	"┏": "+", "┓": "+", "┗": "+", "┛": "+",
+	"╔": "+", "╗": "+", "╚": "+", "╝": "+",
+	"█": "#", "▀": "#", "▄": "#", "▌": "#", "▐": "#",
+	"▛": "#", "▜": "#", "▙": "#", "▟": "#",
+	"▗": "#", "▖": "#", "▝": "#", "▘": "#",
+	"├": "+", "┤": "+", "┬": "+", "┴": "+", "┼": "+",
+	"┣": "+", "┫": "+", "┳": "+", "┻": "+", "╋": "+",
+	"╠": "+", "╣": "+", "╦": "+", "╩": "+", "╬": "+",
+	"░": ".", "▒": ":", "▓": "#",
+	"•": "*", "·": ".", "◦": "o", "▪": "*", "■": "#", "□": "o",
+	"●": "*", "○": "o", "◆": "*", "◇": "o",
+	"▸": ">", "▶": ">", "►": ">", "◂": "<", "◀": "<", "◄": "<",
+	"▴": "^", "▲": "^", "▾": "v", "▼": "v",
+	"→": ">", "←": "<", "↑": "^", "↓": "v", "↔": "-", "⇒": ">", "⇐": "<",
+	"…": ".", "–": "-", "—": "-", "✓": "v", "✔": "v", "✗": "x", "✘": "x",
+	"“": "\"", "”": "\"", "‘": "'", "’": "'",
+}
+
+// fallbackGlyph returns the replacement for a single glyph at the given support level
+func fallbackGlyph(g string, level GlyphSupport) string {
+	switch level {
+	case GlyphsBoxDrawing:
+		if r, ok := boxDrawingFallbacks[g]; ok {
+			return r
+		}
+	case GlyphsASCII:
+		if r, ok := asciiFallbacks[g]; ok {
+			return r
+		}
+	}
+	return g
+}
+
+// fallbackGlyphs replaces every glyph in s that the support level cannot display
+func fallbackGlyphs(s string, level GlyphSupport) string {
+	if level == GlyphsFull {
+		return s
+	}
+	var b strings.Builder
+	for _, r := range s {
+		b.WriteString(fallbackGlyph(string(r), level))
+	}
+	return b.String()
+}
+
+// FallbackGlyphs replaces every glyph in s that the support level cannot
+// display with the closest equivalent it can, as Renderer.Render does for
+// its output: box drawing, bullets, arrows, shades, and typographic
+// punctuation become ASCII under GlyphsASCII, and rounded and heavy lines
+// become plain box drawing under GlyphsBoxDrawing. Every replacement is as
+// wide as the glyph it replaces, so layouts keep their shape. Escape
+// sequences are left untouched.
+//
+// Example:
+//
+//	FallbackGlyphs("• done → next", GlyphsASCII) // "* done > next"
+func FallbackGlyphs(s string, level GlyphSupport) string {
+	return translateGlyphs(s, level, nil)
+}
+
+// translateGlyphs replaces glyphs in the text of s, first from custom and
+// then with the fallbacks for level. A replacement narrower than its glyph
+// is padded with spaces; a wider one is ignored.
+func translateGlyphs(s string, level GlyphSupport, custom map[string]string) string {
+	if level == GlyphsFull && len(custom) == 0 {
+		return s
+	}
+
+	var b strings.Builder
+	b.Grow(len(s))
+	for _, seg := range measure.Segments(s) {
+		if seg.Escape {
+			b.WriteString(seg.Text)
+			continue
+		}
+		for _, r := range seg.Text {
+			g := string(r)
+			repl, ok := custom[g]
+			if !ok {
+				repl = fallbackGlyph(g, level)
+			}
+			width, replWidth := measure.Width(g), measure.Width(repl)
+			switch {
+			case replWidth > width:
+				b.WriteString(g)
+			default:
+				b.WriteString(repl)
+				b.WriteString(strings.Repeat(" ", width-replWidth))
+			}
+		}
+	}
+	return b.String()
+}
+
+// Fallback returns a copy of the border with every character the given
+// support level cannot display swapped for the closest safe equivalent:
+// rounded and heavy corners become plain box drawing, and under GlyphsASCII
+// everything becomes +, -, and |.
+//
+// Example:
+//
+//	RoundedBorder().Fallback(GlyphsBoxDrawing) == NormalBorder() // true
+func (b Border) Fallback(level GlyphSupport) Border {
+	return Border{
+		Top:         fallbackGlyphs(b.Top, level),
+		Bottom:      fallbackGlyphs(b.Bottom, level),
+		Left:        fallbackGlyphs(b.Left, level),
+		Right:       fallbackGlyphs(b.Right, level),
+		TopLeft:     fallbackGlyphs(b.TopLeft, level),
+		TopRight:    fallbackGlyphs(b.TopRight, level),
+		BottomLeft:  fallbackGlyphs(b.BottomLeft, level),
+		BottomRight: fallbackGlyphs(b.BottomRight, level),
+	}
+}
+
+// DetectGlyphSupport probes the environment for the glyphs the terminal is
+// likely to render.
+//
+// Heuristics: a non-UTF-8 locale (LC_ALL, LC_CTYPE, LANG) or TERM=dumb means
+// ASCII only; the Linux virtual console (TERM=linux) lacks rounded corners
+// and quadrant blocks in its default font; everything else is assumed to
+// support full Unicode. Detection cannot see the font itself, so apps should
+// still offer an explicit override (see Renderer.SafeBorders).
+func DetectGlyphSupport() GlyphSupport {
+	return detectGlyphSupport(os.Getenv)
+}
+
+// detectGlyphSupport implements DetectGlyphSupport with an injectable environment
+func detectGlyphSupport(getenv func(string) string) GlyphSupport {
+	term := getenv("TERM")
+	if term == "dumb" {
+		return GlyphsASCII
+	}
+
+	if locale := activeLocale(getenv); locale != "" && !isUTF8Locale(locale) {
+		return GlyphsASCII
+	}
+
+	if term == "linux" {
+		return GlyphsBoxDrawing
+	}
+
+	return GlyphsFull
+}
+
+// activeLocale returns the locale governing character encoding, following
+// POSIX precedence (LC_ALL, then LC_CTYPE, then LANG)
+func activeLocale(getenv func(string) string) string {
+	for _, key := range []string{"LC_ALL", "LC_CTYPE", "LANG"} {
+		if v := getenv(key); v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// isUTF8Locale reports whether a locale name selects UTF-8 encoding
+func isUTF8Locale(locale string) bool {
+	l := strings.ToLower(locale)
+	return strings.Contains(l, "utf-8") || strings.Contains(l, "utf8")
+}
@@ -0,0 +1,121 @@
+package tuistyles
+
+import "testing"
+
+func TestDetectGlyphSupport(t *testing.T) {
+	tests := []struct {
+		name string
+		env  map[string]string
+		want GlyphSupport
+	}{
+		{"empty environment", map[string]string{}, GlyphsFull},
+		{"UTF-8 LANG", map[string]string{"LANG": "en_US.UTF-8"}, GlyphsFull},
+		{"utf8 spelling", map[string]string{"LANG": "de_DE.utf8"}, GlyphsFull},
+		{"C locale", map[string]string{"LANG": "C"}, GlyphsASCII},
+		{"LC_ALL wins over LANG", map[string]string{"LC_ALL": "POSIX", "LANG": "en_US.UTF-8"}, GlyphsASCII},
+		{"LC_CTYPE wins over LANG", map[string]string{"LC_CTYPE": "en_US.UTF-8", "LANG": "C"}, GlyphsFull},
+		{"dumb terminal", map[string]string{"TERM": "dumb", "LANG": "en_US.UTF-8"}, GlyphsASCII},
+		{"linux console", map[string]string{"TERM": "linux", "LANG": "en_US.UTF-8"}, GlyphsBoxDrawing},
+		{"xterm", map[string]string{"TERM": "xterm-256color", "LANG": "en_US.UTF-8"}, GlyphsFull},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := detectGlyphSupport(func(k string) string { return tt.env[k] })
+			if got != tt.want {
+				t.Errorf("detectGlyphSupport() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBorder_Fallback(t *testing.T) {
+	tests := []struct {
+		name   string
+		border Border
+		level  GlyphSupport
+		want   Border
+	}{
+		{"full keeps rounded", RoundedBorder(), GlyphsFull, RoundedBorder()},
+		{"rounded to normal", RoundedBorder(), GlyphsBoxDrawing, NormalBorder()},
+		{"thick to normal", ThickBorder(), GlyphsBoxDrawing, NormalBorder()},
+		{"double kept in box drawing", DoubleBorder(), GlyphsBoxDrawing, DoubleBorder()},
+		{"normal to ASCII", NormalBorder(), GlyphsASCII, ASCIIBorder()},
+		{"rounded to ASCII", RoundedBorder(), GlyphsASCII, ASCIIBorder()},
+		{"hidden unchanged", HiddenBorder(), GlyphsASCII, HiddenBorder()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.border.Fallback(tt.level); got != tt.want {
+				t.Errorf("Fallback(%s) = %+v, want %+v", tt.level, got, tt.want)
+			}
+		})
+	}
+
+	// Every predefined border must be pure ASCII after an ASCII fallback
+	for _, nb := range namedBorders {
+		b := nb.border().Fallback(GlyphsASCII)
+		for _, part := range []string{b.Top, b.Bottom, b.Left, b.Right, b.TopLeft, b.TopRight, b.BottomLeft, b.BottomRight} {
+			for _, r := range part {
+				if r > 127 {
+					t.Errorf("%s border has non-ASCII glyph %q after fallback", nb.name, r)
+				}
+			}
+		}
+	}
+}
+
+func TestGlyphSupport_String(t *testing.T) {
+	if GlyphsBoxDrawing.String() != "BoxDrawing" || GlyphSupport(9).String() != "Unknown" {
+		t.Error("unexpected GlyphSupport.String() output")
+	}
+}
+
+func TestFallbackGlyphs(t *testing.T) {
+	tests := []struct {
+		name  string
+		in    string
+		level GlyphSupport
+		want  string
+	}{
+		{"full unchanged", "• a → b", GlyphsFull, "• a → b"},
+		{"bullets and arrows", "• a → b…", GlyphsASCII, "* a > b."},
+		{"table junctions", "├─┼─┤", GlyphsASCII, "+-+-+"},
+		{"heavy to light", "┣━╋", GlyphsBoxDrawing, "├─┼"},
+		{"box drawing keeps bullets", "• ok", GlyphsBoxDrawing, "• ok"},
+		{"escapes untouched", "\x1b[31m▸\x1b[0m x", GlyphsASCII, "\x1b[31m>\x1b[0m x"},
+		{"unknown glyph kept", "日本", GlyphsASCII, "日本"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FallbackGlyphs(tt.in, tt.level); got != tt.want {
+				t.Errorf("FallbackGlyphs(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+
+	// Every ASCII fallback keeps the width of its glyph
+	for glyph, repl := range asciiFallbacks {
+		if Width(glyph) != Width(repl) {
+			t.Errorf("fallback %q -> %q changes width", glyph, repl)
+		}
+	}
+}
+
+func TestRenderer_GlyphMap(t *testing.T) {
+	custom := map[string]string{"▸": "-", "✓": "", "a": "wide"}
+	r := Renderer{}.GlyphSupport(GlyphsASCII).GlyphMap(custom)
+	custom["▸"] = "changed"
+
+	got := r.Render(NewStyle(), "▸ ✓ a •")
+	if want := "-   a *"; got != want {
+		t.Errorf("Render() = %q, want %q", got, want)
+	}
+
+	full := Renderer{}.GlyphMap(map[string]string{"▸": ">"})
+	if got := full.Render(NewStyle(), "▸ •"); got != "> •" {
+		t.Errorf("Render() at full support = %q, want %q", got, "> •")
+	}
+}
@@ -181,3 +181,59 @@ func TestGoldenSnapshots(t *testing.T) {
 		})
 	}
 }
+
+// TestGoldenSnapshots_Profiles pins the escape sequences each color profile
+// produces, so changes to color conversion show up as reviewable diffs
+func TestGoldenSnapshots_Profiles(t *testing.T) {
+	styles := []struct {
+		name  string
+		style Style
+		input string
+	}{
+		{
+			name:  "hex_colors",
+			style: NewStyle().Foreground(Color("#F72798")).Background(Color("#1E1E2E")),
+			input: "Hex",
+		},
+		{
+			name:  "named_and_256",
+			style: NewStyle().Foreground(Color("bright-cyan")).Background(Color("236")),
+			input: "Mixed",
+		},
+		{
+			name: "bordered_panel",
+			style: NewStyle().
+				Bold(true).
+				Foreground(Color("#FAFAFA")).
+				Background(Color("#7D56F4")).
+				Padding(1, 2).
+				Border(RoundedBorder()).
+				BorderForeground(Color("#04B575")),
+			input: "Panel\n你好 🎉",
+		},
+	}
+	profiles := []ColorProfile{ProfileTrueColor, ProfileANSI256, ProfileANSI16}
+
+	for _, tt := range styles {
+		for _, p := range profiles {
+			name := tt.name + "." + p.String()
+			t.Run(name, func(t *testing.T) {
+				output := Renderer{}.GlyphSupport(GlyphsFull).ColorProfile(p).Render(tt.style, tt.input)
+				goldenFile := filepath.Join("testdata", "golden", "profiles", name+".golden")
+
+				if *update {
+					err := os.MkdirAll(filepath.Dir(goldenFile), 0750)
+					require.NoError(t, err)
+					err = os.WriteFile(goldenFile, []byte(output), 0600)
+					require.NoError(t, err)
+					t.Logf("Updated golden file: %s", goldenFile)
+					return
+				}
+
+				expected, err := os.ReadFile(goldenFile) //nolint:gosec // path built from test names
+				require.NoError(t, err, "failed to read golden file: %s", goldenFile)
+				require.Equal(t, string(expected), output)
+			})
+		}
+	}
+}
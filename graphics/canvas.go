@@ -0,0 +1,214 @@
+package graphics
+
+import (
+	"math"
+	"strings"
+
+	tuistyles "github.com/orchard9/tui-styles"
+	"github.com/orchard9/tui-styles/internal/ansi"
+	"github.com/orchard9/tui-styles/internal/braille"
+)
+
+// Canvas is a drawing surface of braille cells. Each cell holds a 2x4 grid
+// of dots, so a 20x5 canvas plots at 40x20 resolution.
+//
+// Dot coordinates start at the top-left corner, with y growing downward.
+// Drawing outside the canvas is clipped. Unlike styles, a Canvas is
+// mutable: create one per frame or call Clear.
+//
+// Example:
+//
+//	c := graphics.NewCanvas(20, 4)
+//	c.SetPen(tuistyles.Color("green"))
+//	c.PlotSeries(latencies)
+//	fmt.Println(c.Render())
+type Canvas struct {
+	width, height int // in cells
+	dots          []rune
+	colors        []tuistyles.Color
+	pen           tuistyles.Color
+}
+
+// NewCanvas returns an empty canvas of width x height cells. A size of 0
+// or less in either dimension gives an empty canvas.
+func NewCanvas(width, height int) *Canvas {
+	if width <= 0 || height <= 0 {
+		width, height = 0, 0
+	}
+	return &Canvas{
+		width:  width,
+		height: height,
+		dots:   make([]rune, width*height),
+		colors: make([]tuistyles.Color, width*height),
+	}
+}
+
+// Size returns the canvas size in cells.
+func (c *Canvas) Size() (width, height int) {
+	return c.width, c.height
+}
+
+// Resolution returns the canvas size in dots.
+func (c *Canvas) Resolution() (width, height int) {
+	return c.width * 2, c.height * 4
+}
+
+// Bounds returns the drawable area in dots, starting at (0, 0).
+func (c *Canvas) Bounds() tuistyles.Rect {
+	w, h := c.Resolution()
+	return tuistyles.Rect{Width: w, Height: h}
+}
+
+// SetPen sets the color of the cells touched by later drawing. A cell takes
+// the color of the last dot drawn in it; "" uses the terminal default.
+func (c *Canvas) SetPen(color tuistyles.Color) {
+	c.pen = color
+}
+
+// Clear lowers every dot and resets cell colors.
+func (c *Canvas) Clear() {
+	clear(c.dots)
+	clear(c.colors)
+}
+
+// Point raises the dot at (x, y).
+func (c *Canvas) Point(x, y int) {
+	if x < 0 || y < 0 || x >= c.width*2 || y >= c.height*4 {
+		return
+	}
+	cell := y/4*c.width + x/2
+	c.dots[cell] |= braille.Dot(x%2, y%4)
+	c.colors[cell] = c.pen
+}
+
+// Line draws a straight line of dots from (x0, y0) to (x1, y1), inclusive.
+func (c *Canvas) Line(x0, y0, x1, y1 int) {
+	dx, dy := abs(x1-x0), -abs(y1-y0)
+	sx, sy := 1, 1
+	if x0 > x1 {
+		sx = -1
+	}
+	if y0 > y1 {
+		sy = -1
+	}
+
+	// Bresenham's algorithm, valid for every octant
+	err := dx + dy
+	for {
+		c.Point(x0, y0)
+		if x0 == x1 && y0 == y1 {
+			return
+		}
+		e2 := 2 * err
+		if e2 >= dy {
+			err += dy
+			x0 += sx
+		}
+		if e2 <= dx {
+			err += dx
+			y0 += sy
+		}
+	}
+}
+
+// Rect draws the outline of r, in dots. An empty r draws nothing.
+func (c *Canvas) Rect(r tuistyles.Rect) {
+	if r.Empty() {
+		return
+	}
+	x1, y1 := r.X+r.Width-1, r.Y+r.Height-1
+	c.Line(r.X, r.Y, x1, r.Y)
+	c.Line(x1, r.Y, x1, y1)
+	c.Line(x1, y1, r.X, y1)
+	c.Line(r.X, y1, r.X, r.Y)
+}
+
+// PlotSeries draws values as a connected line graph spread across the full
+// canvas width, scaled so the smallest value touches the bottom and the
+// largest the top. NaN and infinite values leave gaps.
+func (c *Canvas) PlotSeries(values []float64) {
+	lo, hi := math.Inf(1), math.Inf(-1)
+	for _, v := range values {
+		if !math.IsNaN(v) && !math.IsInf(v, 0) {
+			lo, hi = math.Min(lo, v), math.Max(hi, v)
+		}
+	}
+	c.PlotSeriesRange(values, lo, hi)
+}
+
+// PlotSeriesRange is like PlotSeries with a fixed value range: lo maps to
+// the bottom row of dots and hi to the top. Values outside are clamped, and
+// a flat range (lo == hi) draws along the middle.
+func (c *Canvas) PlotSeriesRange(values []float64, lo, hi float64) {
+	dotsW, dotsH := c.Resolution()
+	if len(values) == 0 || dotsW == 0 || dotsH == 0 {
+		return
+	}
+
+	prevX, prevY, havePrev := 0, 0, false
+	for i, v := range values {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			havePrev = false
+			continue
+		}
+
+		x := 0
+		if len(values) > 1 {
+			x = int(math.Round(float64(i) * float64(dotsW-1) / float64(len(values)-1)))
+		}
+		y := (dotsH - 1) / 2
+		if hi > lo {
+			frac := (math.Min(math.Max(v, lo), hi) - lo) / (hi - lo)
+			y = int(math.Round((1 - frac) * float64(dotsH-1)))
+		}
+
+		if havePrev {
+			c.Line(prevX, prevY, x, y)
+		} else {
+			c.Point(x, y)
+		}
+		prevX, prevY, havePrev = x, y, true
+	}
+}
+
+// Render returns the canvas as lines of braille characters. Empty cells are
+// spaces, and each line with colored cells ends with a reset.
+func (c *Canvas) Render() string {
+	lines := make([]string, c.height)
+	for row := range c.height {
+		var b strings.Builder
+		var current tuistyles.Color
+		for col := range c.width {
+			cell := row*c.width + col
+			if c.dots[cell] == 0 {
+				b.WriteString(" ")
+				continue
+			}
+
+			if color := c.colors[cell]; color != current {
+				if color == "" {
+					b.WriteString(ansi.DefaultForeground())
+				} else {
+					b.WriteString(ansi.ForegroundColor(string(color)))
+				}
+				current = color
+			}
+			b.WriteRune(braille.Base + c.dots[cell])
+		}
+
+		line := b.String()
+		if strings.Contains(line, "\x1b[") {
+			line += ansi.Reset()
+		}
+		lines[row] = line
+	}
+	return strings.Join(lines, "\n")
+}
+
+// BrailleSparkline plots values on a one-row canvas of the given width,
+// four dots tall, for inline trends such as "cpu ⠤⠒⠉⠒⠤".
+func BrailleSparkline(values []float64, width int) string {
+	c := NewCanvas(width, 1)
+	c.PlotSeries(values)
+	return c.Render()
+}
@@ -0,0 +1,120 @@
+package graphics
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tuistyles "github.com/orchard9/tui-styles"
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+func TestCanvas_Point(t *testing.T) {
+	c := NewCanvas(2, 1)
+	c.Point(0, 0)
+	c.Point(3, 3)
+	c.Point(-1, 0) // clipped
+	c.Point(4, 0)  // clipped
+	c.Point(0, 4)  // clipped
+
+	require.Equal(t, "⠁⢀", c.Render())
+}
+
+func TestCanvas_SizeAndResolution(t *testing.T) {
+	c := NewCanvas(20, 5)
+	w, h := c.Size()
+	require.Equal(t, []int{20, 5}, []int{w, h})
+	w, h = c.Resolution()
+	require.Equal(t, []int{40, 20}, []int{w, h})
+
+	require.Equal(t, tuistyles.Rect{Width: 40, Height: 20}, c.Bounds())
+
+	require.Empty(t, NewCanvas(-1, 3).Render(), "negative sizes clamp to empty")
+}
+
+func TestCanvas_Rect(t *testing.T) {
+	c := NewCanvas(2, 1)
+	c.Rect(c.Bounds())
+	require.Equal(t, "⣏⣹", c.Render())
+
+	c.Clear()
+	c.Rect(tuistyles.Rect{X: 1, Y: 1})
+	require.Equal(t, "  ", c.Render(), "empty rects draw nothing")
+}
+
+func TestCanvas_Line(t *testing.T) {
+	tests := []struct {
+		name           string
+		x0, y0, x1, y1 int
+		want           string
+	}{
+		{"horizontal", 0, 3, 5, 3, "⣀⣀⣀"},
+		{"vertical", 0, 0, 0, 3, "⡇  "},
+		{"diagonal", 0, 0, 1, 1, "⠑  "},
+		{"reversed", 1, 1, 0, 0, "⠑  "},
+		{"clipped", -4, 0, 9, 0, "⠉⠉⠉"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewCanvas(3, 1)
+			c.Line(tt.x0, tt.y0, tt.x1, tt.y1)
+			require.Equal(t, tt.want, c.Render())
+		})
+	}
+}
+
+func TestCanvas_PlotSeries(t *testing.T) {
+	c := NewCanvas(2, 1)
+	c.PlotSeries([]float64{0, 3})
+
+	// A rising line from the bottom-left dot to the top-right dot
+	require.Equal(t, "⡠⠊", c.Render())
+}
+
+func TestCanvas_PlotSeriesRange(t *testing.T) {
+	c := NewCanvas(2, 1)
+	c.PlotSeriesRange([]float64{-10, 10}, 0, 1)
+	require.Equal(t, "⡠⠊", c.Render(), "out-of-range values clamp to the edges")
+
+	flat := NewCanvas(2, 1)
+	flat.PlotSeries([]float64{5, 5, 5, 5})
+	require.Equal(t, "⠒⠒", flat.Render(), "flat series runs along the middle")
+}
+
+func TestCanvas_PlotSeriesGaps(t *testing.T) {
+	c := NewCanvas(2, 1)
+	c.PlotSeriesRange([]float64{1, math.NaN(), math.NaN(), 1}, 0, 1)
+
+	require.Equal(t, "⠁⠈", c.Render(), "NaN breaks the line")
+}
+
+func TestCanvas_Colors(t *testing.T) {
+	c := NewCanvas(3, 1)
+	c.SetPen(tuistyles.Color("red"))
+	c.Point(0, 0)
+	c.Point(2, 0)
+	c.SetPen("")
+	c.Point(4, 0)
+
+	require.Equal(t, "\x1b[31m⠁⠁\x1b[39m⠁\x1b[0m", c.Render())
+}
+
+func TestCanvas_Clear(t *testing.T) {
+	c := NewCanvas(2, 2)
+	c.SetPen(tuistyles.Color("red"))
+	c.Line(0, 0, 3, 7)
+	c.Clear()
+
+	require.Equal(t, "  \n  ", c.Render())
+}
+
+func TestBrailleSparkline(t *testing.T) {
+	got := BrailleSparkline([]float64{1, 4, 2, 8, 5, 7, 3}, 6)
+
+	require.Equal(t, 6, measure.Width(got))
+	require.NotContains(t, got, "\n")
+	require.Equal(t, strings.Repeat(" ", 4), BrailleSparkline(nil, 4))
+}
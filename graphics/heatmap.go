@@ -0,0 +1,137 @@
+package graphics
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	tuistyles "github.com/orchard9/tui-styles"
+	"github.com/orchard9/tui-styles/internal/ansi"
+)
+
+// DefaultHeatmapRamp returns the default heatmap colors, from low to high:
+// five greens like a contribution calendar.
+func DefaultHeatmapRamp() []tuistyles.Color {
+	return []tuistyles.Color{"#161b22", "#0e4429", "#006d32", "#26a641", "#39d353"}
+}
+
+// Heatmap draws a grid of values as colored background cells, one row of
+// cells per row of values, for activity calendars and latency matrices.
+// Each value picks a color from Ramp by where it falls between Min and
+// Max; NaN values are left blank, for missing data. Rows may differ in
+// length.
+//
+// Example:
+//
+//	h := graphics.Heatmap{
+//	    Values: latency,
+//	    Ramp:   tuistyles.Gradient(8, "#1a9850", "#fee08b", "#d73027"),
+//	}
+//	fmt.Println(h.Render())
+//	fmt.Println(h.Legend())
+type Heatmap struct {
+	Values    [][]float64
+	Ramp      []tuistyles.Color // Low to high; DefaultHeatmapRamp when empty
+	CellWidth int               // Terminal cells per value; 2 by default, which looks square
+	Min, Max  float64           // Value range; taken from Values when Min >= Max
+}
+
+// Render returns the heatmap, each line ending with a reset.
+func (h Heatmap) Render() string {
+	ramp, width := h.ramp(), h.cellWidth()
+	lo, hi := h.bounds()
+	blank := strings.Repeat(" ", width)
+
+	lines := make([]string, len(h.Values))
+	for row, values := range h.Values {
+		var b strings.Builder
+		colored := false
+		for _, v := range values {
+			if math.IsNaN(v) {
+				if colored {
+					b.WriteString(ansi.Reset())
+					colored = false
+				}
+				b.WriteString(blank)
+				continue
+			}
+			b.WriteString(ramp[rampIndex(v, lo, hi, len(ramp))].ToANSIBackground())
+			b.WriteString(blank)
+			colored = true
+		}
+		if colored {
+			b.WriteString(ansi.Reset())
+		}
+		lines[row] = b.String()
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Legend returns a one-line key for the heatmap: the low end of the range,
+// a swatch of every ramp color, and the high end.
+func (h Heatmap) Legend() string {
+	ramp, width := h.ramp(), h.cellWidth()
+	lo, hi := h.bounds()
+
+	var b strings.Builder
+	b.WriteString(formatValue(lo))
+	b.WriteString(" ")
+	for _, c := range ramp {
+		b.WriteString(c.ToANSIBackground())
+		b.WriteString(strings.Repeat(" ", width))
+	}
+	b.WriteString(ansi.Reset())
+	b.WriteString(" ")
+	b.WriteString(formatValue(hi))
+	return b.String()
+}
+
+// ramp returns the heatmap's colors
+func (h Heatmap) ramp() []tuistyles.Color {
+	if len(h.Ramp) == 0 {
+		return DefaultHeatmapRamp()
+	}
+	return h.Ramp
+}
+
+// cellWidth returns the terminal cells drawn per value
+func (h Heatmap) cellWidth() int {
+	if h.CellWidth <= 0 {
+		return 2
+	}
+	return h.CellWidth
+}
+
+// bounds returns the value range, from Min and Max or from the values
+func (h Heatmap) bounds() (lo, hi float64) {
+	if h.Min < h.Max {
+		return h.Min, h.Max
+	}
+	lo, hi = math.Inf(1), math.Inf(-1)
+	for _, row := range h.Values {
+		for _, v := range row {
+			if !math.IsNaN(v) {
+				lo, hi = min(lo, v), max(hi, v)
+			}
+		}
+	}
+	if lo > hi {
+		return 0, 0
+	}
+	return lo, hi
+}
+
+// rampIndex maps v within [lo, hi] to one of n colors, clamping values
+// outside the range; an empty range maps everything to the first color
+func rampIndex(v, lo, hi float64, n int) int {
+	if hi <= lo {
+		return 0
+	}
+	t := (v - lo) / (hi - lo)
+	return min(max(int(t*float64(n)), 0), n-1)
+}
+
+// formatValue formats a legend value compactly
+func formatValue(v float64) string {
+	return strconv.FormatFloat(v, 'g', 4, 64)
+}
@@ -0,0 +1,54 @@
+package graphics
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tuistyles "github.com/orchard9/tui-styles"
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+func TestHeatmap_Render(t *testing.T) {
+	ramp := []tuistyles.Color{"#000000", "#808080", "#ffffff"}
+	h := Heatmap{
+		Values: [][]float64{{0, 5, 10}, {math.NaN(), 10}},
+		Ramp:   ramp,
+	}
+
+	got := h.Render()
+	lines := strings.Split(got, "\n")
+	require.Len(t, lines, 2)
+	require.Equal(t, []int{6, 4}, measure.WidthPerLine(got))
+
+	bg := func(c tuistyles.Color) string { return c.ToANSIBackground() + "  " }
+	require.Equal(t, bg(ramp[0])+bg(ramp[1])+bg(ramp[2])+"\x1b[0m", lines[0])
+	require.Equal(t, "  "+bg(ramp[2])+"\x1b[0m", lines[1], "NaN is blank")
+}
+
+func TestHeatmap_Range(t *testing.T) {
+	ramp := []tuistyles.Color{"#000000", "#ffffff"}
+	h := Heatmap{Values: [][]float64{{-5, 0, 50, 200}}, Ramp: ramp, CellWidth: 1, Min: 0, Max: 100}
+
+	line := h.Render()
+	require.Equal(t, 4, measure.Width(line))
+	require.Equal(t, 2, strings.Count(line, ramp[0].ToANSIBackground()), "below range clamps low")
+	require.Equal(t, 2, strings.Count(line, ramp[1].ToANSIBackground()), "above range clamps high")
+}
+
+func TestHeatmap_Legend(t *testing.T) {
+	h := Heatmap{Values: [][]float64{{1.5, 42}}}
+	legend := h.Legend()
+
+	require.Equal(t, "1.5 "+strings.Repeat(" ", 10)+" 42", measure.StripANSI(legend))
+	for _, c := range DefaultHeatmapRamp() {
+		require.Contains(t, legend, c.ToANSIBackground())
+	}
+}
+
+func TestHeatmap_Empty(t *testing.T) {
+	require.Empty(t, Heatmap{}.Render())
+	require.Equal(t, "0 ", measure.StripANSI(Heatmap{}.Legend())[:2])
+}
@@ -0,0 +1,155 @@
+// Package graphics draws pictures into terminal cells: QR codes built from
+// half blocks and other pixel-like output that does not fit a text style.
+package graphics
+
+import (
+	"fmt"
+	"strings"
+
+	tuistyles "github.com/orchard9/tui-styles"
+)
+
+// QRLevel is a QR error correction level. Higher levels survive more
+// damage at the cost of a larger code.
+type QRLevel int
+
+const (
+	QRLevelL QRLevel = iota // Recovers ~7% of codewords
+	QRLevelM                // Recovers ~15% of codewords
+	QRLevelQ                // Recovers ~25% of codewords
+	QRLevelH                // Recovers ~30% of codewords
+)
+
+// String returns the level letter ("L", "M", "Q", "H").
+func (l QRLevel) String() string {
+	switch l {
+	case QRLevelL:
+		return "L"
+	case QRLevelM:
+		return "M"
+	case QRLevelQ:
+		return "Q"
+	case QRLevelH:
+		return "H"
+	default:
+		return fmt.Sprintf("QRLevel(%d)", int(l))
+	}
+}
+
+// QRQuietZone is the light border, in modules, drawn around every code.
+// Scanners need it to find the code; the QR specification requires 4.
+const QRQuietZone = 4
+
+// QRCode is an encoded QR symbol.
+type QRCode struct {
+	version int
+	size    int
+	modules [][]bool // [y][x], true is dark
+}
+
+// EncodeQR encodes data at the given error correction level, choosing the
+// smallest version that fits. Digits-only and uppercase alphanumeric data
+// use the compact numeric and alphanumeric modes; anything else is stored
+// as bytes. Returns ErrQRTooLong if data does not fit in version 40.
+func EncodeQR(data string, level QRLevel) (*QRCode, error) {
+	if level < QRLevelL || level > QRLevelH {
+		return nil, fmt.Errorf("invalid QR level: %d", int(level))
+	}
+
+	codewords, version, err := encodeCodewords(data, level)
+	if err != nil {
+		return nil, err
+	}
+
+	m := newQRMatrix(version)
+	m.drawFunctionPatterns()
+	m.drawCodewords(addErrorCorrection(codewords, version, level))
+
+	// Keep the mask with the lowest penalty
+	best, bestPenalty := 0, -1
+	for mask := range 8 {
+		m.applyMask(mask)
+		m.drawFormatBits(level, mask)
+		if penalty := m.penalty(); bestPenalty < 0 || penalty < bestPenalty {
+			best, bestPenalty = mask, penalty
+		}
+		m.applyMask(mask) // masking is its own inverse
+	}
+	m.applyMask(best)
+	m.drawFormatBits(level, best)
+
+	return &QRCode{version: version, size: m.size, modules: m.modules}, nil
+}
+
+// Version returns the QR version (1-40).
+func (q *QRCode) Version() int {
+	return q.version
+}
+
+// Size returns the width and height in modules, excluding the quiet zone.
+func (q *QRCode) Size() int {
+	return q.size
+}
+
+// Dark reports whether the module at (x, y) is dark. Coordinates outside
+// the symbol, including the quiet zone, are light.
+func (q *QRCode) Dark(x, y int) bool {
+	if x < 0 || y < 0 || x >= q.size || y >= q.size {
+		return false
+	}
+	return q.modules[y][x]
+}
+
+// Render draws the code with half blocks, two modules per cell vertically,
+// surrounded by the quiet zone. Dark modules use style's foreground and
+// light modules its background; see QRStyle.
+func (q *QRCode) Render(style tuistyles.Style) string {
+	start, end := -QRQuietZone, q.size+QRQuietZone
+	lines := make([]string, 0, (end-start+1)/2)
+	for y := start; y < end; y += 2 {
+		var b strings.Builder
+		for x := start; x < end; x++ {
+			top, bottom := q.Dark(x, y), q.Dark(x, y+1) && y+1 < end
+			switch {
+			case top && bottom:
+				b.WriteString("█")
+			case top:
+				b.WriteString("▀")
+			case bottom:
+				b.WriteString("▄")
+			default:
+				b.WriteString(" ")
+			}
+		}
+		lines = append(lines, b.String())
+	}
+	return style.Render(strings.Join(lines, "\n"))
+}
+
+// QRStyle returns black modules on a white background. Scanners expect
+// dark-on-light codes, so explicit colors keep a code readable on dark
+// terminal themes.
+func QRStyle() tuistyles.Style {
+	return tuistyles.NewStyle().
+		Foreground(tuistyles.Color("#000000")).
+		Background(tuistyles.Color("#FFFFFF"))
+}
+
+// RenderQR encodes data at error correction level M and renders it with
+// style, for example to show a pairing URL in a CLI:
+//
+//	code, err := graphics.RenderQR("https://example.com/pair/42", graphics.QRStyle())
+//	if err != nil {
+//	    return err
+//	}
+//	fmt.Println(code)
+//
+// A code takes (size+8)/2 rows and size+8 columns, where size is 21 for
+// the shortest data and grows by 4 per version.
+func RenderQR(data string, style tuistyles.Style) (string, error) {
+	code, err := EncodeQR(data, QRLevelM)
+	if err != nil {
+		return "", err
+	}
+	return code.Render(style), nil
+}
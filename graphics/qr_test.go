@@ -0,0 +1,215 @@
+package graphics
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tuistyles "github.com/orchard9/tui-styles"
+)
+
+func TestEncodeCodewords_HelloWorld(t *testing.T) {
+	// Worked example from the QR specification tutorials: "HELLO WORLD" at 1-M
+	data, version, err := encodeCodewords("HELLO WORLD", QRLevelM)
+	require.NoError(t, err)
+	require.Equal(t, 1, version)
+	require.Equal(t, []byte{32, 91, 11, 120, 209, 114, 220, 77, 67, 64, 236, 17, 236, 17, 236, 17}, data)
+
+	all := addErrorCorrection(data, version, QRLevelM)
+	require.Equal(t, []byte{196, 35, 39, 119, 235, 215, 231, 226, 93, 23}, all[len(data):])
+}
+
+func TestAddErrorCorrection_Interleaves(t *testing.T) {
+	// 5-Q has two blocks of 15 data codewords followed by two of 16
+	data := make([]byte, 62)
+	for i := range data {
+		data[i] = byte(i)
+	}
+	all := addErrorCorrection(data, 5, QRLevelQ)
+	require.Len(t, all, rawDataModules(5)/8)
+
+	var want []byte
+	for i := range 15 {
+		want = append(want, byte(i), byte(15+i), byte(30+i), byte(46+i))
+	}
+	want = append(want, 45, 61)
+	require.Equal(t, want, all[:62])
+}
+
+func TestFormatBits(t *testing.T) {
+	tests := []struct {
+		level QRLevel
+		mask  int
+		want  uint32
+	}{
+		{QRLevelL, 0, 0b111011111000100},
+		{QRLevelM, 0, 0b101010000010010},
+		{QRLevelQ, 0, 0b011010101011111},
+		{QRLevelH, 0, 0b001011010001001},
+		{QRLevelH, 7, 0b000100000111011},
+	}
+
+	for _, tt := range tests {
+		require.Equal(t, tt.want, formatBits(tt.level, tt.mask), "%s mask %d", tt.level, tt.mask)
+	}
+}
+
+func TestVersionBits(t *testing.T) {
+	require.Equal(t, uint32(0b000111110010010100), versionBits(7))
+	require.Equal(t, uint32(0b101000110001101001), versionBits(40))
+}
+
+func TestAlignmentPositions(t *testing.T) {
+	require.Nil(t, alignmentPositions(1))
+	require.Equal(t, []int{6, 18}, alignmentPositions(2))
+	require.Equal(t, []int{6, 22, 38}, alignmentPositions(7))
+	require.Equal(t, []int{6, 34, 60, 86, 112, 138}, alignmentPositions(32))
+	require.Equal(t, []int{6, 30, 58, 86, 114, 142, 170}, alignmentPositions(40))
+}
+
+func TestEncodeQR_Capacity(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		level   QRLevel
+		version int
+	}{
+		{"numeric fills 1-L", strings.Repeat("7", 41), QRLevelL, 1},
+		{"numeric spills to 2-L", strings.Repeat("7", 42), QRLevelL, 2},
+		{"alphanumeric fills 1-L", strings.Repeat("A", 25), QRLevelL, 1},
+		{"bytes fill 1-M", strings.Repeat("a", 14), QRLevelM, 1},
+		{"bytes spill to 2-M", strings.Repeat("a", 15), QRLevelM, 2},
+		{"bytes fill 40-L", strings.Repeat("a", 2953), QRLevelL, 40},
+		{"empty", "", QRLevelM, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, err := EncodeQR(tt.data, tt.level)
+			require.NoError(t, err)
+			require.Equal(t, tt.version, code.Version())
+			require.Equal(t, tt.version*4+17, code.Size())
+		})
+	}
+}
+
+func TestEncodeQR_Errors(t *testing.T) {
+	_, err := EncodeQR(strings.Repeat("a", 2954), QRLevelL)
+	require.ErrorIs(t, err, ErrQRTooLong)
+
+	_, err = EncodeQR("x", QRLevel(9))
+	require.Error(t, err)
+}
+
+// TestEncodeQR_ReadBack decodes the symbol's format information and data
+// modules and checks they match what was encoded.
+func TestEncodeQR_ReadBack(t *testing.T) {
+	for _, tc := range []struct {
+		data  string
+		level QRLevel
+	}{
+		{"HELLO WORLD", QRLevelQ},
+		{"https://example.com/pair/42?token=abc", QRLevelM},
+		{strings.Repeat("0123456789", 30), QRLevelH},
+	} {
+		code, err := EncodeQR(tc.data, tc.level)
+		require.NoError(t, err)
+
+		// Top-left format copy, bits 0-14
+		var bits uint32
+		coords := [][2]int{{8, 0}, {8, 1}, {8, 2}, {8, 3}, {8, 4}, {8, 5}, {8, 7}, {8, 8}, {7, 8}, {5, 8}, {4, 8}, {3, 8}, {2, 8}, {1, 8}, {0, 8}}
+		for i, c := range coords {
+			if code.Dark(c[0], c[1]) {
+				bits |= 1 << uint(i)
+			}
+		}
+		mask := -1
+		for candidate := range 8 {
+			if formatBits(tc.level, candidate) == bits {
+				mask = candidate
+			}
+		}
+		require.NotEqual(t, -1, mask, "format information must decode")
+
+		// Unmask the data modules and read them back in placement order
+		ref := newQRMatrix(code.Version())
+		ref.drawFunctionPatterns()
+		for y := range code.size {
+			for x := range code.size {
+				if !ref.isFunction[y][x] {
+					ref.modules[y][x] = code.Dark(x, y) != maskBit(mask, x, y)
+				}
+			}
+		}
+
+		data, version, err := encodeCodewords(tc.data, tc.level)
+		require.NoError(t, err)
+		want := addErrorCorrection(data, version, tc.level)
+		require.Equal(t, want, readCodewords(ref, len(want)))
+
+		// Functional patterns survive masking
+		require.True(t, code.Dark(8, code.Size()-8), "dark module")
+		for i := 8; i < code.Size()-8; i++ {
+			require.Equal(t, i%2 == 0, code.Dark(i, 6), "timing at %d", i)
+		}
+	}
+}
+
+// readCodewords reverses drawCodewords
+func readCodewords(m *qrMatrix, n int) []byte {
+	out := make([]byte, n)
+	i := 0
+	for right := m.size - 1; right >= 1; right -= 2 {
+		if right == 6 {
+			right = 5
+		}
+		for vert := range m.size {
+			for j := range 2 {
+				x, y := right-j, vert
+				if (right+1)&2 == 0 {
+					y = m.size - 1 - vert
+				}
+				if m.isFunction[y][x] || i >= n*8 {
+					continue
+				}
+				if m.modules[y][x] {
+					out[i/8] |= 0x80 >> uint(i%8)
+				}
+				i++
+			}
+		}
+	}
+	return out
+}
+
+func TestQRCode_Render(t *testing.T) {
+	code, err := EncodeQR("HELLO WORLD", QRLevelM)
+	require.NoError(t, err)
+
+	lines := strings.Split(code.Render(tuistyles.NewStyle()), "\n")
+	require.Len(t, lines, 15, "21 modules plus quiet zone, two per row")
+	for _, line := range lines {
+		require.Equal(t, 29, len([]rune(line)))
+	}
+	require.Equal(t, strings.Repeat(" ", 29), lines[0], "quiet zone")
+	require.Equal(t, strings.Repeat(" ", 29), lines[1], "quiet zone")
+	require.Equal(t, "    █▀▀▀▀▀█", lines[2][:len("    █▀▀▀▀▀█")], "finder pattern top edge")
+	require.Equal(t, strings.Repeat(" ", 29), lines[14][:29], "bottom quiet zone")
+}
+
+func TestRenderQR(t *testing.T) {
+	got, err := RenderQR("pair-1234", QRStyle())
+	require.NoError(t, err)
+	require.Contains(t, got, "\x1b[")
+	require.Contains(t, got, "▀")
+
+	_, err = RenderQR(strings.Repeat("a", 3000), QRStyle())
+	require.ErrorIs(t, err, ErrQRTooLong)
+}
+
+func TestQRLevel_String(t *testing.T) {
+	require.Equal(t, "L", QRLevelL.String())
+	require.Equal(t, "H", QRLevelH.String())
+	require.Equal(t, "QRLevel(7)", QRLevel(7).String())
+}
@@ -0,0 +1,262 @@
+package graphics
+
+import (
+	"errors"
+	"strings"
+)
+
+// ErrQRTooLong is returned when data does not fit in the largest QR
+// version at the requested error correction level
+var ErrQRTooLong = errors.New("data too long for a QR code")
+
+// qrMode is a QR data encoding mode
+type qrMode struct {
+	indicator uint32
+	countBits [3]int // character count bits for versions 1-9, 10-26, 27-40
+}
+
+var (
+	modeNumeric      = qrMode{0x1, [3]int{10, 12, 14}}
+	modeAlphanumeric = qrMode{0x2, [3]int{9, 11, 13}}
+	modeByte         = qrMode{0x4, [3]int{8, 16, 16}}
+)
+
+// alphanumericCharset lists the alphanumeric mode characters by value
+const alphanumericCharset = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ $%*+-./:"
+
+// countBitsFor returns the character count width for a version
+func (m qrMode) countBitsFor(version int) int {
+	switch {
+	case version <= 9:
+		return m.countBits[0]
+	case version <= 26:
+		return m.countBits[1]
+	default:
+		return m.countBits[2]
+	}
+}
+
+// eccCodewordsPerBlock is indexed by level (L, M, Q, H) then version
+var eccCodewordsPerBlock = [4][41]int{
+	{-1, 7, 10, 15, 20, 26, 18, 20, 24, 30, 18, 20, 24, 26, 30, 22, 24, 28, 30, 28, 28, 28, 28, 30, 30, 26, 28, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30},
+	{-1, 10, 16, 26, 18, 24, 16, 18, 22, 22, 26, 30, 22, 22, 24, 24, 28, 28, 26, 26, 26, 26, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28},
+	{-1, 13, 22, 18, 26, 18, 24, 18, 22, 20, 24, 28, 26, 24, 20, 30, 24, 28, 28, 26, 30, 28, 30, 30, 30, 30, 28, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30},
+	{-1, 17, 28, 22, 16, 22, 28, 26, 26, 24, 28, 24, 28, 22, 24, 24, 30, 28, 28, 26, 28, 30, 24, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30},
+}
+
+// eccBlocks is the number of error correction blocks, indexed like eccCodewordsPerBlock
+var eccBlocks = [4][41]int{
+	{-1, 1, 1, 1, 1, 1, 2, 2, 2, 2, 4, 4, 4, 4, 4, 6, 6, 6, 6, 7, 8, 8, 9, 9, 10, 12, 12, 12, 13, 14, 15, 16, 17, 18, 19, 19, 20, 21, 22, 24, 25},
+	{-1, 1, 1, 1, 2, 2, 4, 4, 4, 5, 5, 5, 8, 9, 9, 10, 10, 11, 13, 14, 16, 17, 17, 18, 20, 21, 23, 25, 26, 28, 29, 31, 33, 35, 37, 38, 40, 43, 45, 47, 49},
+	{-1, 1, 1, 2, 2, 4, 4, 6, 6, 8, 8, 8, 10, 12, 16, 12, 17, 16, 18, 21, 20, 23, 23, 25, 27, 29, 34, 34, 35, 38, 40, 43, 45, 48, 51, 53, 56, 59, 62, 65, 68},
+	{-1, 1, 1, 2, 4, 4, 4, 5, 6, 8, 8, 11, 11, 16, 16, 18, 16, 19, 21, 25, 25, 25, 34, 30, 32, 35, 37, 40, 42, 45, 48, 51, 54, 57, 60, 63, 66, 70, 74, 77, 81},
+}
+
+// formatLevelBits are the error correction bits of the format information
+var formatLevelBits = [4]uint32{1, 0, 3, 2}
+
+// bitBuffer accumulates bits most significant first
+type bitBuffer []bool
+
+// append writes the low n bits of v
+func (b *bitBuffer) append(v uint32, n int) {
+	for i := n - 1; i >= 0; i-- {
+		*b = append(*b, v>>uint(i)&1 == 1)
+	}
+}
+
+// bytes packs the buffer, which must be a whole number of bytes
+func (b bitBuffer) bytes() []byte {
+	out := make([]byte, len(b)/8)
+	for i, bit := range b {
+		if bit {
+			out[i/8] |= 0x80 >> uint(i%8)
+		}
+	}
+	return out
+}
+
+// chooseMode picks the most compact single mode able to hold data
+func chooseMode(data string) qrMode {
+	numeric, alnum := true, true
+	for i := 0; i < len(data); i++ {
+		c := data[i]
+		if c < '0' || c > '9' {
+			numeric = false
+		}
+		if !strings.ContainsRune(alphanumericCharset, rune(c)) {
+			alnum = false
+		}
+	}
+	switch {
+	case numeric && data != "":
+		return modeNumeric
+	case alnum && data != "":
+		return modeAlphanumeric
+	default:
+		return modeByte
+	}
+}
+
+// encodePayload writes data in mode without the header
+func encodePayload(data string, mode qrMode) bitBuffer {
+	var bb bitBuffer
+	switch mode {
+	case modeNumeric:
+		for i := 0; i < len(data); i += 3 {
+			group := data[i:min(i+3, len(data))]
+			var v uint32
+			for _, c := range group {
+				v = v*10 + uint32(c-'0')
+			}
+			bb.append(v, len(group)*3+1)
+		}
+	case modeAlphanumeric:
+		for i := 0; i < len(data); i += 2 {
+			v := uint32(strings.IndexByte(alphanumericCharset, data[i]))
+			if i+1 < len(data) {
+				v = v*45 + uint32(strings.IndexByte(alphanumericCharset, data[i+1]))
+				bb.append(v, 11)
+			} else {
+				bb.append(v, 6)
+			}
+		}
+	default:
+		for i := 0; i < len(data); i++ {
+			bb.append(uint32(data[i]), 8)
+		}
+	}
+	return bb
+}
+
+// rawDataModules is the number of modules available for codewords in a version
+func rawDataModules(version int) int {
+	result := (16*version+128)*version + 64
+	if version >= 2 {
+		numAlign := version/7 + 2
+		result -= (25*numAlign-10)*numAlign - 55
+		if version >= 7 {
+			result -= 36
+		}
+	}
+	return result
+}
+
+// dataCodewords is the number of data codewords in a version and level
+func dataCodewords(version int, level QRLevel) int {
+	return rawDataModules(version)/8 - eccCodewordsPerBlock[level][version]*eccBlocks[level][version]
+}
+
+// encodeCodewords builds the data bit stream for the smallest fitting
+// version and returns it with that version
+func encodeCodewords(data string, level QRLevel) ([]byte, int, error) {
+	mode := chooseMode(data)
+	payload := encodePayload(data, mode)
+
+	version := 1
+	for ; version <= 40; version++ {
+		countBits := mode.countBitsFor(version)
+		if len(data) >= 1<<uint(countBits) {
+			continue
+		}
+		if 4+countBits+len(payload) <= dataCodewords(version, level)*8 {
+			break
+		}
+	}
+	if version > 40 {
+		return nil, 0, ErrQRTooLong
+	}
+
+	capacity := dataCodewords(version, level) * 8
+	var bb bitBuffer
+	bb.append(mode.indicator, 4)
+	bb.append(uint32(len(data)), mode.countBitsFor(version))
+	bb = append(bb, payload...)
+
+	// Terminator, byte alignment, then alternating pad bytes
+	bb.append(0, min(4, capacity-len(bb)))
+	bb.append(0, (8-len(bb)%8)%8)
+	for pad := uint32(0xEC); len(bb) < capacity; pad ^= 0xEC ^ 0x11 {
+		bb.append(pad, 8)
+	}
+
+	return bb.bytes(), version, nil
+}
+
+// addErrorCorrection splits data into blocks, appends Reed-Solomon
+// codewords to each, and interleaves the result
+func addErrorCorrection(data []byte, version int, level QRLevel) []byte {
+	numBlocks := eccBlocks[level][version]
+	eccLen := eccCodewordsPerBlock[level][version]
+	rawCodewords := rawDataModules(version) / 8
+	numShort := numBlocks - rawCodewords%numBlocks
+	shortLen := rawCodewords / numBlocks
+
+	divisor := reedSolomonDivisor(eccLen)
+	blocks := make([][]byte, numBlocks)
+	for i, k := 0, 0; i < numBlocks; i++ {
+		n := shortLen - eccLen
+		if i >= numShort {
+			n++
+		}
+		block := append([]byte{}, data[k:k+n]...)
+		k += n
+		ecc := reedSolomonRemainder(block, divisor)
+		if i < numShort {
+			block = append(block, 0) // placeholder keeps columns aligned
+		}
+		blocks[i] = append(block, ecc...)
+	}
+
+	result := make([]byte, 0, rawCodewords)
+	for i := 0; i <= shortLen; i++ {
+		for j, block := range blocks {
+			if i != shortLen-eccLen || j >= numShort {
+				result = append(result, block[i])
+			}
+		}
+	}
+	return result
+}
+
+// reedSolomonDivisor returns the generator polynomial of the given degree
+// (highest coefficient omitted)
+func reedSolomonDivisor(degree int) []byte {
+	result := make([]byte, degree)
+	result[degree-1] = 1
+	root := byte(1)
+	for range degree {
+		for j := range result {
+			result[j] = gfMultiply(result[j], root)
+			if j+1 < len(result) {
+				result[j] ^= result[j+1]
+			}
+		}
+		root = gfMultiply(root, 0x02)
+	}
+	return result
+}
+
+// reedSolomonRemainder returns the error correction codewords for data
+func reedSolomonRemainder(data, divisor []byte) []byte {
+	result := make([]byte, len(divisor))
+	for _, b := range data {
+		factor := b ^ result[0]
+		copy(result, result[1:])
+		result[len(result)-1] = 0
+		for i, coef := range divisor {
+			result[i] ^= gfMultiply(coef, factor)
+		}
+	}
+	return result
+}
+
+// gfMultiply multiplies in GF(2^8) modulo x^8 + x^4 + x^3 + x^2 + 1
+func gfMultiply(x, y byte) byte {
+	var z uint32
+	for i := 7; i >= 0; i-- {
+		z = (z << 1) ^ ((z >> 7) * 0x11D)
+		z ^= uint32(y>>uint(i)&1) * uint32(x)
+	}
+	return byte(z)
+}
@@ -0,0 +1,301 @@
+package graphics
+
+// qrMatrix is a QR symbol under construction
+type qrMatrix struct {
+	size       int
+	modules    [][]bool // [y][x], true is dark
+	isFunction [][]bool // finder, timing, alignment, format, and version modules
+}
+
+// newQRMatrix allocates an empty symbol for version
+func newQRMatrix(version int) *qrMatrix {
+	size := version*4 + 17
+	m := &qrMatrix{size: size, modules: make([][]bool, size), isFunction: make([][]bool, size)}
+	for y := range size {
+		m.modules[y] = make([]bool, size)
+		m.isFunction[y] = make([]bool, size)
+	}
+	return m
+}
+
+// version recovers the version from the symbol size
+func (m *qrMatrix) version() int {
+	return (m.size - 17) / 4
+}
+
+// setFunction sets a function module, which data and masks skip
+func (m *qrMatrix) setFunction(x, y int, dark bool) {
+	m.modules[y][x] = dark
+	m.isFunction[y][x] = true
+}
+
+// drawFunctionPatterns draws everything but the data, reserving the
+// format area (filled in per mask by drawFormatBits)
+func (m *qrMatrix) drawFunctionPatterns() {
+	for i := range m.size {
+		m.setFunction(6, i, i%2 == 0)
+		m.setFunction(i, 6, i%2 == 0)
+	}
+
+	m.drawFinder(3, 3)
+	m.drawFinder(m.size-4, 3)
+	m.drawFinder(3, m.size-4)
+
+	positions := alignmentPositions(m.version())
+	last := len(positions) - 1
+	for i, x := range positions {
+		for j, y := range positions {
+			// Skip the three corners occupied by finder patterns
+			if (i == 0 && j == 0) || (i == 0 && j == last) || (i == last && j == 0) {
+				continue
+			}
+			m.drawAlignment(x, y)
+		}
+	}
+
+	m.drawFormatBits(QRLevelL, 0)
+	m.drawVersion()
+}
+
+// drawFinder draws a finder pattern and its separator centered on (cx, cy)
+func (m *qrMatrix) drawFinder(cx, cy int) {
+	for dy := -4; dy <= 4; dy++ {
+		for dx := -4; dx <= 4; dx++ {
+			x, y := cx+dx, cy+dy
+			if x < 0 || y < 0 || x >= m.size || y >= m.size {
+				continue
+			}
+			dist := max(abs(dx), abs(dy))
+			m.setFunction(x, y, dist != 2 && dist != 4)
+		}
+	}
+}
+
+// drawAlignment draws a 5x5 alignment pattern centered on (cx, cy)
+func (m *qrMatrix) drawAlignment(cx, cy int) {
+	for dy := -2; dy <= 2; dy++ {
+		for dx := -2; dx <= 2; dx++ {
+			m.setFunction(cx+dx, cy+dy, max(abs(dx), abs(dy)) != 1)
+		}
+	}
+}
+
+// alignmentPositions returns the alignment pattern centers along each axis
+func alignmentPositions(version int) []int {
+	if version == 1 {
+		return nil
+	}
+
+	count := version/7 + 2
+	step := 26
+	if version != 32 {
+		step = (version*4 + count*2 + 1) / (count*2 - 2) * 2
+	}
+
+	positions := make([]int, count)
+	positions[0] = 6
+	for i, pos := count-1, version*4+17-7; i >= 1; i, pos = i-1, pos-step {
+		positions[i] = pos
+	}
+	return positions
+}
+
+// formatBits returns the 15-bit BCH-coded format information
+func formatBits(level QRLevel, mask int) uint32 {
+	data := formatLevelBits[level]<<3 | uint32(mask)
+	rem := data
+	for range 10 {
+		rem = (rem << 1) ^ ((rem >> 9) * 0x537)
+	}
+	return (data<<10 | rem) ^ 0x5412
+}
+
+// drawFormatBits writes both copies of the format information
+func (m *qrMatrix) drawFormatBits(level QRLevel, mask int) {
+	bits := formatBits(level, mask)
+	bit := func(i int) bool { return bits>>uint(i)&1 == 1 }
+
+	// Copy around the top-left finder
+	for i := range 6 {
+		m.setFunction(8, i, bit(i))
+	}
+	m.setFunction(8, 7, bit(6))
+	m.setFunction(8, 8, bit(7))
+	m.setFunction(7, 8, bit(8))
+	for i := 9; i < 15; i++ {
+		m.setFunction(14-i, 8, bit(i))
+	}
+
+	// Copy split between the other two finders
+	for i := range 8 {
+		m.setFunction(m.size-1-i, 8, bit(i))
+	}
+	for i := 8; i < 15; i++ {
+		m.setFunction(8, m.size-15+i, bit(i))
+	}
+	m.setFunction(8, m.size-8, true) // always-dark module
+}
+
+// drawVersion writes both copies of the version information (version 7+)
+func (m *qrMatrix) drawVersion() {
+	version := m.version()
+	if version < 7 {
+		return
+	}
+
+	bits := versionBits(version)
+	for i := range 18 {
+		dark := bits>>uint(i)&1 == 1
+		a, b := m.size-11+i%3, i/3
+		m.setFunction(a, b, dark)
+		m.setFunction(b, a, dark)
+	}
+}
+
+// versionBits returns the 18-bit BCH-coded version information
+func versionBits(version int) uint32 {
+	rem := uint32(version)
+	for range 12 {
+		rem = (rem << 1) ^ ((rem >> 11) * 0x1F25)
+	}
+	return uint32(version)<<12 | rem
+}
+
+// drawCodewords places data in the zigzag column order, skipping function
+// modules. Remainder modules stay light.
+func (m *qrMatrix) drawCodewords(data []byte) {
+	i := 0
+	for right := m.size - 1; right >= 1; right -= 2 {
+		if right == 6 {
+			right = 5 // skip the vertical timing pattern
+		}
+		for vert := range m.size {
+			for j := range 2 {
+				x := right - j
+				y := vert
+				if (right+1)&2 == 0 {
+					y = m.size - 1 - vert // upward column pair
+				}
+				if m.isFunction[y][x] || i >= len(data)*8 {
+					continue
+				}
+				m.modules[y][x] = data[i/8]>>uint(7-i%8)&1 == 1
+				i++
+			}
+		}
+	}
+}
+
+// applyMask XORs a mask pattern over the data modules
+func (m *qrMatrix) applyMask(mask int) {
+	for y := range m.size {
+		for x := range m.size {
+			if !m.isFunction[y][x] && maskBit(mask, x, y) {
+				m.modules[y][x] = !m.modules[y][x]
+			}
+		}
+	}
+}
+
+// maskBit reports whether mask inverts the module at (x, y)
+func maskBit(mask, x, y int) bool {
+	switch mask {
+	case 0:
+		return (x+y)%2 == 0
+	case 1:
+		return y%2 == 0
+	case 2:
+		return x%3 == 0
+	case 3:
+		return (x+y)%3 == 0
+	case 4:
+		return (x/3+y/2)%2 == 0
+	case 5:
+		return x*y%2+x*y%3 == 0
+	case 6:
+		return (x*y%2+x*y%3)%2 == 0
+	default:
+		return ((x+y)%2+x*y%3)%2 == 0
+	}
+}
+
+// penalty scores the symbol with the four QR mask evaluation rules;
+// lower is easier to scan
+func (m *qrMatrix) penalty() int {
+	score := 0
+	at := func(x, y int, horizontal bool) bool {
+		if horizontal {
+			return m.modules[y][x]
+		}
+		return m.modules[x][y]
+	}
+
+	for _, horizontal := range []bool{true, false} {
+		for y := range m.size {
+			// Rule 1: runs of five or more same-colored modules
+			run := 1
+			for x := 1; x < m.size; x++ {
+				if at(x, y, horizontal) == at(x-1, y, horizontal) {
+					run++
+					if run == 5 {
+						score += 3
+					} else if run > 5 {
+						score++
+					}
+				} else {
+					run = 1
+				}
+			}
+
+			// Rule 3: finder-like 1:1:3:1:1 patterns with four light modules on a side
+			for x := 0; x+11 <= m.size; x++ {
+				var window [11]bool
+				for k := range window {
+					window[k] = at(x+k, y, horizontal)
+				}
+				if window == finderLikeBefore || window == finderLikeAfter {
+					score += 40
+				}
+			}
+		}
+	}
+
+	// Rule 2: 2x2 blocks of one color
+	for y := 0; y+1 < m.size; y++ {
+		for x := 0; x+1 < m.size; x++ {
+			c := m.modules[y][x]
+			if c == m.modules[y][x+1] && c == m.modules[y+1][x] && c == m.modules[y+1][x+1] {
+				score += 3
+			}
+		}
+	}
+
+	// Rule 4: dark proportion far from 50%
+	dark := 0
+	for y := range m.size {
+		for x := range m.size {
+			if m.modules[y][x] {
+				dark++
+			}
+		}
+	}
+	total := m.size * m.size
+	k := (abs(dark*20-total*10)+total-1)/total - 1
+	score += k * 10
+
+	return score
+}
+
+// Finder-like patterns penalized by rule 3
+var (
+	finderLikeBefore = [11]bool{false, false, false, false, true, false, true, true, true, false, true}
+	finderLikeAfter  = [11]bool{true, false, true, true, true, false, true, false, false, false, false}
+)
+
+// abs returns the absolute value of n
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
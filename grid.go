@@ -0,0 +1,147 @@
+package tuistyles
+
+import (
+	"strings"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+// Grid lays components out in rows of Columns cells, filling each row left
+// to right. Every cell in a column gets the same width: columns start from
+// their components' natural widths and grow or shrink together to fill the
+// width the grid is given, but never below the widest MinWidth in the
+// column. Cells in a row are aligned at the top and padded to the row's
+// height, and a short last row is padded to the full width.
+//
+// Example:
+//
+//	grid := Grid{Columns: 2, Gap: 1, Cells: []Component{cpuPanel, memPanel, diskTable, netList}}
+//	fmt.Println(grid.Render(120))
+type Grid struct {
+	Cells   []Component
+	Columns int // Cells per row; 1 when 0 or less
+	Gap     int // Blank cells between columns and blank lines between rows
+}
+
+// Render draws the grid width cells wide. A width of 0 or less gives every
+// column its natural width. When width is narrower than MinWidth, the grid
+// is MinWidth wide.
+func (g Grid) Render(width int) string {
+	if len(g.Cells) == 0 {
+		return ""
+	}
+
+	// The first row is always full, so its last cell ends at the right edge
+	rendered, areas := g.layout(width)
+	cols := g.columnCount()
+	total := areas[cols-1].Max().X
+	gap := max(g.Gap, 0)
+	spacer := strings.Repeat(" ", gap)
+	blank := strings.Repeat(" ", total)
+	var rows []string
+	for start := 0; start < len(rendered); start += cols {
+		var blocks []string
+		for i := start; i < min(start+cols, len(rendered)); i++ {
+			if i > start && gap > 0 {
+				blocks = append(blocks, spacer)
+			}
+			blocks = append(blocks, padBlock(rendered[i], areas[i].Width))
+		}
+		if len(rows) > 0 {
+			for range gap {
+				rows = append(rows, blank)
+			}
+		}
+		rows = append(rows, padBlock(JoinHorizontal(Top, blocks...), total))
+	}
+	return strings.Join(rows, "\n")
+}
+
+// Layout returns the area each cell takes up when the grid is rendered
+// width cells wide, in the order of Cells: its column's width and its
+// row's height. Use it to find which cell a mouse click landed in or to
+// draw overlays.
+func (g Grid) Layout(width int) []Rect {
+	_, areas := g.layout(width)
+	return areas
+}
+
+// layout renders the cells at their column widths and returns them along
+// with their areas
+func (g Grid) layout(width int) ([]string, []Rect) {
+	if len(g.Cells) == 0 {
+		return nil, nil
+	}
+
+	cols := g.columnCount()
+	gap := max(g.Gap, 0)
+	natural := make([]int, cols)
+	minimum := g.columnMinWidths()
+	for i, cell := range g.Cells {
+		natural[i%cols] = max(natural[i%cols], measure.MaxWidth(cell.Render(0)), minimum[i%cols])
+	}
+
+	widths := natural
+	if width > 0 {
+		widths = fitWidths(width-gap*(cols-1), natural, minimum)
+	}
+
+	rendered := make([]string, len(g.Cells))
+	areas := make([]Rect, len(g.Cells))
+	y := 0
+	for start := 0; start < len(g.Cells); start += cols {
+		end := min(start+cols, len(g.Cells))
+		x, height := 0, 0
+		for i := start; i < end; i++ {
+			w := widths[i-start]
+			rendered[i] = g.Cells[i].Render(w)
+			areas[i] = Rect{X: x, Y: y, Width: w}
+			height = max(height, Height(rendered[i]))
+			x += w + gap
+		}
+		for i := start; i < end; i++ {
+			areas[i].Height = height
+		}
+		y += height + gap
+	}
+	return rendered, areas
+}
+
+// MinWidth returns the sum of the columns' minimum widths and the gaps
+// between them.
+func (g Grid) MinWidth() int {
+	if len(g.Cells) == 0 {
+		return 0
+	}
+	total := max(g.Gap, 0) * (g.columnCount() - 1)
+	for _, w := range g.columnMinWidths() {
+		total += w
+	}
+	return total
+}
+
+// columnCount returns the number of columns, no more than there are cells
+func (g Grid) columnCount() int {
+	return min(max(g.Columns, 1), len(g.Cells))
+}
+
+// columnMinWidths returns the widest MinWidth in each column
+func (g Grid) columnMinWidths() []int {
+	cols := g.columnCount()
+	widths := make([]int, cols)
+	for i, cell := range g.Cells {
+		widths[i%cols] = max(widths[i%cols], cell.MinWidth())
+	}
+	return widths
+}
+
+// padBlock pads every line of block with spaces to width cells
+func padBlock(block string, width int) string {
+	lines := strings.Split(block, "\n")
+	for i, line := range lines {
+		if w := measure.Width(line); w < width {
+			lines[i] = line + strings.Repeat(" ", width-w)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,83 @@
+package tuistyles
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComponent_MinWidth(t *testing.T) {
+	tests := []struct {
+		name      string
+		component Component
+		want      int
+	}{
+		{"table", Table{Headers: []string{"Name", "Status"}, Rows: [][]string{{"api", "up"}}}, 9},
+		{"empty table", Table{}, 0},
+		{"list", List{Items: []string{"build", "test"}}, 3},
+		{"numbered list", List{Items: make([]string, 10), Numbered: true}, 5},
+		{"panel", Panel{Title: "Metrics", Body: "CPU 45%"}, 5},
+		{"panel with padding", Panel{Body: "x", Style: NewStyle().Padding(0, 3)}, 9},
+		{"grid", Grid{Columns: 2, Gap: 1, Cells: []Component{List{Items: []string{"a"}}, Panel{Body: "b"}}}, 9},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, tt.component.MinWidth())
+			if tt.want > 0 {
+				// Rendering at the minimum width fits it exactly
+				require.Equal(t, tt.want, measure.MaxWidth(tt.component.Render(tt.want)))
+			}
+		})
+	}
+}
+
+func TestGrid_Render(t *testing.T) {
+	a := List{Items: []string{"one", "two"}}
+	b := List{Items: []string{"three"}, Marker: "-"}
+	c := List{Items: []string{"four"}}
+
+	t.Run("natural width", func(t *testing.T) {
+		got := Grid{Columns: 2, Gap: 1, Cells: []Component{a, b, c}}.Render(0)
+		want := "• one  - three\n" +
+			"• two         \n" +
+			"              \n" +
+			"• four        "
+		require.Equal(t, want, measure.StripANSI(got))
+	})
+
+	t.Run("shares width", func(t *testing.T) {
+		got := Grid{Columns: 2, Gap: 2, Cells: []Component{a, b}}.Render(30)
+		for _, line := range strings.Split(got, "\n") {
+			require.Equal(t, 30, measure.Width(line))
+		}
+	})
+
+	t.Run("never below minimum", func(t *testing.T) {
+		grid := Grid{Columns: 2, Cells: []Component{a, Panel{Body: "wide body"}}}
+		require.Equal(t, grid.MinWidth(), measure.MaxWidth(grid.Render(1)))
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		require.Empty(t, Grid{}.Render(40))
+		require.Zero(t, Grid{}.MinWidth())
+	})
+}
+
+func TestGrid_Layout(t *testing.T) {
+	grid := Grid{Columns: 2, Gap: 1, Cells: []Component{
+		List{Items: []string{"one", "two"}},
+		List{Items: []string{"three"}},
+		List{Items: []string{"four"}},
+	}}
+
+	want := []Rect{
+		{X: 0, Y: 0, Width: 6, Height: 2},
+		{X: 7, Y: 0, Width: 7, Height: 2},
+		{X: 0, Y: 3, Width: 6, Height: 1},
+	}
+	require.Equal(t, want, grid.Layout(0))
+	require.Empty(t, Grid{}.Layout(40))
+}
@@ -0,0 +1,104 @@
+package tuistyles
+
+import (
+	"strings"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+// KeyBinding is a key and what it does, as listed in a HelpBar
+type KeyBinding struct {
+	Key         string
+	Description string
+}
+
+// HelpBar lays out key bindings as a footer, wrapping onto more rows when
+// they do not fit:
+//
+//	q quit • r refresh • ? help
+//
+// Example:
+//
+//	help := HelpBar{
+//	    Bindings: []KeyBinding{{"q", "quit"}, {"r", "refresh"}, {"?", "help"}},
+//	    Align:    Center,
+//	}
+//	fmt.Println(help.Render(80))
+type HelpBar struct {
+	Bindings  []KeyBinding
+	Separator string   // Between bindings on a row; " • " by default
+	Align     Position // Row alignment within the width: Left (default), Center, or Right
+	Theme     Theme
+
+	KeyStyle         Style // Bold primary by default
+	DescriptionStyle Style // Muted by default
+	SeparatorStyle   Style // Muted by default
+}
+
+// Render lays the bindings out within width cells. Rows break between
+// bindings, never inside one; a binding wider than the width is truncated.
+// A width of 0 or less puts everything on one row.
+func (h HelpBar) Render(width int) string {
+	if len(h.Bindings) == 0 {
+		return ""
+	}
+
+	theme := h.Theme.WithDefaults()
+	keyStyle := orDefault(h.KeyStyle, NewStyle().Bold(true).Foreground(theme.Primary))
+	descStyle := orDefault(h.DescriptionStyle, NewStyle().Foreground(theme.Muted))
+	sepStyle := orDefault(h.SeparatorStyle, NewStyle().Foreground(theme.Muted))
+	separator := h.Separator
+	if separator == "" {
+		separator = " • "
+	}
+	sep := sepStyle.Render(separator)
+	sepWidth := measure.Width(separator)
+
+	var rows [][]string
+	var row []string
+	rowWidth := 0
+	for _, binding := range h.Bindings {
+		item := keyStyle.Render(binding.Key)
+		if binding.Description != "" {
+			item += " " + descStyle.Render(binding.Description)
+		}
+		itemWidth := measure.Width(item)
+		if width > 0 && itemWidth > width {
+			item, itemWidth = measure.TruncateStyled(item, width), width
+		}
+
+		if len(row) > 0 && width > 0 && rowWidth+sepWidth+itemWidth > width {
+			rows = append(rows, row)
+			row, rowWidth = nil, 0
+		}
+		if len(row) > 0 {
+			rowWidth += sepWidth
+		}
+		row = append(row, item)
+		rowWidth += itemWidth
+	}
+	rows = append(rows, row)
+
+	lines := make([]string, len(rows))
+	for i, items := range rows {
+		line := strings.Join(items, sep)
+		if width > 0 {
+			line = alignLine(line, width, h.Align)
+		}
+		lines[i] = line
+	}
+	return strings.Join(lines, "\n")
+}
+
+// alignLine pads a line with spaces to width according to pos
+func alignLine(line string, width int, pos Position) string {
+	gap := max(width-measure.Width(line), 0)
+	switch pos {
+	case Center:
+		return strings.Repeat(" ", gap/2) + line + strings.Repeat(" ", gap-gap/2)
+	case Right:
+		return strings.Repeat(" ", gap) + line
+	default:
+		return line + strings.Repeat(" ", gap)
+	}
+}
@@ -0,0 +1,62 @@
+package tuistyles
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+var testBindings = []KeyBinding{{"q", "quit"}, {"r", "refresh"}, {"?", "help"}}
+
+func TestHelpBar_Render(t *testing.T) {
+	tests := []struct {
+		name  string
+		bar   HelpBar
+		width int
+		want  string
+	}{
+		{"one row", HelpBar{Bindings: testBindings}, 0, "q quit • r refresh • ? help"},
+		{"padded", HelpBar{Bindings: testBindings}, 30, "q quit • r refresh • ? help   "},
+		{"centered", HelpBar{Bindings: testBindings, Align: Center}, 31, "  q quit • r refresh • ? help  "},
+		{"right", HelpBar{Bindings: testBindings, Align: Right}, 29, "  q quit • r refresh • ? help"},
+		{"wraps", HelpBar{Bindings: testBindings}, 20, "q quit • r refresh  \n? help              "},
+		{"separator", HelpBar{Bindings: testBindings, Separator: "  "}, 0, "q quit  r refresh  ? help"},
+		{"key only", HelpBar{Bindings: []KeyBinding{{"esc", ""}}}, 0, "esc"},
+		{"truncated", HelpBar{Bindings: []KeyBinding{{"ctrl+c", "interrupt"}}}, 10, "ctrl+c int"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.bar.Render(tt.width)
+			require.Equal(t, tt.want, measure.StripANSI(got))
+		})
+	}
+}
+
+func TestHelpBar_WrapsEveryRowToWidth(t *testing.T) {
+	bindings := append(append([]KeyBinding{}, testBindings...), KeyBinding{"tab", "next pane"}, KeyBinding{"/", "search"})
+	got := HelpBar{Bindings: bindings, Align: Center}.Render(22)
+
+	for _, line := range strings.Split(got, "\n") {
+		require.Equal(t, 22, measure.Width(line))
+	}
+	require.Len(t, strings.Split(got, "\n"), 3)
+}
+
+func TestHelpBar_Styles(t *testing.T) {
+	bar := HelpBar{
+		Bindings:         []KeyBinding{{"q", "quit"}, {"r", "run"}},
+		KeyStyle:         NewStyle().Underline(true),
+		DescriptionStyle: NewStyle().Italic(true),
+		SeparatorStyle:   NewStyle().Faint(true),
+	}
+
+	require.Equal(t, "\x1b[4mq\x1b[0m \x1b[3mquit\x1b[0m\x1b[2m • \x1b[0m\x1b[4mr\x1b[0m \x1b[3mrun\x1b[0m", bar.Render(0))
+}
+
+func TestHelpBar_Empty(t *testing.T) {
+	require.Empty(t, HelpBar{}.Render(80))
+}
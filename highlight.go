@@ -0,0 +1,189 @@
+package tuistyles
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/orchard9/tui-styles/internal/ansi"
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+// HighlightAll draws every case-insensitive match of substr in s with
+// style's text attributes and colors, the building block of search UIs.
+// Matching looks only at the visible text of s, so a match may span escape
+// sequences, and existing styling is kept: after each match the styling in
+// effect at that point is re-opened. Widths never change.
+//
+// An empty substr or a style without attributes or colors returns s
+// unchanged.
+//
+// Example:
+//
+//	mark := NewStyle().Background(Color("yellow")).Foreground(Color("black"))
+//	fmt.Println(HighlightAll(logLine, query, mark))
+func HighlightAll(s, substr string, style Style) string {
+	if substr == "" || !style.hasAnyStyle() {
+		return s
+	}
+	pieces := splitMatches(s, foldMatches(measure.StripANSI(s), substr))
+
+	prefix := style.stylePrefix()
+	var b, original strings.Builder
+	current := -1
+	for _, p := range pieces {
+		if p.match != current {
+			if current >= 0 {
+				b.WriteString(ansi.Reset())
+				b.WriteString(measure.OpenSGR(original.String()))
+			}
+			if p.match >= 0 {
+				b.WriteString(prefix)
+			}
+			current = p.match
+		}
+		b.WriteString(p.text)
+		original.WriteString(p.text)
+		if p.escape && current >= 0 {
+			// The escape may have reset the highlight
+			b.WriteString(prefix)
+		}
+	}
+	if current >= 0 {
+		b.WriteString(ansi.Reset())
+		b.WriteString(measure.OpenSGR(original.String()))
+	}
+	return b.String()
+}
+
+// ReplaceAll is strings.ReplaceAll for styled text: it replaces every
+// non-overlapping occurrence of old in the visible text of s with
+// replacement, keeping escape sequences. Escapes inside a replaced
+// occurrence are kept after the replacement, so styling opened or closed
+// there still applies. An empty old returns s unchanged.
+//
+// Example:
+//
+//	ReplaceAll(coloredPath, os.Getenv("HOME"), "~")
+func ReplaceAll(s, old, replacement string) string {
+	if old == "" {
+		return s
+	}
+	plain := measure.StripANSI(s)
+	var matches [][2]int
+	for at := 0; ; {
+		i := strings.Index(plain[at:], old)
+		if i < 0 {
+			break
+		}
+		matches = append(matches, [2]int{at + i, at + i + len(old)})
+		at += i + len(old)
+	}
+
+	var b strings.Builder
+	current := -1
+	for _, p := range splitMatches(s, matches) {
+		if p.escape {
+			b.WriteString(p.text)
+			continue
+		}
+		if p.match != current && p.match >= 0 {
+			b.WriteString(replacement)
+		}
+		current = p.match
+		if p.match < 0 {
+			b.WriteString(p.text)
+		}
+	}
+	return b.String()
+}
+
+// matchPiece is a run of a styled string that is either a single escape
+// sequence or text entirely inside or outside one match
+type matchPiece struct {
+	text   string
+	escape bool
+	match  int // Index of the enclosing match, or -1
+}
+
+// splitMatches cuts s into pieces at the boundaries of matches, given as
+// sorted, non-overlapping byte ranges of the visible text. An escape counts
+// as inside a match only when visible text of the match lies on both sides.
+func splitMatches(s string, matches [][2]int) []matchPiece {
+	var pieces []matchPiece
+	pos, m := 0, 0
+	for _, seg := range measure.Segments(s) {
+		if seg.Escape {
+			match := -1
+			if m < len(matches) && pos > matches[m][0] && pos < matches[m][1] {
+				match = m
+			}
+			pieces = append(pieces, matchPiece{text: seg.Text, escape: true, match: match})
+			continue
+		}
+
+		text := seg.Text
+		for text != "" {
+			for m < len(matches) && pos >= matches[m][1] {
+				m++
+			}
+			next, match := pos+len(text), -1
+			switch {
+			case m < len(matches) && pos >= matches[m][0]:
+				next, match = min(next, matches[m][1]), m
+			case m < len(matches):
+				next = min(next, matches[m][0])
+			}
+			pieces = append(pieces, matchPiece{text: text[:next-pos], match: match})
+			text = text[next-pos:]
+			pos = next
+		}
+	}
+	return pieces
+}
+
+// foldMatches returns the byte ranges of the non-overlapping matches of
+// substr in s under Unicode simple case folding
+func foldMatches(s, substr string) [][2]int {
+	var matches [][2]int
+	for start := 0; start < len(s); {
+		if end, ok := foldPrefix(s[start:], substr); ok {
+			matches = append(matches, [2]int{start, start + end})
+			start += end
+			continue
+		}
+		_, size := utf8.DecodeRuneInString(s[start:])
+		start += size
+	}
+	return matches
+}
+
+// foldPrefix reports whether s starts with prefix under simple case
+// folding, and how many bytes of s the match covers
+func foldPrefix(s, prefix string) (int, bool) {
+	i := 0
+	for _, want := range prefix {
+		if i >= len(s) {
+			return 0, false
+		}
+		got, size := utf8.DecodeRuneInString(s[i:])
+		if !foldEqual(got, want) {
+			return 0, false
+		}
+		i += size
+	}
+	return i, true
+}
+
+// foldEqual reports whether two runes are equal under simple case folding
+func foldEqual(a, b rune) bool {
+	if a == b {
+		return true
+	}
+	for r := unicode.SimpleFold(a); r != a; r = unicode.SimpleFold(r) {
+		if r == b {
+			return true
+		}
+	}
+	return false
+}
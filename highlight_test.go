@@ -0,0 +1,70 @@
+package tuistyles
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+func TestHighlightAll(t *testing.T) {
+	const (
+		red   = "\x1b[31m"
+		reset = "\x1b[0m"
+		rev   = "\x1b[7m"
+	)
+	mark := NewStyle().Reverse(true)
+
+	tests := []struct {
+		name   string
+		s      string
+		substr string
+		want   string
+	}{
+		{"no match", "hello", "xyz", "hello"},
+		{"plain", "a cat", "cat", "a " + rev + "cat" + reset},
+		{"every match, any case", "Go go GO", "go", rev + "Go" + reset + " " + rev + "go" + reset + " " + rev + "GO" + reset},
+		{"non-unicode fold", "STRASSE straße", "straße", "STRASSE " + rev + "straße" + reset},
+		{"reopens styling after match", red + "a cat here" + reset, "cat", red + "a " + rev + "cat" + reset + red + " here" + reset},
+		{"match across escape", "ab" + red + "cd" + reset, "bc", "a" + rev + "b" + red + rev + "c" + reset + red + "d" + reset},
+		{"match ending at escape", "ab" + red + "cd" + reset, "ab", rev + "ab" + reset + red + "cd" + reset},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := HighlightAll(tt.s, tt.substr, mark)
+			require.Equal(t, tt.want, got)
+			require.Equal(t, measure.Width(tt.s), measure.Width(got))
+		})
+	}
+
+	require.Equal(t, "abc", HighlightAll("abc", "", mark))
+	require.Equal(t, "abc", HighlightAll("abc", "b", NewStyle()))
+}
+
+func TestReplaceAll(t *testing.T) {
+	const (
+		red   = "\x1b[31m"
+		reset = "\x1b[0m"
+	)
+
+	tests := []struct {
+		name        string
+		s, old, new string
+		want        string
+	}{
+		{"plain", "a-b-c", "-", "+", "a+b+c"},
+		{"case sensitive", "Go go", "go", "x", "Go x"},
+		{"keeps styling", red + "/home/me/src" + reset, "/home/me", "~", red + "~/src" + reset},
+		{"escape inside match moves after", "ab" + red + "cd" + reset, "bc", "X", "aX" + red + "d" + reset},
+		{"empty old", "abc", "", "x", "abc"},
+		{"delete", "a b c", " ", "", "abc"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, ReplaceAll(tt.s, tt.old, tt.new))
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package tuistyles
+
+import "slices"
+
+// RenderHook intercepts rendering at three points, for cross-cutting
+// concerns such as masking words, stamping a watermark, or recording the
+// sizes of rendered blocks. Each stage is optional; a nil function leaves
+// its stage unchanged.
+//
+// Hooks run in the order they were added: every PreRender first, then
+// every PostLayout, then every PostANSI.
+//
+// Example:
+//
+//	mask := RenderHook{PreRender: func(s Style, str string) (Style, string) {
+//		return s, strings.ReplaceAll(str, password, "****")
+//	}}
+//	r := NewRenderer().Hooks(mask)
+type RenderHook struct {
+	// PreRender receives the style and text before anything is drawn and
+	// returns the ones to render
+	PreRender func(s Style, str string) (Style, string)
+
+	// PostLayout receives the rendered block, sized, aligned, and framed,
+	// before it is adapted to the terminal
+	PostLayout func(block string) string
+
+	// PostANSI receives the final output exactly as it will be written,
+	// after glyph fallbacks and output target adjustments
+	PostANSI func(out string) string
+}
+
+// Hooks adds render hooks that run on everything the renderer draws, after
+// any added before.
+//
+// Returns a new Renderer, leaving the original unchanged.
+//
+// Example:
+//
+//	var cells atomic.Int64
+//	sizes := RenderHook{PostLayout: func(block string) string {
+//		w, h := Size(block)
+//		cells.Add(int64(w * h))
+//		return block
+//	}}
+//	r := NewRenderer().Hooks(sizes)
+func (r Renderer) Hooks(hooks ...RenderHook) Renderer {
+	r2 := r
+	r2.hooks = append(slices.Clip(r.hooks), hooks...)
+	return r2
+}
+
+// RenderWith renders str like Render, passing it through hooks on the way.
+// Without a Renderer nothing adapts the output, so PostLayout and PostANSI
+// see the same string.
+//
+// Example:
+//
+//	fmt.Println(s.RenderWith(comment, profanityFilter))
+func (s Style) RenderWith(str string, hooks ...RenderHook) string {
+	s, str = preRender(hooks, s, str)
+	out := postLayout(hooks, s.Render(str))
+	return postANSI(hooks, out)
+}
+
+// preRender runs the PreRender stage of hooks
+func preRender(hooks []RenderHook, s Style, str string) (Style, string) {
+	for _, h := range hooks {
+		if h.PreRender != nil {
+			s, str = h.PreRender(s, str)
+		}
+	}
+	return s, str
+}
+
+// postLayout runs the PostLayout stage of hooks
+func postLayout(hooks []RenderHook, block string) string {
+	for _, h := range hooks {
+		if h.PostLayout != nil {
+			block = h.PostLayout(block)
+		}
+	}
+	return block
+}
+
+// postANSI runs the PostANSI stage of hooks
+func postANSI(hooks []RenderHook, out string) string {
+	for _, h := range hooks {
+		if h.PostANSI != nil {
+			out = h.PostANSI(out)
+		}
+	}
+	return out
+}
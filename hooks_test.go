@@ -0,0 +1,79 @@
+package tuistyles
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderer_Hooks(t *testing.T) {
+	var stages []string
+	trace := func(name string) RenderHook {
+		return RenderHook{
+			PreRender: func(s Style, str string) (Style, string) {
+				stages = append(stages, name+" pre")
+				return s, str
+			},
+			PostLayout: func(block string) string {
+				stages = append(stages, name+" layout")
+				return block
+			},
+			PostANSI: func(out string) string {
+				stages = append(stages, name+" ansi")
+				return out
+			},
+		}
+	}
+
+	r := NewRenderer().Hooks(trace("a")).Hooks(trace("b"))
+	r.Render(NewStyle(), "x")
+
+	require.Equal(t, []string{"a pre", "b pre", "a layout", "b layout", "a ansi", "b ansi"}, stages)
+}
+
+func TestRenderer_HookStages(t *testing.T) {
+	mask := RenderHook{PreRender: func(s Style, str string) (Style, string) {
+		return s.Bold(true), strings.ReplaceAll(str, "secret", "******")
+	}}
+	var layout string
+	capture := RenderHook{PostLayout: func(block string) string {
+		layout = block
+		return block
+	}}
+	stamp := RenderHook{PostANSI: func(out string) string {
+		return out + " ©"
+	}}
+
+	r := NewRenderer().Target(TargetPlain).Hooks(mask, capture, stamp)
+	got := r.Render(NewStyle(), "the secret")
+
+	require.Equal(t, "the ****** ©", got)
+	require.Equal(t, NewStyle().Bold(true).Render("the ******"), layout, "PostLayout sees styling before the target strips it")
+}
+
+func TestRenderer_HooksImmutable(t *testing.T) {
+	upper := RenderHook{PostANSI: strings.ToUpper}
+	base := NewRenderer().Target(TargetPlain)
+	hooked := base.Hooks(upper)
+
+	require.Equal(t, "x", base.Render(NewStyle(), "x"))
+	require.Equal(t, "X", hooked.Render(NewStyle(), "x"))
+
+	// Adding to a shared parent never leaks between its children
+	a := hooked.Hooks(RenderHook{PostANSI: func(s string) string { return s + "a" }})
+	b := hooked.Hooks(RenderHook{PostANSI: func(s string) string { return s + "b" }})
+	require.Equal(t, "Xa", a.Render(NewStyle(), "x"))
+	require.Equal(t, "Xb", b.Render(NewStyle(), "x"))
+}
+
+func TestStyle_RenderWith(t *testing.T) {
+	s := NewStyle().Width(5).Align(Right)
+	got := s.RenderWith("ab",
+		RenderHook{PreRender: func(s Style, str string) (Style, string) { return s, str + "c" }},
+		RenderHook{PostLayout: func(block string) string { return "[" + block + "]" }},
+	)
+
+	require.Equal(t, "[  abc]", got)
+	require.Equal(t, s.Render("ab"), s.RenderWith("ab"))
+}
@@ -0,0 +1,17 @@
+package tuistyles
+
+import "github.com/orchard9/tui-styles/internal/ansi"
+
+// Hyperlink makes text a clickable link to url using the OSC 8 escape
+// sequence. Text may already be styled. Terminals without hyperlink support
+// ignore the sequence and show text as is, and the link adds no width.
+//
+// Example:
+//
+//	link := Hyperlink("https://example.com", NewStyle().Underline(true).Render("docs"))
+func Hyperlink(url, text string) string {
+	if url == "" {
+		return text
+	}
+	return ansi.Hyperlink(url, text)
+}
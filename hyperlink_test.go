@@ -0,0 +1,21 @@
+package tuistyles
+
+import (
+	"testing"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+func TestHyperlink(t *testing.T) {
+	got := Hyperlink("https://example.com", "docs")
+
+	if want := "\x1b]8;;https://example.com\x1b\\docs\x1b]8;;\x1b\\"; got != want {
+		t.Errorf("Hyperlink() = %q, want %q", got, want)
+	}
+	if w := measure.Width(got); w != 4 {
+		t.Errorf("width = %d, want 4 (escape sequences take no cells)", w)
+	}
+	if got := Hyperlink("", "docs"); got != "docs" {
+		t.Errorf("empty url should return text unchanged, got %q", got)
+	}
+}
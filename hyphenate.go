@@ -0,0 +1,234 @@
+package tuistyles
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+// Hyphenator finds hyphenation points in words using Liang's algorithm, the
+// pattern-based method used by TeX.
+//
+// A Hyphenator is immutable once built and safe for concurrent use. The
+// built-in DefaultHyphenator uses a small English pattern set covering
+// common prefixes, suffixes, and doubled consonants; for better results
+// load a full TeX pattern file (such as hyph-en-us.tex) with NewHyphenator.
+type Hyphenator struct {
+	patterns      map[string][]int
+	exceptions    map[string][]int
+	maxPatternLen int
+	leftMin       int
+	rightMin      int
+}
+
+// NewHyphenator builds a Hyphenator from TeX-format patterns ("hy3ph",
+// ".un1") and exceptions written with hyphens at every break ("ta-ble").
+// Words are never broken within the first 2 or last 3 letters.
+//
+// Example:
+//
+//	h := NewHyphenator(strings.Fields(patternFile), []string{"ta-ble"})
+//	h.Syllables("tablecloth")
+func NewHyphenator(patterns, exceptions []string) Hyphenator {
+	h := Hyphenator{
+		patterns:   make(map[string][]int, len(patterns)),
+		exceptions: make(map[string][]int, len(exceptions)),
+		leftMin:    2,
+		rightMin:   3,
+	}
+
+	for _, p := range patterns {
+		letters, values := parsePattern(p)
+		if letters == "" {
+			continue
+		}
+		h.patterns[letters] = values
+		h.maxPatternLen = max(h.maxPatternLen, utf8.RuneCountInString(letters))
+	}
+
+	for _, e := range exceptions {
+		var points []int
+		n := 0
+		for _, r := range e {
+			if r == '-' {
+				points = append(points, n)
+				continue
+			}
+			n++
+		}
+		h.exceptions[strings.ToLower(strings.ReplaceAll(e, "-", ""))] = points
+	}
+
+	return h
+}
+
+// parsePattern splits a TeX pattern into its letters and the value before
+// each letter position ("hy3ph" -> "hyph", [0 0 3 0 0])
+func parsePattern(p string) (string, []int) {
+	var letters strings.Builder
+	values := []int{0}
+	for _, r := range p {
+		if r >= '0' && r <= '9' {
+			values[len(values)-1] = int(r - '0')
+			continue
+		}
+		letters.WriteRune(unicode.ToLower(r))
+		values = append(values, 0)
+	}
+	return letters.String(), values
+}
+
+// englishPatterns is the built-in pattern set: common prefixes and
+// suffixes, and breaks between doubled consonants. Even values inhibit a
+// break that another pattern allows.
+var englishPatterns = []string{
+	".un1", ".dis1", ".mis1", ".non1", ".over1", ".under1", ".inter1",
+	".trans1", ".super1", ".multi1", ".anti1", ".sub1",
+	"1tion", "1sion", "1ment", "1ness", "1less", "1ful", "1ture", "1ship",
+	"1hood", "1ward", "1ing.", "1ings.",
+	"b1b", "c1c", "d1d", "f1f", "g1g", "l1l", "m1m", "n1n", "p1p", "r1r",
+	"s1s", "t1t", "z1z", "ck1",
+	// Keep "-ing" with a doubled consonant: "run-ning", not "runn-ing"
+	"bb2ing.", "dd2ing.", "gg2ing.", "ll2ing.", "mm2ing.", "nn2ing.",
+	"pp2ing.", "rr2ing.", "ss2ing.", "tt2ing.", "zz2ing.",
+}
+
+// englishExceptions fixes common words the patterns would break badly
+var englishExceptions = []string{
+	"hy-phen-ation", "com-put-er", "ta-ble", "pro-gram", "in-for-ma-tion",
+	"ter-mi-nal", "win-dow", "sub-tle", "sub-tly", "bring", "thing",
+	"string", "strings", "spring", "during", "nothing", "something",
+}
+
+// defaultHyphenator is built once from the English patterns
+var defaultHyphenator = NewHyphenator(englishPatterns, englishExceptions)
+
+// DefaultHyphenator returns the built-in English hyphenator.
+func DefaultHyphenator() Hyphenator {
+	return defaultHyphenator
+}
+
+// Points returns the rune offsets in word where a hyphen may be inserted.
+// Only words made entirely of letters are hyphenated.
+func (h Hyphenator) Points(word string) []int {
+	lower := strings.ToLower(word)
+	runes := []rune(lower)
+	n := len(runes)
+	if n < h.leftMin+h.rightMin {
+		return nil
+	}
+	for _, r := range runes {
+		if !unicode.IsLetter(r) {
+			return nil
+		}
+	}
+
+	if points, ok := h.exceptions[lower]; ok {
+		return points
+	}
+
+	// Score every inter-letter position of ".word." with the highest
+	// value from any matching pattern; odd scores allow a break
+	padded := append(append([]rune{'.'}, runes...), '.')
+	scores := make([]int, len(padded)+1)
+	for i := range padded {
+		for j := i + 1; j <= len(padded) && j-i <= h.maxPatternLen; j++ {
+			values, ok := h.patterns[string(padded[i:j])]
+			if !ok {
+				continue
+			}
+			for k, v := range values {
+				scores[i+k] = max(scores[i+k], v)
+			}
+		}
+	}
+
+	var points []int
+	for p := h.leftMin; p <= n-h.rightMin; p++ {
+		// scores[p+1] sits before runes[p] because of the leading '.'
+		if scores[p+1]%2 == 1 {
+			points = append(points, p)
+		}
+	}
+	return points
+}
+
+// Syllables splits word at its hyphenation points.
+//
+// Example:
+//
+//	DefaultHyphenator().Syllables("hyphenation") // ["hy", "phen", "ation"]
+func (h Hyphenator) Syllables(word string) []string {
+	points := h.Points(word)
+	if len(points) == 0 {
+		return []string{word}
+	}
+
+	runes := []rune(word)
+	parts := make([]string, 0, len(points)+1)
+	prev := 0
+	for _, p := range points {
+		parts = append(parts, string(runes[prev:p]))
+		prev = p
+	}
+	return append(parts, string(runes[prev:]))
+}
+
+// split breaks word at the last hyphenation point that leaves the head,
+// plus its hyphen, no wider than avail cells. Leading and trailing
+// punctuation stay attached to the head and tail. Words containing escape
+// sequences are never split.
+func (h Hyphenator) split(word string, avail int) (head, tail string, ok bool) {
+	if avail < 2 || strings.ContainsRune(word, '\x1b') {
+		return "", "", false
+	}
+
+	// Hyphenate only the letters, keeping surrounding punctuation
+	start := strings.IndexFunc(word, unicode.IsLetter)
+	end := strings.LastIndexFunc(word, unicode.IsLetter)
+	if start < 0 {
+		return "", "", false
+	}
+	_, lastSize := utf8.DecodeRuneInString(word[end:])
+	core := word[start : end+lastSize]
+
+	runes := []rune(core)
+	points := h.Points(core)
+	for i := len(points) - 1; i >= 0; i-- {
+		candidate := word[:start] + string(runes[:points[i]]) + "-"
+		if measure.Width(candidate) <= avail {
+			return candidate, string(runes[points[i]:]) + word[end+lastSize:], true
+		}
+	}
+	return "", "", false
+}
+
+// WrapHyphenated word-wraps str to width like Wrap, but hyphenates words
+// with h to fill lines instead of leaving a long word to overflow or push
+// a mostly empty line. It suits narrow columns such as table cells.
+//
+// Example:
+//
+//	WrapHyphenated("internationalization matters", 12, DefaultHyphenator())
+func WrapHyphenated(str string, width int, h Hyphenator) string {
+	return wrapText(str, width, &h, BreakAnywhere)
+}
+
+// Hyphenate makes Render word-wrap content to the style's Width, breaking
+// long words with DefaultHyphenator. It has no effect without a Width and
+// is meant for narrow columns (roughly under 20 cells) where plain wrapping
+// leaves ragged, mostly empty lines.
+//
+// Returns a new Style with hyphenate set, leaving the original unchanged.
+//
+// Example:
+//
+//	cell := NewStyle().Width(12).Hyphenate(true)
+//	fmt.Println(cell.Render("Internationalization support"))
+func (s Style) Hyphenate(v bool) Style {
+	s2 := s
+	s2.hyphenate = &v
+	return s2
+}
@@ -0,0 +1,83 @@
+package tuistyles
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+func TestHyphenator_Syllables(t *testing.T) {
+	h := DefaultHyphenator()
+
+	tests := []struct {
+		word string
+		want []string
+	}{
+		{"hyphenation", []string{"hy", "phen", "ation"}},
+		{"programming", []string{"program", "ming"}},
+		{"running", []string{"run", "ning"}},
+		{"development", []string{"develop", "ment"}},
+		{"distance", []string{"dis", "tance"}},
+		{"Thinking", []string{"Think", "ing"}},
+		{"bring", []string{"bring"}},
+		{"cat", []string{"cat"}},
+		{"x2y2z2", []string{"x2y2z2"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.word, func(t *testing.T) {
+			require.Equal(t, tt.want, h.Syllables(tt.word))
+		})
+	}
+}
+
+func TestNewHyphenator(t *testing.T) {
+	// Patterns from Liang's thesis example
+	h := NewHyphenator([]string{"hy3ph", "he2n", "hena4", "hen5at", "1na", "n2at", "1tio", "2io", "o2n"}, nil)
+	require.Equal(t, []string{"hy", "phen", "ation"}, h.Syllables("hyphenation"))
+
+	h = NewHyphenator(nil, []string{"ta-ble"})
+	require.Equal(t, []string{"Ta", "ble"}, h.Syllables("Table"), "exceptions are case-insensitive")
+	require.Equal(t, []string{"tablet"}, h.Syllables("tablet"))
+}
+
+func TestHyphenator_LeftRightMinimums(t *testing.T) {
+	h := NewHyphenator([]string{"1a", "1b", "1c", "1d", "1e", "1f"}, nil)
+
+	// Breaks allowed everywhere, but never within the first 2 or last 3 letters
+	require.Equal(t, []int{2, 3}, h.Points("abcdef"))
+}
+
+func TestWrapHyphenated(t *testing.T) {
+	h := DefaultHyphenator()
+	text := "Internationalization support for rendering terminal applications"
+
+	got := WrapHyphenated(text, 12, h)
+	lines := strings.Split(got, "\n")
+	for _, line := range lines {
+		require.LessOrEqual(t, measure.Width(line), 12, "line %q", line)
+	}
+	require.Equal(t, "for render-", lines[3], "words are hyphenated to fill the line")
+
+	// Removing the hyphens at line ends restores the original words
+	rejoined := strings.ReplaceAll(got, "-\n", "")
+	require.Equal(t, text, strings.ReplaceAll(rejoined, "\n", " "))
+}
+
+func TestWrapHyphenated_KeepsPunctuation(t *testing.T) {
+	got := WrapHyphenated("(development),", 9, DefaultHyphenator())
+	require.Equal(t, "(develop-\nment),", got)
+}
+
+func TestStyle_Hyphenate(t *testing.T) {
+	s := NewStyle().Width(10).Hyphenate(true)
+	got := s.Render("happiness development")
+
+	require.Equal(t, "happiness\ndevelop-\nment", got)
+
+	// Without a width there is nothing to wrap to
+	require.Equal(t, "happiness development", NewStyle().Hyphenate(true).Render("happiness development"))
+}
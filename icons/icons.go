@@ -0,0 +1,154 @@
+// Package icons maps semantic names such as "folder", "git", and "warning"
+// to glyphs, choosing between Nerd Font icons, plain Unicode symbols, and
+// ASCII according to what the terminal can draw.
+//
+// Example:
+//
+//	set := icons.Detect()
+//	fmt.Println(set.Get("warning"), "disk almost full")
+package icons
+
+import (
+	"maps"
+	"slices"
+
+	"github.com/orchard9/tui-styles/capabilities"
+)
+
+// Level is the kind of glyph an icon is drawn with.
+type Level int
+
+const (
+	// LevelNerdFont uses icons from a Nerd Font patched font
+	LevelNerdFont Level = iota
+	// LevelUnicode uses symbols most Unicode fonts include
+	LevelUnicode
+	// LevelASCII uses 7-bit ASCII only
+	LevelASCII
+)
+
+// String returns human-readable icon level name
+func (l Level) String() string {
+	switch l {
+	case LevelNerdFont:
+		return "NerdFont"
+	case LevelUnicode:
+		return "Unicode"
+	case LevelASCII:
+		return "ASCII"
+	default:
+		return "Unknown"
+	}
+}
+
+// LevelFor returns the richest level the capabilities allow: Nerd Font
+// icons when the font has them (see capabilities.Capabilities.NerdFont),
+// Unicode symbols when the terminal draws full Unicode, and ASCII
+// otherwise.
+func LevelFor(c capabilities.Capabilities) Level {
+	switch {
+	case c.Unicode < capabilities.UnicodeFull:
+		return LevelASCII
+	case c.NerdFont:
+		return LevelNerdFont
+	default:
+		return LevelUnicode
+	}
+}
+
+// Icon is one icon at each level.
+type Icon struct {
+	NerdFont string
+	Unicode  string
+	ASCII    string
+}
+
+// For returns the icon's glyph at level, falling back to the next plainer
+// level when it has none there.
+func (i Icon) For(level Level) string {
+	if level <= LevelNerdFont && i.NerdFont != "" {
+		return i.NerdFont
+	}
+	if level <= LevelUnicode && i.Unicode != "" {
+		return i.Unicode
+	}
+	return i.ASCII
+}
+
+// builtin is the registry of named icons
+var builtin = map[string]Icon{
+	"folder":   {NerdFont: "\uf07b", Unicode: "▸", ASCII: "/"},
+	"file":     {NerdFont: "\uf15b", Unicode: "·", ASCII: "-"},
+	"home":     {NerdFont: "\uf015", Unicode: "⌂", ASCII: "~"},
+	"git":      {NerdFont: "\ue702", Unicode: "±", ASCII: "git"},
+	"branch":   {NerdFont: "\ue0a0", Unicode: "⎇", ASCII: "@"},
+	"terminal": {NerdFont: "\uf120", Unicode: "❯", ASCII: ">"},
+	"search":   {NerdFont: "\uf002", Unicode: "⌕", ASCII: "?"},
+	"lock":     {NerdFont: "\uf023", Unicode: "⚿", ASCII: "#"},
+	"clock":    {NerdFont: "\uf017", Unicode: "◷", ASCII: "@"},
+	"info":     {NerdFont: "\uf05a", Unicode: "ℹ", ASCII: "i"},
+	"success":  {NerdFont: "\uf00c", Unicode: "✔", ASCII: "ok"},
+	"warning":  {NerdFont: "\uf071", Unicode: "⚠", ASCII: "!"},
+	"error":    {NerdFont: "\uf057", Unicode: "✖", ASCII: "x"},
+}
+
+// Lookup returns the built-in icon with the given name.
+func Lookup(name string) (Icon, bool) {
+	icon, ok := builtin[name]
+	return icon, ok
+}
+
+// Names returns the names of the built-in icons, sorted.
+func Names() []string {
+	return slices.Sorted(maps.Keys(builtin))
+}
+
+// Set draws icons at one level. It starts with the built-in icons; With
+// adds or replaces icons.
+//
+// Like tuistyles.Style, Set is immutable: With returns a new Set, so one
+// can be shared freely between goroutines.
+type Set struct {
+	level Level
+	extra map[string]Icon // Never modified after construction
+}
+
+// New returns a Set that draws icons at level.
+func New(level Level) Set {
+	return Set{level: level}
+}
+
+// Detect returns a Set at the level the terminal supports (see LevelFor
+// and capabilities.Detect).
+func Detect() Set {
+	return New(LevelFor(capabilities.Detect()))
+}
+
+// Level returns the level the set draws icons at.
+func (s Set) Level() Level {
+	return s.level
+}
+
+// With returns a copy of the set with icon registered under name,
+// replacing any built-in icon of that name.
+func (s Set) With(name string, icon Icon) Set {
+	extra := maps.Clone(s.extra)
+	if extra == nil {
+		extra = make(map[string]Icon, 1)
+	}
+	extra[name] = icon
+	s.extra = extra
+	return s
+}
+
+// Get returns the glyph for the named icon, or "" when there is no icon of
+// that name.
+func (s Set) Get(name string) string {
+	if icon, ok := s.extra[name]; ok {
+		return icon.For(s.level)
+	}
+	if icon, ok := builtin[name]; ok {
+		return icon.For(s.level)
+	}
+	return ""
+}
@@ -0,0 +1,69 @@
+package icons
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/orchard9/tui-styles/capabilities"
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+func TestLevelFor(t *testing.T) {
+	tests := []struct {
+		name string
+		caps capabilities.Capabilities
+		want Level
+	}{
+		{"nerd font", capabilities.Capabilities{Unicode: capabilities.UnicodeFull, NerdFont: true}, LevelNerdFont},
+		{"unicode", capabilities.Capabilities{Unicode: capabilities.UnicodeFull}, LevelUnicode},
+		{"linux console", capabilities.Capabilities{Unicode: capabilities.UnicodeBoxDrawing, NerdFont: true}, LevelASCII},
+		{"ascii", capabilities.Capabilities{Unicode: capabilities.UnicodeASCII}, LevelASCII},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, LevelFor(tt.caps))
+		})
+	}
+}
+
+func TestSet_Get(t *testing.T) {
+	require.Equal(t, "\uf071", New(LevelNerdFont).Get("warning"))
+	require.Equal(t, "⚠", New(LevelUnicode).Get("warning"))
+	require.Equal(t, "!", New(LevelASCII).Get("warning"))
+	require.Empty(t, New(LevelUnicode).Get("no-such-icon"))
+}
+
+func TestSet_With(t *testing.T) {
+	base := New(LevelNerdFont)
+	custom := base.
+		With("rocket", Icon{NerdFont: "\uf135", ASCII: "^"}).
+		With("warning", Icon{ASCII: "WARN"})
+
+	require.Equal(t, "\uf135", custom.Get("rocket"))
+	require.Equal(t, "WARN", custom.Get("warning"), "falls back to the ASCII glyph")
+	require.Equal(t, "^", New(LevelUnicode).With("rocket", Icon{NerdFont: "\uf135", ASCII: "^"}).Get("rocket"))
+
+	require.Empty(t, base.Get("rocket"), "original set is unchanged")
+	require.Equal(t, "\uf071", base.Get("warning"))
+}
+
+func TestBuiltinIcons(t *testing.T) {
+	names := Names()
+	require.Contains(t, names, "folder")
+	require.Contains(t, names, "git")
+	require.IsIncreasing(t, names)
+
+	for _, name := range names {
+		icon, ok := Lookup(name)
+		require.True(t, ok, name)
+		require.NotEmpty(t, icon.NerdFont, name)
+		require.NotEmpty(t, icon.Unicode, name)
+		require.NotEmpty(t, icon.ASCII, name)
+		for _, r := range icon.ASCII {
+			require.Less(t, r, rune(0x80), "%s ASCII glyph %q", name, icon.ASCII)
+		}
+		require.LessOrEqual(t, measure.Width(icon.Unicode), 1, "%s Unicode glyph %q", name, icon.Unicode)
+	}
+}
@@ -0,0 +1,231 @@
+package image
+
+import (
+	goimage "image"
+
+	tuistyles "github.com/orchard9/tui-styles"
+)
+
+// pixel is a resampled image pixel: straight (not premultiplied) color
+// channels in 0-255, alpha in 0-1, and the terminal color once quantized
+type pixel struct {
+	r, g, b, a float64
+	color      string
+}
+
+// opaque reports whether the pixel is drawn rather than left transparent
+func (p pixel) opaque() bool {
+	return p.a >= 0.5
+}
+
+// luminance returns the pixel's perceived brightness in 0-1
+func (p pixel) luminance() float64 {
+	return (0.2126*p.r + 0.7152*p.g + 0.0722*p.b) / 255
+}
+
+// resample scales img to w x h pixels, averaging the source pixels each
+// target pixel covers
+func resample(img goimage.Image, w, h int) [][]pixel {
+	bounds := img.Bounds()
+	sw, sh := bounds.Dx(), bounds.Dy()
+
+	px := make([][]pixel, h)
+	for ty := range h {
+		px[ty] = make([]pixel, w)
+		y0 := bounds.Min.Y + ty*sh/h
+		y1 := max(bounds.Min.Y+(ty+1)*sh/h, y0+1)
+		for tx := range w {
+			x0 := bounds.Min.X + tx*sw/w
+			x1 := max(bounds.Min.X+(tx+1)*sw/w, x0+1)
+
+			var r, g, b, a float64
+			for y := y0; y < y1; y++ {
+				for x := x0; x < x1; x++ {
+					pr, pg, pb, pa := img.At(x, y).RGBA()
+					r, g, b, a = r+float64(pr), g+float64(pg), b+float64(pb), a+float64(pa)
+				}
+			}
+
+			n := float64((y1 - y0) * (x1 - x0))
+			p := pixel{a: a / n / 0xFFFF}
+			if a > 0 {
+				p.r, p.g, p.b = r/a*255, g/a*255, b/a*255
+			}
+			px[ty][tx] = p
+		}
+	}
+	return px
+}
+
+// bayer4 is the 4x4 ordered dithering threshold matrix
+var bayer4 = [4][4]float64{
+	{0, 8, 2, 10},
+	{12, 4, 14, 6},
+	{3, 11, 1, 9},
+	{15, 7, 13, 5},
+}
+
+// bayerOffset returns the ordered dither offset at (x, y), in -0.5..0.5
+func bayerOffset(x, y int) float64 {
+	return (bayer4[y%4][x%4]+0.5)/16 - 0.5
+}
+
+// orderedSpread is the ordered dither amplitude per palette, roughly the
+// distance between neighboring palette colors
+var orderedSpread = map[Palette]float64{ANSI256: 48, ANSI16: 128}
+
+// quantizeImage assigns every opaque pixel a terminal color from palette,
+// diffusing or patterning the rounding error as dither asks
+func quantizeImage(px [][]pixel, palette Palette, dither Dither) {
+	if palette == TrueColor {
+		dither = DitherNone
+	}
+
+	q := quantizer{palette: palette, cache: make(map[[3]uint8]pixel)}
+	for y := range px {
+		for x := range px[y] {
+			p := px[y][x]
+			if !p.opaque() {
+				continue
+			}
+
+			target := p
+			if dither == DitherOrdered {
+				offset := bayerOffset(x, y) * orderedSpread[palette]
+				target.r, target.g, target.b = p.r+offset, p.g+offset, p.b+offset
+			}
+
+			out := q.nearest(target)
+			out.a = p.a
+			px[y][x] = out
+
+			if dither == DitherFloydSteinberg {
+				er, eg, eb := p.r-out.r, p.g-out.g, p.b-out.b
+				diffuse(px, x, y, func(n *pixel, weight float64) {
+					if n.opaque() {
+						n.r += er * weight
+						n.g += eg * weight
+						n.b += eb * weight
+					}
+				})
+			}
+		}
+	}
+}
+
+// nearest quantizes a single pixel without dithering
+func nearest(p pixel, palette Palette) pixel {
+	q := quantizer{palette: palette}
+	return q.nearest(p)
+}
+
+// quantizer maps colors to a palette, caching palette lookups
+type quantizer struct {
+	palette Palette
+	cache   map[[3]uint8]pixel
+}
+
+// nearest returns the palette entry closest to p, with its actual RGB
+func (q *quantizer) nearest(p pixel) pixel {
+	key := [3]uint8{clampChannel(p.r), clampChannel(p.g), clampChannel(p.b)}
+	if out, ok := q.cache[key]; ok {
+		return out
+	}
+
+	color := tuistyles.Color(hex(key[0], key[1], key[2]))
+	switch q.palette {
+	case ANSI256:
+		color = color.To256()
+	case ANSI16:
+		color = color.To16()
+	}
+	r, g, b, _ := color.RGB()
+
+	out := pixel{r: float64(r), g: float64(g), b: float64(b), a: 1, color: string(color)}
+	if q.cache != nil {
+		q.cache[key] = out
+	}
+	return out
+}
+
+// clampChannel rounds a channel value into 0-255
+func clampChannel(v float64) uint8 {
+	switch {
+	case v <= 0:
+		return 0
+	case v >= 255:
+		return 255
+	default:
+		return uint8(v + 0.5)
+	}
+}
+
+// thresholdImage decides which braille dots are raised: light pixels, or
+// dark ones when invert is set. Transparent pixels are never raised.
+func thresholdImage(px [][]pixel, dither Dither, invert bool) [][]bool {
+	level := make([][]float64, len(px))
+	for y := range px {
+		level[y] = make([]float64, len(px[y]))
+		for x, p := range px[y] {
+			level[y][x] = p.luminance()
+			if invert {
+				level[y][x] = 1 - level[y][x]
+			}
+		}
+	}
+
+	raised := make([][]bool, len(px))
+	for y := range px {
+		raised[y] = make([]bool, len(px[y]))
+		for x := range px[y] {
+			if !px[y][x].opaque() {
+				continue
+			}
+
+			v := level[y][x]
+			switch dither {
+			case DitherOrdered:
+				raised[y][x] = v > bayerOffset(x, y)+0.5
+			case DitherFloydSteinberg:
+				raised[y][x] = v >= 0.5
+				err := v
+				if raised[y][x] {
+					err = v - 1
+				}
+				diffuseLevels(level, x, y, err)
+			default:
+				raised[y][x] = v >= 0.5
+			}
+		}
+	}
+	return raised
+}
+
+// fsWeights are the Floyd-Steinberg error shares for the right,
+// lower-left, lower, and lower-right neighbors
+var fsWeights = [4]struct {
+	dx, dy int
+	weight float64
+}{
+	{1, 0, 7.0 / 16}, {-1, 1, 3.0 / 16}, {0, 1, 5.0 / 16}, {1, 1, 1.0 / 16},
+}
+
+// diffuse calls spread for each in-bounds Floyd-Steinberg neighbor of (x, y)
+func diffuse(px [][]pixel, x, y int, spread func(n *pixel, weight float64)) {
+	for _, w := range fsWeights {
+		nx, ny := x+w.dx, y+w.dy
+		if ny < len(px) && nx >= 0 && nx < len(px[ny]) {
+			spread(&px[ny][nx], w.weight)
+		}
+	}
+}
+
+// diffuseLevels spreads a brightness error to the Floyd-Steinberg neighbors
+func diffuseLevels(level [][]float64, x, y int, err float64) {
+	for _, w := range fsWeights {
+		nx, ny := x+w.dx, y+w.dy
+		if ny < len(level) && nx >= 0 && nx < len(level[ny]) {
+			level[ny][nx] += err * w.weight
+		}
+	}
+}
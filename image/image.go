@@ -0,0 +1,247 @@
+// Package image converts images to ANSI art that composes with tuistyles
+// borders and layout functions.
+//
+// Import it under a name that does not clash with the standard library:
+//
+//	import tuiimage "github.com/orchard9/tui-styles/image"
+//
+//	art := tuiimage.Render(img, tuiimage.Options{Width: 40, Height: 20})
+//	fmt.Println(tuistyles.NewStyle().Border(tuistyles.RoundedBorder()).Render(art))
+//
+// Every line of the output is exactly as wide as the others and ends with
+// a reset, so the result can be passed to Style.Render, Place, and the
+// Join functions like any other block of text.
+package image
+
+import (
+	"fmt"
+	goimage "image"
+	"strings"
+
+	"github.com/orchard9/tui-styles/internal/ansi"
+	"github.com/orchard9/tui-styles/internal/braille"
+)
+
+// Mode selects how pixels map onto terminal cells.
+type Mode int
+
+const (
+	// HalfBlock draws two vertically stacked pixels per cell with ▀, using
+	// the foreground for the top pixel and the background for the bottom.
+	HalfBlock Mode = iota
+
+	// Braille draws a 2x4 grid of dots per cell, giving four times the
+	// resolution of HalfBlock with one color per cell.
+	Braille
+)
+
+// Palette selects the colors written to the terminal.
+type Palette int
+
+const (
+	TrueColor Palette = iota // 24-bit colors
+	ANSI256                  // xterm 256-color codes
+	ANSI16                   // The 16 basic ANSI colors
+)
+
+// Dither selects how colors and dots are approximated.
+//
+// In HalfBlock mode dithering applies when Palette limits the colors; in
+// Braille mode it decides which dots are raised.
+type Dither int
+
+const (
+	DitherNone           Dither = iota // Nearest color or plain threshold
+	DitherFloydSteinberg               // Error diffusion; smooth gradients
+	DitherOrdered                      // 4x4 Bayer pattern; stable between frames
+)
+
+// Options control Render.
+type Options struct {
+	// Width and Height bound the output in cells. The image is scaled to
+	// fit the box keeping its aspect ratio; 0 leaves a dimension
+	// unconstrained, and when both are 0 each source pixel becomes one
+	// output pixel.
+	Width, Height int
+
+	Mode    Mode
+	Palette Palette
+	Dither  Dither
+
+	// Invert raises braille dots for dark pixels instead of light ones,
+	// for terminals with a light background. Ignored in HalfBlock mode.
+	Invert bool
+
+	// Protocol sends the image as real pixels using a terminal graphics
+	// protocol instead of drawing it with characters; DetectProtocol picks
+	// one the terminal supports. The output still occupies a block of
+	// cells of the same size as HalfBlock output, so it composes with
+	// borders and layout. Mode, Palette, Dither, and Invert apply only to
+	// ProtocolBlocks, the default.
+	Protocol Protocol
+}
+
+// Render converts img to ANSI art. Transparent pixels are left as the
+// terminal background. An empty image renders as "".
+func Render(img goimage.Image, opts Options) string {
+	if opts.Protocol != ProtocolBlocks {
+		return renderInline(img, opts)
+	}
+
+	cellW, cellH := 1, 2
+	if opts.Mode == Braille {
+		cellW, cellH = 2, 4
+	}
+
+	pw, ph := targetSize(img.Bounds(), opts.Width*cellW, opts.Height*cellH)
+	if pw == 0 || ph == 0 {
+		return ""
+	}
+	px := resample(img, pw, ph)
+
+	if opts.Mode == Braille {
+		return renderBraille(px, opts)
+	}
+	return renderHalfBlock(px, opts)
+}
+
+// targetSize scales bounds to fit within maxW x maxH pixels (0 means no
+// limit), preserving the aspect ratio
+func targetSize(bounds goimage.Rectangle, maxW, maxH int) (int, int) {
+	w, h := bounds.Dx(), bounds.Dy()
+	if w <= 0 || h <= 0 {
+		return 0, 0
+	}
+
+	scale := -1.0
+	if maxW > 0 {
+		scale = float64(maxW) / float64(w)
+	}
+	if maxH > 0 && (scale < 0 || float64(maxH)/float64(h) < scale) {
+		scale = float64(maxH) / float64(h)
+	}
+	if scale < 0 {
+		return w, h
+	}
+
+	pw := max(1, int(float64(w)*scale+0.5))
+	ph := max(1, int(float64(h)*scale+0.5))
+	if maxW > 0 {
+		pw = min(pw, maxW)
+	}
+	if maxH > 0 {
+		ph = min(ph, maxH)
+	}
+	return pw, ph
+}
+
+// renderHalfBlock draws two pixel rows per line of text
+func renderHalfBlock(px [][]pixel, opts Options) string {
+	quantizeImage(px, opts.Palette, opts.Dither)
+
+	lines := make([]string, 0, (len(px)+1)/2)
+	for y := 0; y < len(px); y += 2 {
+		var line sgrWriter
+		for x := range px[y] {
+			top := px[y][x]
+			bottom := pixel{}
+			if y+1 < len(px) {
+				bottom = px[y+1][x]
+			}
+
+			switch {
+			case top.opaque() && bottom.opaque():
+				line.write(top.color, bottom.color, "▀")
+			case top.opaque():
+				line.write(top.color, "", "▀")
+			case bottom.opaque():
+				line.write(bottom.color, "", "▄")
+			default:
+				line.write("", "", " ")
+			}
+		}
+		lines = append(lines, line.String())
+	}
+	return strings.Join(lines, "\n")
+}
+
+// renderBraille draws 2x4 pixel blocks as braille cells colored by the
+// average of their raised dots
+func renderBraille(px [][]pixel, opts Options) string {
+	raised := thresholdImage(px, opts.Dither, opts.Invert)
+	height, width := len(px), len(px[0])
+
+	lines := make([]string, 0, (height+3)/4)
+	for cy := 0; cy < height; cy += 4 {
+		var line sgrWriter
+		for cx := 0; cx < width; cx += 2 {
+			var dots rune
+			var sum pixel
+			n := 0
+			for dy := range 4 {
+				for dx := range 2 {
+					y, x := cy+dy, cx+dx
+					if y >= height || x >= width || !raised[y][x] {
+						continue
+					}
+					dots |= braille.Dot(dx, dy)
+					sum.r += px[y][x].r
+					sum.g += px[y][x].g
+					sum.b += px[y][x].b
+					n++
+				}
+			}
+
+			if dots == 0 {
+				line.write("", "", " ")
+				continue
+			}
+			avg := pixel{r: sum.r / float64(n), g: sum.g / float64(n), b: sum.b / float64(n), a: 1}
+			line.write(nearest(avg, opts.Palette).color, "", string(braille.Base+dots))
+		}
+		lines = append(lines, line.String())
+	}
+	return strings.Join(lines, "\n")
+}
+
+// sgrWriter builds a line of cells, emitting color changes only where the
+// colors differ from the previous cell
+type sgrWriter struct {
+	b      strings.Builder
+	fg, bg string
+}
+
+// write appends text with the given colors ("" is the terminal default)
+func (w *sgrWriter) write(fg, bg, text string) {
+	if fg != w.fg {
+		if fg == "" {
+			w.b.WriteString(ansi.DefaultForeground())
+		} else {
+			w.b.WriteString(ansi.ForegroundColor(fg))
+		}
+		w.fg = fg
+	}
+	if bg != w.bg {
+		if bg == "" {
+			w.b.WriteString(ansi.DefaultBackground())
+		} else {
+			w.b.WriteString(ansi.BackgroundColor(bg))
+		}
+		w.bg = bg
+	}
+	w.b.WriteString(text)
+}
+
+// String returns the line, reset at the end if any color was used
+func (w *sgrWriter) String() string {
+	s := w.b.String()
+	if strings.Contains(s, "\x1b[") {
+		s += ansi.Reset()
+	}
+	return s
+}
+
+// hex formats 8-bit channels as #RRGGBB
+func hex(r, g, b uint8) string {
+	return fmt.Sprintf("#%02X%02X%02X", r, g, b)
+}
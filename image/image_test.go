@@ -0,0 +1,168 @@
+package image
+
+import (
+	goimage "image"
+	"image/color"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tuistyles "github.com/orchard9/tui-styles"
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+// solid returns a w x h image filled with c
+func solid(w, h int, c color.Color) *goimage.NRGBA {
+	img := goimage.NewNRGBA(goimage.Rect(0, 0, w, h))
+	for y := range h {
+		for x := range w {
+			img.Set(x, y, c)
+		}
+	}
+	return img
+}
+
+var (
+	red         = color.NRGBA{R: 255, A: 255}
+	blue        = color.NRGBA{B: 255, A: 255}
+	white       = color.NRGBA{R: 255, G: 255, B: 255, A: 255}
+	transparent = color.NRGBA{}
+)
+
+func TestTargetSize(t *testing.T) {
+	bounds := goimage.Rect(0, 0, 100, 50)
+	tests := []struct {
+		name       string
+		maxW, maxH int
+		w, h       int
+	}{
+		{"natural", 0, 0, 100, 50},
+		{"width bound", 20, 0, 20, 10},
+		{"height bound", 0, 10, 20, 10},
+		{"box limited by width", 40, 40, 40, 20},
+		{"box limited by height", 400, 10, 20, 10},
+		{"upscale", 200, 0, 200, 100},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w, h := targetSize(bounds, tt.maxW, tt.maxH)
+			require.Equal(t, tt.w, w)
+			require.Equal(t, tt.h, h)
+		})
+	}
+
+	w, h := targetSize(goimage.Rect(0, 0, 0, 0), 10, 10)
+	require.Zero(t, w)
+	require.Zero(t, h)
+}
+
+func TestRender_HalfBlock(t *testing.T) {
+	img := solid(2, 2, red)
+	img.Set(0, 1, blue)
+	img.Set(1, 1, blue)
+
+	got := Render(img, Options{})
+	require.Equal(t, "\x1b[38;2;255;0;0m\x1b[48;2;0;0;255m▀▀\x1b[0m", got)
+}
+
+func TestRender_HalfBlockTransparency(t *testing.T) {
+	img := solid(3, 2, transparent)
+	img.Set(0, 0, red)  // top only
+	img.Set(1, 1, blue) // bottom only
+
+	got := Render(img, Options{})
+	require.Equal(t, "\x1b[38;2;255;0;0m▀\x1b[38;2;0;0;255m▄\x1b[39m \x1b[0m", got)
+}
+
+func TestRender_OddHeight(t *testing.T) {
+	got := Render(solid(1, 3, red), Options{})
+	lines := strings.Split(got, "\n")
+
+	require.Len(t, lines, 2)
+	require.Equal(t, "\x1b[38;2;255;0;0m▀\x1b[0m", lines[1], "missing bottom pixel is transparent")
+}
+
+func TestRender_Palettes(t *testing.T) {
+	img := solid(1, 2, red)
+
+	require.Equal(t, "\x1b[38;5;196m\x1b[48;5;196m▀\x1b[0m", Render(img, Options{Palette: ANSI256}))
+	require.Equal(t, "\x1b[91m\x1b[101m▀\x1b[0m", Render(img, Options{Palette: ANSI16}))
+}
+
+func TestRender_FitsBox(t *testing.T) {
+	got := Render(solid(100, 50, red), Options{Width: 20, Height: 20})
+	require.Equal(t, []int{20, 20, 20, 20, 20}, measure.WidthPerLine(got))
+
+	got = Render(solid(100, 50, red), Options{Width: 20, Height: 20, Mode: Braille})
+	require.Equal(t, []int{20, 20, 20, 20, 20}, measure.WidthPerLine(got), "40x20 dots in 20x5 cells")
+}
+
+func TestRender_Braille(t *testing.T) {
+	require.Equal(t, "\x1b[38;2;255;255;255m⣿\x1b[0m", Render(solid(2, 4, white), Options{Mode: Braille}))
+	require.Equal(t, " ", Render(solid(2, 4, color.NRGBA{A: 255}), Options{Mode: Braille}), "dark pixels stay flat")
+	require.Equal(t, " ", Render(solid(2, 4, transparent), Options{Mode: Braille, Invert: true}), "transparent pixels stay flat")
+
+	dark := Render(solid(2, 4, color.NRGBA{A: 255}), Options{Mode: Braille, Invert: true})
+	require.Equal(t, "\x1b[38;2;0;0;0m⣿\x1b[0m", dark)
+
+	img := solid(2, 4, color.NRGBA{A: 255})
+	img.Set(0, 0, white)
+	img.Set(1, 3, white)
+	require.Equal(t, "\x1b[38;2;255;255;255m⢁\x1b[0m", Render(img, Options{Mode: Braille}), "dots 1 and 8")
+}
+
+func TestRender_BrailleDither(t *testing.T) {
+	gray := solid(8, 8, color.NRGBA{R: 128, G: 128, B: 128, A: 255})
+	countDots := func(s string) int {
+		n := 0
+		for _, r := range measure.StripANSI(s) {
+			if r >= 0x2800 && r <= 0x28FF {
+				for bits := r - 0x2800; bits > 0; bits &= bits - 1 {
+					n++
+				}
+			}
+		}
+		return n
+	}
+
+	require.Equal(t, 64, countDots(Render(gray, Options{Mode: Braille})), "plain threshold raises every dot")
+	require.Equal(t, 32, countDots(Render(gray, Options{Mode: Braille, Dither: DitherOrdered})))
+	require.InDelta(t, 32, countDots(Render(gray, Options{Mode: Braille, Dither: DitherFloydSteinberg})), 2)
+}
+
+func TestRender_ColorDither(t *testing.T) {
+	// A color between two palette entries dithers into a mix of both
+	img := solid(8, 8, color.NRGBA{R: 118, G: 118, B: 118, A: 255})
+
+	codes := func(s string) map[string]bool {
+		set := make(map[string]bool)
+		for _, seg := range strings.Split(s, "\x1b[")[1:] {
+			set[seg[:strings.IndexByte(seg, 'm')]] = true
+		}
+		delete(set, "0")
+		return set
+	}
+
+	require.Len(t, codes(Render(img, Options{Palette: ANSI16})), 2, "one color as foreground and background")
+	for _, dither := range []Dither{DitherFloydSteinberg, DitherOrdered} {
+		got := Render(img, Options{Palette: ANSI16, Dither: dither})
+		require.Greater(t, len(codes(got)), 2, "dither %d mixes colors", dither)
+	}
+
+	require.Equal(t, Render(img, Options{}), Render(img, Options{Dither: DitherFloydSteinberg}), "true color is never dithered")
+}
+
+func TestRender_Empty(t *testing.T) {
+	require.Empty(t, Render(goimage.NewNRGBA(goimage.Rectangle{}), Options{Width: 10}))
+}
+
+func TestRender_ComposesWithBorders(t *testing.T) {
+	art := Render(solid(10, 10, red), Options{Width: 6})
+	boxed := tuistyles.NewStyle().Border(tuistyles.RoundedBorder()).Render(art)
+
+	require.Equal(t, []int{8, 8, 8, 8, 8}, measure.WidthPerLine(boxed))
+	placed := tuistyles.Place(12, 5, tuistyles.Center, tuistyles.Center, art)
+	require.Equal(t, []int{12, 12, 12, 12, 12}, measure.WidthPerLine(placed))
+}
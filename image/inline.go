@@ -0,0 +1,136 @@
+package image
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	goimage "image"
+	"image/png"
+	"strings"
+
+	"github.com/orchard9/tui-styles/capabilities"
+	"github.com/orchard9/tui-styles/term"
+)
+
+// Protocol selects how Render sends the image to the terminal.
+type Protocol int
+
+const (
+	// ProtocolBlocks draws the image with text characters (see Mode); it
+	// works in every terminal.
+	ProtocolBlocks Protocol = iota
+
+	// ProtocolKitty sends the image with the Kitty graphics protocol,
+	// supported by Kitty, Ghostty, and Konsole.
+	ProtocolKitty
+
+	// ProtocolITerm2 sends the image with the iTerm2 inline image protocol,
+	// supported by iTerm2, WezTerm, and mintty.
+	ProtocolITerm2
+)
+
+// kittyChunk is the largest base64 payload the Kitty protocol accepts in
+// one escape sequence
+const kittyChunk = 4096
+
+// DetectProtocol returns the inline image protocol the terminal advertises
+// (see capabilities.Detect), or ProtocolBlocks when it advertises none.
+//
+// Example:
+//
+//	art := tuiimage.Render(img, tuiimage.Options{
+//		Width: 40, Height: 20,
+//		Protocol: tuiimage.DetectProtocol(),
+//	})
+func DetectProtocol() Protocol {
+	return ProtocolFor(capabilities.Detect())
+}
+
+// ProtocolFor returns the best inline image protocol c supports, preferring
+// Kitty graphics, or ProtocolBlocks when it supports none.
+func ProtocolFor(c capabilities.Capabilities) Protocol {
+	switch {
+	case c.KittyGraphics:
+		return ProtocolKitty
+	case c.ITerm2Images:
+		return ProtocolITerm2
+	default:
+		return ProtocolBlocks
+	}
+}
+
+// renderInline encodes img as PNG and places it over a block of spaces the
+// size of the cell box, so the result measures and composes like block
+// output. The spaces are drawn first: on the last line the cursor is saved,
+// moved back to the top-left of the block, the image is drawn, and the
+// cursor is restored, leaving it where text output would have left it.
+func renderInline(img goimage.Image, opts Options) string {
+	pw, ph := targetSize(img.Bounds(), opts.Width, opts.Height*2)
+	if pw == 0 || ph == 0 {
+		return ""
+	}
+	cols, rows := pw, (ph+1)/2
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return ""
+	}
+	payload := base64.StdEncoding.EncodeToString(buf.Bytes())
+
+	var seq string
+	if opts.Protocol == ProtocolKitty {
+		seq = kittySequence(payload, cols, rows)
+	} else {
+		seq = iterm2Sequence(payload, buf.Len(), cols, rows)
+	}
+
+	lines := make([]string, rows)
+	blank := strings.Repeat(" ", cols)
+	for i := range lines {
+		lines[i] = blank
+	}
+
+	var last strings.Builder
+	last.WriteString(blank)
+	last.WriteString(term.SaveCursor())
+	if rows > 1 {
+		last.WriteString(term.CursorUp(rows - 1))
+	}
+	last.WriteString(term.CursorBack(cols))
+	last.WriteString(seq)
+	last.WriteString(term.RestoreCursor())
+	lines[rows-1] = last.String()
+
+	return strings.Join(lines, "\n")
+}
+
+// kittySequence transmits and displays a PNG scaled to cols x rows cells,
+// split into chunks, without moving the cursor or asking for a reply
+func kittySequence(payload string, cols, rows int) string {
+	var b strings.Builder
+	for first := true; first || payload != ""; first = false {
+		chunk := payload
+		if len(chunk) > kittyChunk {
+			chunk = chunk[:kittyChunk]
+		}
+		payload = payload[len(chunk):]
+
+		more := 0
+		if payload != "" {
+			more = 1
+		}
+		if first {
+			fmt.Fprintf(&b, "\x1b_Ga=T,f=100,q=2,C=1,c=%d,r=%d,m=%d;%s\x1b\\", cols, rows, more, chunk)
+		} else {
+			fmt.Fprintf(&b, "\x1b_Gm=%d;%s\x1b\\", more, chunk)
+		}
+	}
+	return b.String()
+}
+
+// iterm2Sequence displays a PNG of size bytes inline, fitted to cols x rows
+// cells
+func iterm2Sequence(payload string, size, cols, rows int) string {
+	return fmt.Sprintf("\x1b]1337;File=inline=1;size=%d;width=%d;height=%d;preserveAspectRatio=1:%s\x07",
+		size, cols, rows, payload)
+}
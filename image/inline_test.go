@@ -0,0 +1,85 @@
+package image
+
+import (
+	"bytes"
+	"encoding/base64"
+	goimage "image"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tuistyles "github.com/orchard9/tui-styles"
+	"github.com/orchard9/tui-styles/capabilities"
+	"github.com/orchard9/tui-styles/internal/measure"
+	"github.com/orchard9/tui-styles/term"
+)
+
+func TestProtocolFor(t *testing.T) {
+	tests := []struct {
+		name string
+		env  map[string]string
+		want Protocol
+	}{
+		{"plain terminal", map[string]string{"TERM": "xterm-256color"}, ProtocolBlocks},
+		{"kitty", map[string]string{"TERM": "xterm-kitty"}, ProtocolKitty},
+		{"kitty window", map[string]string{"KITTY_WINDOW_ID": "1"}, ProtocolKitty},
+		{"ghostty", map[string]string{"TERM_PROGRAM": "ghostty"}, ProtocolKitty},
+		{"iterm2", map[string]string{"TERM_PROGRAM": "iTerm.app"}, ProtocolITerm2},
+		{"iterm2 over ssh", map[string]string{"LC_TERMINAL": "iTerm2"}, ProtocolITerm2},
+		{"wezterm", map[string]string{"TERM_PROGRAM": "WezTerm"}, ProtocolITerm2},
+		{"tmux", map[string]string{"TERM_PROGRAM": "iTerm.app", "TMUX": "/tmp/tmux-1/default"}, ProtocolBlocks},
+		{"screen", map[string]string{"TERM": "screen-256color", "KITTY_WINDOW_ID": "1"}, ProtocolBlocks},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			getenv := func(k string) string { return tt.env[k] }
+			require.Equal(t, tt.want, ProtocolFor(capabilities.FromEnv(getenv)))
+		})
+	}
+}
+
+func TestRender_Kitty(t *testing.T) {
+	out := Render(solid(10, 10, red), Options{Width: 6, Protocol: ProtocolKitty})
+
+	lines := strings.Split(out, "\n")
+	require.Len(t, lines, 3)
+	require.Equal(t, []int{6, 6, 6}, measure.WidthPerLine(out))
+	require.Equal(t, "      ", lines[0])
+
+	prefix := "      " + term.SaveCursor() + term.CursorUp(2) + term.CursorBack(6) + "\x1b_Ga=T,f=100,q=2,C=1,c=6,r=3,m=0;"
+	require.True(t, strings.HasPrefix(lines[2], prefix), lines[2])
+	require.True(t, strings.HasSuffix(lines[2], "\x1b\\"+term.RestoreCursor()))
+
+	payload := strings.TrimSuffix(strings.TrimPrefix(lines[2], prefix), "\x1b\\"+term.RestoreCursor())
+	data, err := base64.StdEncoding.DecodeString(payload)
+	require.NoError(t, err)
+	decoded, err := png.Decode(bytes.NewReader(data))
+	require.NoError(t, err)
+	require.Equal(t, goimage.Rect(0, 0, 10, 10), decoded.Bounds())
+}
+
+func TestKittySequence_Chunks(t *testing.T) {
+	payload := strings.Repeat("A", kittyChunk+10)
+	got := kittySequence(payload, 4, 2)
+
+	want := "\x1b_Ga=T,f=100,q=2,C=1,c=4,r=2,m=1;" + strings.Repeat("A", kittyChunk) + "\x1b\\" +
+		"\x1b_Gm=0;" + strings.Repeat("A", 10) + "\x1b\\"
+	require.Equal(t, want, got)
+}
+
+func TestRender_ITerm2(t *testing.T) {
+	out := Render(solid(4, 2, blue), Options{Width: 8, Protocol: ProtocolITerm2})
+
+	require.Equal(t, []int{8, 8}, measure.WidthPerLine(out))
+	require.Contains(t, out, "\x1b]1337;File=inline=1;size=")
+	require.Contains(t, out, ";width=8;height=2;preserveAspectRatio=1:")
+}
+
+func TestRender_InlineComposesWithBorders(t *testing.T) {
+	art := Render(solid(10, 10, red), Options{Width: 6, Protocol: ProtocolKitty})
+	boxed := tuistyles.NewStyle().Border(tuistyles.RoundedBorder()).Render(art)
+	require.Equal(t, []int{8, 8, 8, 8, 8}, measure.WidthPerLine(boxed))
+}
@@ -186,7 +186,25 @@ func ForegroundColor(color string) string {
 	return ColorToANSI(color, false)
 }
 
+// DefaultForeground returns the escape sequence restoring the terminal's
+// default foreground color without touching other attributes
+func DefaultForeground() string {
+	return "\x1b[39m"
+}
+
+// DefaultBackground returns the escape sequence restoring the terminal's
+// default background color without touching other attributes
+func DefaultBackground() string {
+	return "\x1b[49m"
+}
+
 // BackgroundColor returns the ANSI escape sequence for the given background color
 func BackgroundColor(color string) string {
 	return ColorToANSI(color, true)
 }
+
+// Hyperlink wraps text in OSC 8 sequences so supporting terminals make it a
+// clickable link to url. Terminals without support show text unchanged.
+func Hyperlink(url, text string) string {
+	return "\x1b]8;;" + url + "\x1b\\" + text + "\x1b]8;;\x1b\\"
+}
@@ -0,0 +1,73 @@
+package ansi
+
+import (
+	"strconv"
+	"strings"
+)
+
+// standardPalette holds the xterm default RGB values for ANSI colors 0-15
+var standardPalette = [16][3]int{
+	{0, 0, 0},       // black
+	{205, 0, 0},     // red
+	{0, 205, 0},     // green
+	{205, 205, 0},   // yellow
+	{0, 0, 238},     // blue
+	{205, 0, 205},   // magenta
+	{0, 205, 205},   // cyan
+	{229, 229, 229}, // white
+	{127, 127, 127}, // bright-black
+	{255, 0, 0},     // bright-red
+	{0, 255, 0},     // bright-green
+	{255, 255, 0},   // bright-yellow
+	{92, 92, 255},   // bright-blue
+	{255, 0, 255},   // bright-magenta
+	{0, 255, 255},   // bright-cyan
+	{255, 255, 255}, // bright-white
+}
+
+// cubeLevels are the channel intensities of the 6x6x6 color cube (codes 16-231)
+var cubeLevels = [6]int{0, 95, 135, 175, 215, 255}
+
+// PaletteRGB returns the RGB value xterm uses for a 256-color code.
+// Codes outside 0-255 return black.
+func PaletteRGB(code int) (r, g, b int) {
+	switch {
+	case code < 0 || code > 255:
+		return 0, 0, 0
+	case code < 16:
+		c := standardPalette[code]
+		return c[0], c[1], c[2]
+	case code < 232:
+		i := code - 16
+		return cubeLevels[i/36], cubeLevels[(i/6)%6], cubeLevels[i%6]
+	default:
+		level := 8 + (code-232)*10
+		return level, level, level
+	}
+}
+
+// ColorToRGB resolves a color string (hex, ANSI name, or 256-color code)
+// to RGB. ANSI names and codes use the xterm default palette, so the result
+// is an approximation of what the user's terminal actually displays.
+// Returns ok=false if the color cannot be parsed.
+func ColorToRGB(color string) (r, g, b int, ok bool) {
+	if strings.HasPrefix(color, "#") {
+		r, g, b, err := hexToRGB(color)
+		if err != nil {
+			return 0, 0, 0, false
+		}
+		return r, g, b, true
+	}
+
+	if code, exists := ansiColorNames[strings.ToLower(color)]; exists {
+		r, g, b := PaletteRGB(code)
+		return r, g, b, true
+	}
+
+	if code, err := strconv.Atoi(color); err == nil && code >= 0 && code <= 255 {
+		r, g, b := PaletteRGB(code)
+		return r, g, b, true
+	}
+
+	return 0, 0, 0, false
+}
@@ -0,0 +1,57 @@
+package ansi
+
+import "testing"
+
+func TestPaletteRGB(t *testing.T) {
+	tests := []struct {
+		code    int
+		r, g, b int
+	}{
+		{0, 0, 0, 0},
+		{1, 205, 0, 0},
+		{15, 255, 255, 255},
+		{16, 0, 0, 0},
+		{196, 255, 0, 0},
+		{21, 0, 0, 255},
+		{231, 255, 255, 255},
+		{232, 8, 8, 8},
+		{255, 238, 238, 238},
+		{-1, 0, 0, 0},
+		{256, 0, 0, 0},
+	}
+
+	for _, tt := range tests {
+		r, g, b := PaletteRGB(tt.code)
+		if r != tt.r || g != tt.g || b != tt.b {
+			t.Errorf("PaletteRGB(%d) = (%d, %d, %d), want (%d, %d, %d)", tt.code, r, g, b, tt.r, tt.g, tt.b)
+		}
+	}
+}
+
+func TestColorToRGB(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		r, g, b int
+		ok      bool
+	}{
+		{"hex", "#FF8000", 255, 128, 0, true},
+		{"short hex", "#F00", 255, 0, 0, true},
+		{"ANSI name", "blue", 0, 0, 238, true},
+		{"ANSI name uppercase", "BRIGHT-RED", 255, 0, 0, true},
+		{"256 code", "196", 255, 0, 0, true},
+		{"invalid hex", "#GGG", 0, 0, 0, false},
+		{"invalid name", "notacolor", 0, 0, 0, false},
+		{"out of range code", "300", 0, 0, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, g, b, ok := ColorToRGB(tt.input)
+			if ok != tt.ok || r != tt.r || g != tt.g || b != tt.b {
+				t.Errorf("ColorToRGB(%q) = (%d, %d, %d, %v), want (%d, %d, %d, %v)",
+					tt.input, r, g, b, ok, tt.r, tt.g, tt.b, tt.ok)
+			}
+		})
+	}
+}
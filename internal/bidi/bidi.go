@@ -0,0 +1,198 @@
+// Package bidi implements the parts of the Unicode bidirectional algorithm
+// needed to lay out mixed left-to-right and right-to-left text in a
+// terminal: detecting paragraph direction and reordering a line from
+// logical (memory) order into visual (display) order.
+//
+// It is deliberately simplified: explicit embedding controls are ignored,
+// numbers are treated as left-to-right text, and neutrals take the
+// direction of the text around them.
+package bidi
+
+import (
+	"unicode"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+// class is the simplified bidirectional type of a character
+type class int
+
+const (
+	neutral class = iota
+	ltr
+	rtl
+)
+
+// rtlScripts are the scripts written right to left
+var rtlScripts = []*unicode.RangeTable{
+	unicode.Arabic,
+	unicode.Hebrew,
+	unicode.Syriac,
+	unicode.Thaana,
+	unicode.Nko,
+	unicode.Samaritan,
+	unicode.Mandaic,
+}
+
+// IsRTL reports whether r is a strong right-to-left character.
+func IsRTL(r rune) bool {
+	return unicode.In(r, rtlScripts...) && (unicode.IsLetter(r) || unicode.IsMark(r))
+}
+
+// classify returns the bidi class of the first rune in cluster
+func classify(cluster string) class {
+	for _, r := range cluster {
+		switch {
+		case IsRTL(r):
+			return rtl
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			return ltr
+		default:
+			return neutral
+		}
+	}
+	return neutral
+}
+
+// FirstStrongIsRTL reports whether the first strongly directional character
+// in s is right-to-left, which is how a paragraph's direction is detected.
+// Text with no strong characters is left-to-right.
+func FirstStrongIsRTL(s string) bool {
+	for _, r := range s {
+		if IsRTL(r) {
+			return true
+		}
+		if unicode.IsLetter(r) {
+			return false
+		}
+	}
+	return false
+}
+
+// ContainsRTL reports whether s contains any right-to-left character.
+func ContainsRTL(s string) bool {
+	for _, r := range s {
+		if IsRTL(r) {
+			return true
+		}
+	}
+	return false
+}
+
+// mirrors maps paired punctuation to its mirror image, used for characters
+// displayed right to left
+var mirrors = map[string]string{
+	"(": ")", ")": "(",
+	"[": "]", "]": "[",
+	"{": "}", "}": "{",
+	"<": ">", ">": "<",
+	"«": "»", "»": "«",
+}
+
+// Reorder converts a single line of plain text from logical to visual order
+// for a terminal without bidirectional support. baseRTL selects the
+// paragraph direction. Grapheme clusters are kept intact (combining marks
+// stay on their base character), and brackets inside right-to-left runs are
+// mirrored.
+func Reorder(line string, baseRTL bool) string {
+	if !ContainsRTL(line) && !baseRTL {
+		return line
+	}
+
+	var clusters []string
+	var classes []class
+	measure.EachGrapheme(line, func(cluster string, _ int) bool {
+		clusters = append(clusters, cluster)
+		classes = append(classes, classify(cluster))
+		return true
+	})
+
+	base := ltr
+	if baseRTL {
+		base = rtl
+	}
+	resolveNeutrals(classes, base)
+
+	// Embedding levels: even is left to right, odd is right to left
+	levels := make([]int, len(classes))
+	maxLevel := 0
+	for i, c := range classes {
+		switch {
+		case base == ltr && c == rtl:
+			levels[i] = 1
+		case base == rtl && c == rtl:
+			levels[i] = 1
+		case base == rtl && c == ltr:
+			levels[i] = 2
+		}
+		maxLevel = max(maxLevel, levels[i])
+	}
+
+	// From the highest level down, reverse every run at or above that level
+	for level := maxLevel; level >= 1; level-- {
+		for i := 0; i < len(levels); {
+			if levels[i] < level {
+				i++
+				continue
+			}
+			j := i
+			for j < len(levels) && levels[j] >= level {
+				j++
+			}
+			reverse(clusters[i:j])
+			reverse(levels[i:j])
+			i = j
+		}
+	}
+
+	result := make([]byte, 0, len(line))
+	for i, cluster := range clusters {
+		if levels[i]%2 == 1 {
+			if m, ok := mirrors[cluster]; ok {
+				cluster = m
+			}
+		}
+		result = append(result, cluster...)
+	}
+	return string(result)
+}
+
+// resolveNeutrals gives each neutral the direction of the strong characters
+// on both sides when they agree, and the base direction otherwise
+func resolveNeutrals(classes []class, base class) {
+	for i := 0; i < len(classes); {
+		if classes[i] != neutral {
+			i++
+			continue
+		}
+
+		j := i
+		for j < len(classes) && classes[j] == neutral {
+			j++
+		}
+
+		before, after := base, base
+		if i > 0 {
+			before = classes[i-1]
+		}
+		if j < len(classes) {
+			after = classes[j]
+		}
+
+		resolved := base
+		if before == after {
+			resolved = before
+		}
+		for k := i; k < j; k++ {
+			classes[k] = resolved
+		}
+		i = j
+	}
+}
+
+// reverse reverses a slice in place
+func reverse[T any](s []T) {
+	for i, j := 0, len(s)-1; i < j; i, j = i+1, j-1 {
+		s[i], s[j] = s[j], s[i]
+	}
+}
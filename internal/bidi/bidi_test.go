@@ -0,0 +1,49 @@
+package bidi
+
+import "testing"
+
+func TestFirstStrongIsRTL(t *testing.T) {
+	tests := []struct {
+		input string
+		want  bool
+	}{
+		{"hello", false},
+		{"שלום", true},
+		{"123 שלום", true},
+		{"hello שלום", false},
+		{"مرحبا world", true},
+		{"", false},
+		{"123 !?", false},
+	}
+
+	for _, tt := range tests {
+		if got := FirstStrongIsRTL(tt.input); got != tt.want {
+			t.Errorf("FirstStrongIsRTL(%q) = %v, want %v", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestReorder(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		baseRTL bool
+		want    string
+	}{
+		{"plain LTR untouched", "hello world", false, "hello world"},
+		{"pure RTL reversed", "שלום עולם", true, "םלוע םולש"},
+		{"RTL word in LTR line", "say שלום now", false, "say םולש now"},
+		{"LTR word in RTL line keeps its order", "שלום abc עולם", true, "םלוע abc םולש"},
+		{"numbers stay LTR", "מחיר 100", true, "100 ריחמ"},
+		{"brackets mirrored", "(שלום)", true, "(םולש)"},
+		{"combining marks stay attached", "בְּ", true, "בְּ"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Reorder(tt.input, tt.baseRTL); got != tt.want {
+				t.Errorf("Reorder(%q, %v) = %q, want %q", tt.input, tt.baseRTL, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,19 @@
+// Package braille maps 2x4 dot grids onto Unicode braille patterns.
+package braille
+
+// Base is the empty braille pattern, U+2800. Each raised dot adds its bit.
+const Base = 0x2800
+
+// dots maps a dot's position within a cell, [row][column], to its bit
+var dots = [4][2]rune{
+	{0x01, 0x08},
+	{0x02, 0x10},
+	{0x04, 0x20},
+	{0x40, 0x80},
+}
+
+// Dot returns the bit for the dot at column dx (0-1) and row dy (0-3) of
+// a cell. Add bits to Base to form the character.
+func Dot(dx, dy int) rune {
+	return dots[dy][dx]
+}
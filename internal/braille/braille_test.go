@@ -0,0 +1,20 @@
+package braille
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDot(t *testing.T) {
+	require.Equal(t, '⠁', Base+Dot(0, 0))
+	require.Equal(t, '⢀', Base+Dot(1, 3))
+
+	var all rune
+	for dy := range 4 {
+		for dx := range 2 {
+			all |= Dot(dx, dy)
+		}
+	}
+	require.Equal(t, '⣿', Base+all)
+}
@@ -0,0 +1,93 @@
+package colorspace
+
+import "math"
+
+// HSLToRGB converts hue (degrees), saturation, and lightness (0-1) to sRGB
+// channels (0-1). Hue wraps around; saturation and lightness are clamped.
+func HSLToRGB(h, s, l float64) (r, g, b float64) {
+	s, l = clamp01(s), clamp01(l)
+	c := (1 - math.Abs(2*l-1)) * s
+	return hueToRGB(h, c, l-c/2)
+}
+
+// HSVToRGB converts hue (degrees), saturation, and value (0-1) to sRGB
+// channels (0-1). Hue wraps around; saturation and value are clamped.
+func HSVToRGB(h, s, v float64) (r, g, b float64) {
+	s, v = clamp01(s), clamp01(v)
+	c := v * s
+	return hueToRGB(h, c, v-c)
+}
+
+// RGBToHSL converts sRGB channels (0-1) to hue (degrees, 0-360), saturation,
+// and lightness (0-1). Grays have hue and saturation 0.
+func RGBToHSL(r, g, b float64) (h, s, l float64) {
+	hi, lo := math.Max(r, math.Max(g, b)), math.Min(r, math.Min(g, b))
+	l = (hi + lo) / 2
+	if d := hi - lo; d > 0 {
+		s = d / (1 - math.Abs(2*l-1))
+		h = hue(r, g, b, hi, d)
+	}
+	return h, s, l
+}
+
+// RGBToHSV converts sRGB channels (0-1) to hue (degrees, 0-360), saturation,
+// and value (0-1). Grays have hue and saturation 0.
+func RGBToHSV(r, g, b float64) (h, s, v float64) {
+	hi, lo := math.Max(r, math.Max(g, b)), math.Min(r, math.Min(g, b))
+	v = hi
+	if d := hi - lo; d > 0 {
+		s = d / hi
+		h = hue(r, g, b, hi, d)
+	}
+	return h, s, v
+}
+
+// hueToRGB places chroma c on the color wheel at hue h and adds m to every
+// channel, the shared final step of HSL and HSV conversion
+func hueToRGB(h, c, m float64) (r, g, b float64) {
+	h = math.Mod(h, 360)
+	if h < 0 {
+		h += 360
+	}
+	x := c * (1 - math.Abs(math.Mod(h/60, 2)-1))
+
+	switch {
+	case h < 60:
+		r, g, b = c, x, 0
+	case h < 120:
+		r, g, b = x, c, 0
+	case h < 180:
+		r, g, b = 0, c, x
+	case h < 240:
+		r, g, b = 0, x, c
+	case h < 300:
+		r, g, b = x, 0, c
+	default:
+		r, g, b = c, 0, x
+	}
+	return r + m, g + m, b + m
+}
+
+// hue returns the hue in degrees of a non-gray color with maximum channel hi
+// and chroma d
+func hue(r, g, b, hi, d float64) float64 {
+	var h float64
+	switch hi {
+	case r:
+		h = math.Mod((g-b)/d, 6)
+	case g:
+		h = (b-r)/d + 2
+	default:
+		h = (r-g)/d + 4
+	}
+	h *= 60
+	if h < 0 {
+		h += 360
+	}
+	return h
+}
+
+// clamp01 limits v to the range 0-1
+func clamp01(v float64) float64 {
+	return math.Max(0, math.Min(1, v))
+}
@@ -0,0 +1,78 @@
+package colorspace
+
+import (
+	"math"
+	"testing"
+)
+
+func TestHSLRoundTrip(t *testing.T) {
+	tests := []struct {
+		name    string
+		r, g, b float64
+		h, s, l float64
+	}{
+		{"red", 1, 0, 0, 0, 1, 0.5},
+		{"lime", 0, 1, 0, 120, 1, 0.5},
+		{"blue", 0, 0, 1, 240, 1, 0.5},
+		{"white", 1, 1, 1, 0, 0, 1},
+		{"gray", 0.5, 0.5, 0.5, 0, 0, 0.5},
+		{"magenta dark", 0.5, 0, 0.5, 300, 1, 0.25},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, s, l := RGBToHSL(tt.r, tt.g, tt.b)
+			assertNear(t, "h", h, tt.h)
+			assertNear(t, "s", s, tt.s)
+			assertNear(t, "l", l, tt.l)
+
+			r, g, b := HSLToRGB(tt.h, tt.s, tt.l)
+			assertNear(t, "r", r, tt.r)
+			assertNear(t, "g", g, tt.g)
+			assertNear(t, "b", b, tt.b)
+		})
+	}
+}
+
+func TestHSVRoundTrip(t *testing.T) {
+	tests := []struct {
+		name    string
+		r, g, b float64
+		h, s, v float64
+	}{
+		{"red", 1, 0, 0, 0, 1, 1},
+		{"cyan", 0, 1, 1, 180, 1, 1},
+		{"black", 0, 0, 0, 0, 0, 0},
+		{"olive", 0.5, 0.5, 0, 60, 1, 0.5},
+		{"pale rose", 1, 0.5, 0.75, 330, 0.5, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, s, v := RGBToHSV(tt.r, tt.g, tt.b)
+			assertNear(t, "h", h, tt.h)
+			assertNear(t, "s", s, tt.s)
+			assertNear(t, "v", v, tt.v)
+
+			r, g, b := HSVToRGB(tt.h, tt.s, tt.v)
+			assertNear(t, "r", r, tt.r)
+			assertNear(t, "g", g, tt.g)
+			assertNear(t, "b", b, tt.b)
+		})
+	}
+}
+
+func TestHueWraps(t *testing.T) {
+	r1, g1, b1 := HSLToRGB(-120, 1, 0.5)
+	r2, g2, b2 := HSLToRGB(240, 1, 0.5)
+	assertNear(t, "r", r1, r2)
+	assertNear(t, "g", g1, g2)
+	assertNear(t, "b", b1, b2)
+}
+
+func assertNear(t *testing.T, name string, got, want float64) {
+	t.Helper()
+	if math.Abs(got-want) > 1e-9 {
+		t.Errorf("%s = %v, want %v", name, got, want)
+	}
+}
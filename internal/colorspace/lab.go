@@ -0,0 +1,63 @@
+// Package colorspace provides perceptual color conversions used for
+// palette quantization and accessibility checks.
+package colorspace
+
+import "math"
+
+// Lab is a color in the CIE L*a*b* space (D65 white point)
+type Lab struct {
+	L, A, B float64
+}
+
+// SRGBToLinear converts an 8-bit sRGB channel to linear light (0-1)
+func SRGBToLinear(v uint8) float64 {
+	c := float64(v) / 255
+	if c <= 0.04045 {
+		return c / 12.92
+	}
+	return math.Pow((c+0.055)/1.055, 2.4)
+}
+
+// LinearToSRGB converts a linear light channel (0-1) to 8-bit sRGB, clamping out-of-gamut values
+func LinearToSRGB(v float64) uint8 {
+	v = math.Max(0, math.Min(1, v))
+	if v <= 0.0031308 {
+		v *= 12.92
+	} else {
+		v = 1.055*math.Pow(v, 1/2.4) - 0.055
+	}
+	return uint8(math.Round(v * 255))
+}
+
+// RGBToLab converts 8-bit sRGB to CIE L*a*b*
+func RGBToLab(r, g, b uint8) Lab {
+	lr, lg, lb := SRGBToLinear(r), SRGBToLinear(g), SRGBToLinear(b)
+
+	// Linear sRGB -> XYZ (D65), normalized by the reference white
+	x := (0.4124564*lr + 0.3575761*lg + 0.1804375*lb) / 0.95047
+	y := 0.2126729*lr + 0.7151522*lg + 0.0721750*lb
+	z := (0.0193339*lr + 0.1191920*lg + 0.9503041*lb) / 1.08883
+
+	fx, fy, fz := labF(x), labF(y), labF(z)
+	return Lab{
+		L: 116*fy - 16,
+		A: 500 * (fx - fy),
+		B: 200 * (fy - fz),
+	}
+}
+
+// labF is the nonlinear compression used by the XYZ -> L*a*b* transform
+func labF(t float64) float64 {
+	const epsilon = 216.0 / 24389.0
+	const kappa = 24389.0 / 27.0
+	if t > epsilon {
+		return math.Cbrt(t)
+	}
+	return (kappa*t + 16) / 116
+}
+
+// Distance returns the CIE76 color difference (ΔE*ab) between two colors
+func (l Lab) Distance(o Lab) float64 {
+	dl, da, db := l.L-o.L, l.A-o.A, l.B-o.B
+	return math.Sqrt(dl*dl + da*da + db*db)
+}
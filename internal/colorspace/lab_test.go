@@ -0,0 +1,43 @@
+package colorspace
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRGBToLab(t *testing.T) {
+	tests := []struct {
+		name    string
+		r, g, b uint8
+		want    Lab
+	}{
+		{"black", 0, 0, 0, Lab{0, 0, 0}},
+		{"white", 255, 255, 255, Lab{100, 0, 0}},
+		{"red", 255, 0, 0, Lab{53.24, 80.09, 67.20}},
+		{"blue", 0, 0, 255, Lab{32.30, 79.19, -107.86}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := RGBToLab(tt.r, tt.g, tt.b)
+			if got.Distance(tt.want) > 0.05 {
+				t.Errorf("RGBToLab(%d, %d, %d) = %+v, want %+v", tt.r, tt.g, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLinearRoundTrip(t *testing.T) {
+	for v := 0; v <= 255; v++ {
+		if got := LinearToSRGB(SRGBToLinear(uint8(v))); int(got) != v {
+			t.Fatalf("round trip of %d = %d", v, got)
+		}
+	}
+}
+
+func TestDistance(t *testing.T) {
+	a, b := Lab{50, 0, 0}, Lab{53, 4, 0}
+	if d := a.Distance(b); math.Abs(d-5) > 1e-9 {
+		t.Errorf("Distance() = %f, want 5", d)
+	}
+}
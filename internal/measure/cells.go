@@ -0,0 +1,73 @@
+package measure
+
+import (
+	"strings"
+
+	"github.com/clipperhouse/uax29/v2/graphemes"
+	"github.com/mattn/go-runewidth"
+)
+
+// EachGrapheme calls fn for every grapheme cluster in a plain (ANSI-free)
+// string together with its width in cells. Iteration stops early if fn
+// returns false. Widths agree with Width, so summing them yields Width(s).
+func EachGrapheme(s string, fn func(cluster string, width int) bool) {
+	g := graphemes.FromString(s)
+	for g.Next() {
+		cluster := g.Value()
+		if !fn(cluster, runewidth.StringWidth(cluster)) {
+			return
+		}
+	}
+}
+
+// SliceCells returns the cells [from, to) of a plain (ANSI-free) string.
+//
+// A wide character that straddles either boundary cannot be split, so each
+// of its cells that falls inside the range is replaced by a space. This keeps
+// the result exactly to-from cells wide (when s is long enough), which is what
+// callers aligning columns need.
+func SliceCells(s string, from, to int) string {
+	if from < 0 {
+		from = 0
+	}
+	if to <= from {
+		return ""
+	}
+
+	var b strings.Builder
+	col := 0
+	EachGrapheme(s, func(cluster string, width int) bool {
+		start, end := col, col+width
+		col = end
+
+		switch {
+		case end <= from:
+			return true
+		case start >= to:
+			return false
+		case start >= from && end <= to:
+			b.WriteString(cluster)
+		default:
+			// Partially visible wide cluster: pad the visible cells
+			visible := min(end, to) - max(start, from)
+			b.WriteString(strings.Repeat(" ", visible))
+		}
+		return col < to
+	})
+
+	return b.String()
+}
+
+// PrefixWidth returns the width of the longest prefix of a plain string that
+// fits within width cells without splitting a wide character.
+func PrefixWidth(s string, width int) int {
+	total := 0
+	EachGrapheme(s, func(_ string, w int) bool {
+		if total+w > width {
+			return false
+		}
+		total += w
+		return true
+	})
+	return total
+}
@@ -0,0 +1,67 @@
+package measure
+
+import "testing"
+
+func TestSliceCells(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		from, to int
+		want     string
+	}{
+		{"ASCII middle", "hello world", 2, 7, "llo w"},
+		{"ASCII prefix", "hello", 0, 3, "hel"},
+		{"beyond end", "hi", 0, 10, "hi"},
+		{"empty range", "hello", 3, 3, ""},
+		{"negative from", "hello", -2, 2, "he"},
+		{"CJK aligned", "你好世界", 2, 6, "好世"},
+		{"CJK split at start", "你好世界", 1, 6, " 好世"},
+		{"CJK split at end", "你好世界", 0, 5, "你好 "},
+		{"CJK split both", "你好世界", 1, 5, " 好 "},
+		{"emoji cluster", "a👋b", 1, 3, "👋"},
+		{"emoji split", "a👋b", 0, 2, "a "},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := SliceCells(tt.input, tt.from, tt.to)
+			if got != tt.want {
+				t.Errorf("SliceCells(%q, %d, %d) = %q, want %q", tt.input, tt.from, tt.to, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEachGrapheme_MatchesWidth(t *testing.T) {
+	inputs := []string{"hello", "你好", "👋🌍", "é", "👨‍👩‍👧"}
+	for _, s := range inputs {
+		total := 0
+		EachGrapheme(s, func(_ string, w int) bool {
+			total += w
+			return true
+		})
+		if total != Width(s) {
+			t.Errorf("sum of grapheme widths for %q = %d, want %d", s, total, Width(s))
+		}
+	}
+}
+
+func TestPrefixWidth(t *testing.T) {
+	tests := []struct {
+		input string
+		width int
+		want  int
+	}{
+		{"hello", 3, 3},
+		{"hello", 10, 5},
+		{"你好世界", 5, 4},
+		{"你好世界", 1, 0},
+		{"", 4, 0},
+	}
+
+	for _, tt := range tests {
+		if got := PrefixWidth(tt.input, tt.width); got != tt.want {
+			t.Errorf("PrefixWidth(%q, %d) = %d, want %d", tt.input, tt.width, got, tt.want)
+		}
+	}
+}
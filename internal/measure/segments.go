@@ -0,0 +1,136 @@
+package measure
+
+import "strings"
+
+// Segment is a piece of a string that is either a terminal escape sequence
+// or visible text.
+type Segment struct {
+	Text   string
+	Escape bool
+}
+
+// Segments splits s into escape sequences and runs of visible text, in order.
+// It recognizes CSI sequences (ESC [ ... final byte), OSC sequences such as
+// hyperlinks (ESC ] ... BEL or ESC \), APC sequences such as Kitty graphics
+// (ESC _ ... ESC \), and two-byte escapes.
+func Segments(s string) []Segment {
+	var segments []Segment
+	textStart := 0
+
+	for i := 0; i < len(s); {
+		if s[i] != 0x1b {
+			i++
+			continue
+		}
+
+		end := escapeEnd(s, i)
+		if textStart < i {
+			segments = append(segments, Segment{Text: s[textStart:i]})
+		}
+		segments = append(segments, Segment{Text: s[i:end], Escape: true})
+		i = end
+		textStart = end
+	}
+
+	if textStart < len(s) {
+		segments = append(segments, Segment{Text: s[textStart:]})
+	}
+	return segments
+}
+
+// escapeEnd returns the index just past the escape sequence starting at start
+func escapeEnd(s string, start int) int {
+	if start+1 >= len(s) {
+		return len(s)
+	}
+
+	switch s[start+1] {
+	case '[': // CSI: parameters and intermediates, then a final byte 0x40-0x7E
+		for i := start + 2; i < len(s); i++ {
+			if s[i] >= 0x40 && s[i] <= 0x7e {
+				return i + 1
+			}
+		}
+		return len(s)
+	case ']', '_': // OSC and APC: terminated by BEL or ST (ESC \)
+		for i := start + 2; i < len(s); i++ {
+			if s[i] == 0x07 {
+				return i + 1
+			}
+			if s[i] == 0x1b && i+1 < len(s) && s[i+1] == '\\' {
+				return i + 2
+			}
+		}
+		return len(s)
+	default:
+		return start + 2
+	}
+}
+
+// SplitAt splits a styled string after width cells, keeping escape sequences
+// intact. Escapes at the cut point go to the tail. A wide character that
+// would straddle the cut goes entirely to the tail, so head may be narrower
+// than width.
+func SplitAt(s string, width int) (head, tail string) {
+	var b strings.Builder
+	col := 0
+
+	segments := Segments(s)
+	for i, seg := range segments {
+		if seg.Escape {
+			if col >= width {
+				return b.String(), joinSegments(segments[i:])
+			}
+			b.WriteString(seg.Text)
+			continue
+		}
+
+		offset := 0
+		split := false
+		EachGrapheme(seg.Text, func(cluster string, w int) bool {
+			if col+w > width {
+				split = true
+				return false
+			}
+			b.WriteString(cluster)
+			col += w
+			offset += len(cluster)
+			return true
+		})
+
+		if split {
+			rest := seg.Text[offset:] + joinSegments(segments[i+1:])
+			return b.String(), rest
+		}
+	}
+
+	return b.String(), ""
+}
+
+// joinSegments concatenates segment texts
+func joinSegments(segments []Segment) string {
+	var b strings.Builder
+	for _, seg := range segments {
+		b.WriteString(seg.Text)
+	}
+	return b.String()
+}
+
+// TruncateStyled cuts a styled line to exactly width cells, keeping escape
+// sequences in the kept part. A wide character split by the cut is replaced
+// with spaces, and a reset is appended if styled text was cut off, so the
+// result never bleeds styling into whatever follows it.
+func TruncateStyled(s string, width int) string {
+	if Width(s) <= width {
+		return s
+	}
+
+	head, _ := SplitAt(s, width)
+	if gap := width - Width(head); gap > 0 {
+		head += strings.Repeat(" ", gap)
+	}
+	if strings.Contains(head, "\x1b[") {
+		head += "\x1b[0m"
+	}
+	return head
+}
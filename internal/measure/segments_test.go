@@ -0,0 +1,95 @@
+package measure
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSegments(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []Segment
+	}{
+		{"plain", "hello", []Segment{{Text: "hello"}}},
+		{"empty", "", nil},
+		{"SGR", "\x1b[1mhi\x1b[0m", []Segment{
+			{Text: "\x1b[1m", Escape: true}, {Text: "hi"}, {Text: "\x1b[0m", Escape: true},
+		}},
+		{"cursor movement", "a\x1b[2Kb", []Segment{
+			{Text: "a"}, {Text: "\x1b[2K", Escape: true}, {Text: "b"},
+		}},
+		{"OSC 8 hyperlink with ST", "\x1b]8;;https://x.io\x1b\\link\x1b]8;;\x1b\\", []Segment{
+			{Text: "\x1b]8;;https://x.io\x1b\\", Escape: true}, {Text: "link"}, {Text: "\x1b]8;;\x1b\\", Escape: true},
+		}},
+		{"OSC with BEL", "\x1b]0;title\x07x", []Segment{
+			{Text: "\x1b]0;title\x07", Escape: true}, {Text: "x"},
+		}},
+		{"APC", "\x1b_Ga=T;AAAA\x1b\\x", []Segment{
+			{Text: "\x1b_Ga=T;AAAA\x1b\\", Escape: true}, {Text: "x"},
+		}},
+		{"unterminated CSI", "a\x1b[31", []Segment{{Text: "a"}, {Text: "\x1b[31", Escape: true}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Segments(tt.input)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Segments(%q) = %#v, want %#v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSplitAt(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      string
+		width      int
+		head, tail string
+	}{
+		{"plain", "hello world", 5, "hello", " world"},
+		{"fits", "hi", 5, "hi", ""},
+		{"zero", "hi", 0, "", "hi"},
+		{"styled", "\x1b[1mhello\x1b[0m", 3, "\x1b[1mhel", "lo\x1b[0m"},
+		{"escape at cut goes to tail", "ab\x1b[0mcd", 2, "ab", "\x1b[0mcd"},
+		{"wide rune not split", "你好", 3, "你", "好"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			head, tail := SplitAt(tt.input, tt.width)
+			if head != tt.head || tail != tt.tail {
+				t.Errorf("SplitAt(%q, %d) = (%q, %q), want (%q, %q)", tt.input, tt.width, head, tail, tt.head, tt.tail)
+			}
+		})
+	}
+}
+
+func TestTruncateStyled(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		width int
+		want  string
+	}{
+		{"fits", "abc", 5, "abc"},
+		{"plain", "abcdef", 3, "abc"},
+		{"wide rune split becomes space", "你好世界", 3, "你 "},
+		{"wide runes whole", "你好世界", 4, "你好"},
+		{"styled keeps escapes and resets", "\x1b[31m你好世界\x1b[0m", 5, "\x1b[31m你好 \x1b[0m"},
+		{"zero width", "abc", 0, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := TruncateStyled(tt.input, tt.width)
+			if got != tt.want {
+				t.Errorf("TruncateStyled(%q, %d) = %q, want %q", tt.input, tt.width, got, tt.want)
+			}
+			if w := Width(got); tt.width < Width(tt.input) && w != tt.width {
+				t.Errorf("width = %d, want exactly %d", w, tt.width)
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package measure
+
+import "strings"
+
+// OpenSGR returns the SGR sequences still in effect at the end of s: every
+// SGR sequence after the last full reset, concatenated in order, or "" when
+// s ends unstyled. Writing the result at the start of another string
+// re-opens s's styling there.
+func OpenSGR(s string) string {
+	var open []string
+	for _, seg := range Segments(s) {
+		if !seg.Escape || !isSGR(seg.Text) {
+			continue
+		}
+		params := seg.Text[2 : len(seg.Text)-1]
+		first, rest, _ := strings.Cut(params, ";")
+		if first == "" || first == "0" {
+			open = open[:0]
+			if rest == "" {
+				continue
+			}
+		}
+		open = append(open, seg.Text)
+	}
+	return strings.Join(open, "")
+}
+
+// isSGR reports whether an escape sequence is a CSI SGR sequence (ESC [ ... m)
+func isSGR(esc string) bool {
+	return len(esc) >= 3 && esc[1] == '[' && esc[len(esc)-1] == 'm'
+}
@@ -0,0 +1,28 @@
+package measure
+
+import "testing"
+
+func TestOpenSGR(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{"plain", "abc", ""},
+		{"open color", "\x1b[31mred", "\x1b[31m"},
+		{"closed", "\x1b[31mred\x1b[0m", ""},
+		{"short reset", "\x1b[1mx\x1b[m", ""},
+		{"accumulates", "\x1b[1mbold \x1b[38;5;196mred", "\x1b[1m\x1b[38;5;196m"},
+		{"after reset", "\x1b[1mx\x1b[0my\x1b[4mz", "\x1b[4m"},
+		{"reset with params", "\x1b[1mx\x1b[0;32mz", "\x1b[0;32m"},
+		{"other escapes ignored", "\x1b[31m\x1b[2K\x1b]8;;url\x1b\\x", "\x1b[31m"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := OpenSGR(tt.input); got != tt.want {
+				t.Errorf("OpenSGR(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
@@ -10,8 +10,11 @@ import (
 	"github.com/mattn/go-runewidth"
 )
 
-// ansiRegex matches ANSI escape sequences to strip them before measuring
-var ansiRegex = regexp.MustCompile(`\x1b\[[0-9;]*m`)
+// ansiRegex matches escape sequences to strip them before measuring: CSI
+// sequences (SGR colors, cursor movement), OSC sequences (hyperlinks,
+// window titles) terminated by BEL or ST, APC sequences (Kitty graphics)
+// terminated by ST, and the cursor save and restore escapes
+var ansiRegex = regexp.MustCompile(`\x1b\[[0-?]*[ -/]*[@-~]|\x1b\][^\x07\x1b]*(?:\x07|\x1b\\)|\x1b_[^\x1b]*\x1b\\|\x1b[78]`)
 
 // Width returns the visible width of a string in terminal cells.
 // It strips ANSI escape codes and accounts for Unicode character widths:
@@ -92,3 +95,23 @@ func Truncate(s string, width int, tail string) string {
 
 	return truncated + tail
 }
+
+// TruncateExact is Truncate for fixed-width columns: a string that is cut
+// comes out exactly width cells wide. A wide character that does not fit
+// before the tail is replaced with spaces, so "你好世界" cut to 6 cells with
+// "..." gives "你 ..." rather than the 5-cell "你...".
+func TruncateExact(s string, width int, tail string) string {
+	out := Truncate(s, width, tail)
+	if out == s {
+		return out
+	}
+
+	gap := width - runewidth.StringWidth(out)
+	if gap <= 0 {
+		return out
+	}
+	if runewidth.StringWidth(tail) >= width {
+		return out + strings.Repeat(" ", gap)
+	}
+	return out[:len(out)-len(tail)] + strings.Repeat(" ", gap) + tail
+}
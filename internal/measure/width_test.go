@@ -54,6 +54,11 @@ func TestStripANSI(t *testing.T) {
 		{"mixed with text", "normal \x1b[31mred\x1b[0m normal", "normal red normal"},
 		{"empty string", "", ""},
 		{"only ANSI", "\x1b[31m\x1b[0m", ""},
+		{"cursor movement", "a\x1b[2Kb\x1b[3A", "ab"},
+		{"OSC 8 hyperlink", "\x1b]8;;https://example.com\x1b\\link\x1b]8;;\x1b\\", "link"},
+		{"OSC with BEL", "\x1b]0;title\x07text", "text"},
+		{"APC graphics", "\x1b_Gf=100,m=0;iVBORw0K\x1b\\text", "text"},
+		{"cursor save and restore", "a\x1b7b\x1b8c", "abc"},
 	}
 
 	for _, tt := range tests {
@@ -182,6 +187,34 @@ func TestTruncate(t *testing.T) {
 	}
 }
 
+func TestTruncateExact(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		width int
+		tail  string
+		want  string
+	}{
+		{"no truncation needed", "你好", 6, "...", "你好"},
+		{"ASCII", "hello world", 8, "...", "hello..."},
+		{"CJK padded before tail", "你好世界", 6, "...", "你 ..."},
+		{"CJK exact fit", "你好世界", 7, "...", "你好..."},
+		{"no tail", "你好世界", 5, "", "你好 "},
+		{"tail wider than width", "hello", 3, "【】", "【 "},
+		{"zero width", "你好", 0, "...", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := TruncateExact(tt.input, tt.width, tt.tail)
+			if got != tt.want {
+				t.Errorf("TruncateExact(%q, %d, %q) = %q, want %q",
+					tt.input, tt.width, tt.tail, got, tt.want)
+			}
+		})
+	}
+}
+
 // Benchmark for performance validation
 func BenchmarkWidth(b *testing.B) {
 	testStrings := []string{
@@ -0,0 +1,164 @@
+package tuistyles
+
+import (
+	"strings"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+// FitBlock pairs content with the Style that renders it, so that
+// JoinHorizontalFit can re-wrap the content at a different width.
+type FitBlock struct {
+	Content string
+	Style   Style
+}
+
+// JoinHorizontalFit joins blocks side-by-side so the row is exactly
+// totalWidth cells wide.
+//
+// Each block is first rendered at its natural width. The available width is
+// then shared out in proportion to those natural widths, so blocks grow or
+// shrink together, and every block is re-rendered at its share: the content
+// is word-wrapped to fit inside the block's padding and border, and shorter
+// lines are padded using the block's alignment (Left when none is set). Any
+// Width or MaxWidth on a block's Style is replaced by its share.
+//
+// Every block keeps at least one content cell plus its frame. When
+// totalWidth is too small for that, the row is wider than totalWidth.
+// pos aligns blocks of different heights, as in JoinHorizontal.
+//
+// Example:
+//
+//	box := NewStyle().Border(RoundedBorder()).Padding(0, 1)
+//	row := JoinHorizontalFit(80, Top,
+//		FitBlock{Content: sidebar, Style: box},
+//		FitBlock{Content: body, Style: box},
+//	)
+func JoinHorizontalFit(totalWidth int, pos Position, blocks ...FitBlock) string {
+	if len(blocks) == 0 {
+		return ""
+	}
+
+	natural := make([]int, len(blocks))
+	minimum := make([]int, len(blocks))
+	for i, b := range blocks {
+		natural[i] = measure.MaxWidth(b.Style.Render(b.Content))
+		minimum[i] = b.Style.horizontalFrame() + 1
+	}
+
+	widths := fitWidths(totalWidth, natural, minimum)
+
+	rendered := make([]string, len(blocks))
+	for i, b := range blocks {
+		rendered[i] = b.renderAt(widths[i])
+	}
+	return JoinHorizontal(pos, rendered...)
+}
+
+// renderAt renders the block so every line is exactly width cells wide
+func (b FitBlock) renderAt(width int) string {
+	inner := width - b.Style.horizontalFrame()
+	if inner < 1 {
+		inner = 1
+	}
+
+	s := b.Style.Width(inner)
+	s.maxWidth = nil
+	if s.align == nil {
+		s = s.Align(Left)
+	}
+
+	out := s.Render(Wrap(b.Content, inner))
+	if out == "" {
+		return strings.Repeat(" ", width)
+	}
+
+	lines := strings.Split(out, "\n")
+	for i, line := range lines {
+		if w := measure.Width(line); w < width {
+			lines[i] = line + strings.Repeat(" ", width-w)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// horizontalFrame returns the number of cells the style adds to the left
+// and right of its content: padding, border sides, and drop shadow
+func (s Style) horizontalFrame() int {
+	frame := 0
+	if s.paddingLeft != nil {
+		frame += *s.paddingLeft
+	}
+	if s.paddingRight != nil {
+		frame += *s.paddingRight
+	}
+	if s.hasBorder() {
+		border := *s.borderType
+		if s.borderLeft == nil || *s.borderLeft {
+			frame += measure.Width(border.Left)
+		}
+		if s.borderRight == nil || *s.borderRight {
+			frame += measure.Width(border.Right)
+		}
+	}
+	if s.hasShadow() {
+		frame++
+	}
+	return frame
+}
+
+// fitWidths shares total among columns in proportion to their natural
+// widths, handing leftover cells to the largest fractional remainders, and
+// then raises any column below its minimum by taking cells from the widest
+// columns that can spare them
+func fitWidths(total int, natural, minimum []int) []int {
+	if total < 0 {
+		total = 0
+	}
+	n := len(natural)
+	sum := 0
+	for _, w := range natural {
+		sum += w
+	}
+
+	widths := make([]int, n)
+	remainders := make([]int, n)
+	assigned := 0
+	for i, w := range natural {
+		if sum == 0 {
+			widths[i] = total / n
+			remainders[i] = total % n
+		} else {
+			widths[i] = w * total / sum
+			remainders[i] = w * total % sum
+		}
+		assigned += widths[i]
+	}
+
+	for left := total - assigned; left > 0; left-- {
+		best := 0
+		for i := range remainders {
+			if remainders[i] > remainders[best] {
+				best = i
+			}
+		}
+		widths[best]++
+		remainders[best] = -1
+	}
+
+	for i := range widths {
+		for widths[i] < minimum[i] {
+			donor := -1
+			for j := range widths {
+				if widths[j] > minimum[j] && (donor < 0 || widths[j] > widths[donor]) {
+					donor = j
+				}
+			}
+			if donor >= 0 {
+				widths[donor]--
+			}
+			widths[i]++
+		}
+	}
+	return widths
+}
@@ -0,0 +1,82 @@
+package tuistyles
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+func TestJoinHorizontalFit_ExactWidth(t *testing.T) {
+	box := NewStyle().Border(NormalBorder()).Padding(0, 1)
+	blocks := []FitBlock{
+		{Content: "Sidebar with a few words", Style: box},
+		{Content: "Main content area that is considerably longer than the sidebar text", Style: box},
+	}
+
+	for _, total := range []int{30, 50, 80, 120, 200} {
+		out := JoinHorizontalFit(total, Top, blocks...)
+		for i, line := range strings.Split(out, "\n") {
+			require.Equal(t, total, measure.Width(line), "total %d line %d: %q", total, i, line)
+		}
+	}
+}
+
+func TestJoinHorizontalFit_WrapsContent(t *testing.T) {
+	out := JoinHorizontalFit(20, Top,
+		FitBlock{Content: "aaaa bbbb cccc"},
+		FitBlock{Content: "dddd eeee ffff"},
+	)
+	require.Equal(t, strings.Join([]string{
+		"aaaa bbbb dddd eeee ",
+		"cccc      ffff      ",
+	}, "\n"), out)
+}
+
+func TestJoinHorizontalFit_KeepsAlignment(t *testing.T) {
+	out := JoinHorizontalFit(10, Top,
+		FitBlock{Content: "ab", Style: NewStyle().Align(Right)},
+		FitBlock{Content: "cd"},
+	)
+	require.Equal(t, "   abcd   ", out)
+}
+
+func TestJoinHorizontalFit_Empty(t *testing.T) {
+	require.Equal(t, "", JoinHorizontalFit(40, Top))
+
+	out := JoinHorizontalFit(6, Top, FitBlock{}, FitBlock{Content: "x"})
+	require.Equal(t, 6, measure.Width(out))
+}
+
+func TestFitWidths(t *testing.T) {
+	tests := []struct {
+		name     string
+		total    int
+		natural  []int
+		minimum  []int
+		expected []int
+	}{
+		{"proportional", 20, []int{10, 30}, []int{1, 1}, []int{5, 15}},
+		{"expand", 60, []int{10, 20}, []int{1, 1}, []int{20, 40}},
+		{"remainder to largest fraction", 10, []int{1, 1, 1}, []int{1, 1, 1}, []int{4, 3, 3}},
+		{"all empty shares evenly", 7, []int{0, 0}, []int{1, 1}, []int{4, 3}},
+		{"minimum taken from widest", 10, []int{1, 99}, []int{4, 1}, []int{4, 6}},
+		{"overflow when minimums do not fit", 3, []int{5, 5}, []int{3, 3}, []int{3, 3}},
+		{"negative total", -5, []int{5}, []int{1}, []int{1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, fitWidths(tt.total, tt.natural, tt.minimum))
+		})
+	}
+}
+
+func TestStyle_HorizontalFrame(t *testing.T) {
+	require.Equal(t, 0, NewStyle().horizontalFrame())
+	require.Equal(t, 4, NewStyle().Border(RoundedBorder()).Padding(0, 1).horizontalFrame())
+	require.Equal(t, 1, NewStyle().Border(NormalBorder(), false, false, false, true).horizontalFrame())
+	require.Equal(t, 3, NewStyle().Border(NormalBorder()).Shadow(true).horizontalFrame())
+}
@@ -0,0 +1,346 @@
+package tuistyles
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// StyleJSONVersion is the schema version written by Style.MarshalJSON.
+//
+// UnmarshalJSON accepts documents with this version or older (a missing
+// version is treated as 1) and rejects documents from newer schemas.
+const StyleJSONVersion = 1
+
+// styleJSON is the stable wire representation of a Style.
+//
+// Field names are part of the public format: rename them only together with
+// a StyleJSONVersion bump. Unset style properties are omitted.
+type styleJSON struct {
+	Version int `json:"version"`
+
+	Bold          *bool `json:"bold,omitempty"`
+	Italic        *bool `json:"italic,omitempty"`
+	Underline     *bool `json:"underline,omitempty"`
+	Strikethrough *bool `json:"strikethrough,omitempty"`
+	Faint         *bool `json:"faint,omitempty"`
+	Blink         *bool `json:"blink,omitempty"`
+	Reverse       *bool `json:"reverse,omitempty"`
+
+	Foreground *Color `json:"foreground,omitempty"`
+	Background *Color `json:"background,omitempty"`
+
+	AutoForeground *bool `json:"auto_foreground,omitempty"`
+
+	Width     *int `json:"width,omitempty"`
+	Height    *int `json:"height,omitempty"`
+	MaxWidth  *int `json:"max_width,omitempty"`
+	MaxHeight *int `json:"max_height,omitempty"`
+
+	WidthPercent  *int `json:"width_percent,omitempty"`
+	HeightPercent *int `json:"height_percent,omitempty"`
+
+	TruncateMode  *TruncateMode `json:"truncate_mode,omitempty"`
+	TruncateExact *bool         `json:"truncate_exact,omitempty"`
+
+	Align            *Position `json:"align,omitempty"`
+	AlignVertical    *Position `json:"align_vertical,omitempty"`
+	DecimalSeparator *string   `json:"decimal_separator,omitempty"`
+
+	Direction   *TextDirection `json:"direction,omitempty"`
+	BidiReorder *bool          `json:"bidi_reorder,omitempty"`
+
+	Hyphenate *bool           `json:"hyphenate,omitempty"`
+	LongWords *LongWordPolicy `json:"long_words,omitempty"`
+
+	PaddingTop    *int `json:"padding_top,omitempty"`
+	PaddingRight  *int `json:"padding_right,omitempty"`
+	PaddingBottom *int `json:"padding_bottom,omitempty"`
+	PaddingLeft   *int `json:"padding_left,omitempty"`
+	MarginTop     *int `json:"margin_top,omitempty"`
+	MarginRight   *int `json:"margin_right,omitempty"`
+	MarginBottom  *int `json:"margin_bottom,omitempty"`
+	MarginLeft    *int `json:"margin_left,omitempty"`
+
+	PaddingTopBackground    *Color `json:"padding_top_background,omitempty"`
+	PaddingRightBackground  *Color `json:"padding_right_background,omitempty"`
+	PaddingBottomBackground *Color `json:"padding_bottom_background,omitempty"`
+	PaddingLeftBackground   *Color `json:"padding_left_background,omitempty"`
+
+	Border           *Border `json:"border,omitempty"`
+	BorderTop        *bool   `json:"border_top,omitempty"`
+	BorderRight      *bool   `json:"border_right,omitempty"`
+	BorderBottom     *bool   `json:"border_bottom,omitempty"`
+	BorderLeft       *bool   `json:"border_left,omitempty"`
+	BorderForeground *Color  `json:"border_foreground,omitempty"`
+	BorderBackground *Color  `json:"border_background,omitempty"`
+
+	BorderTopForeground    *Color `json:"border_top_foreground,omitempty"`
+	BorderRightForeground  *Color `json:"border_right_foreground,omitempty"`
+	BorderBottomForeground *Color `json:"border_bottom_foreground,omitempty"`
+	BorderLeftForeground   *Color `json:"border_left_foreground,omitempty"`
+	BorderTopBackground    *Color `json:"border_top_background,omitempty"`
+	BorderRightBackground  *Color `json:"border_right_background,omitempty"`
+	BorderBottomBackground *Color `json:"border_bottom_background,omitempty"`
+	BorderLeftBackground   *Color `json:"border_left_background,omitempty"`
+
+	Shadow      *bool  `json:"shadow,omitempty"`
+	ShadowColor *Color `json:"shadow_color,omitempty"`
+
+	BackgroundPattern *string `json:"background_pattern,omitempty"`
+}
+
+// MarshalJSON encodes the Style as a versioned JSON object.
+//
+// Only properties that have been explicitly set are written, so a round trip
+// through JSON preserves the difference between "unset" and "set to zero".
+//
+// Example:
+//
+//	data, _ := json.Marshal(NewStyle().Bold(true).Padding(1))
+//	// {"version":1,"bold":true,"padding_top":1,...}
+func (s Style) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.toJSON())
+}
+
+// toJSON copies the style's properties into their serialized form
+func (s Style) toJSON() styleJSON {
+	return styleJSON{
+		Version:                 StyleJSONVersion,
+		Bold:                    s.bold,
+		Italic:                  s.italic,
+		Underline:               s.underline,
+		Strikethrough:           s.strikethrough,
+		Faint:                   s.faint,
+		Blink:                   s.blink,
+		Reverse:                 s.reverse,
+		Foreground:              s.foreground,
+		Background:              s.background,
+		AutoForeground:          s.autoForeground,
+		Width:                   s.width,
+		Height:                  s.height,
+		MaxWidth:                s.maxWidth,
+		MaxHeight:               s.maxHeight,
+		WidthPercent:            s.widthPercent,
+		HeightPercent:           s.heightPercent,
+		TruncateMode:            s.truncateMode,
+		TruncateExact:           s.truncateExact,
+		Align:                   s.align,
+		DecimalSeparator:        s.decimalSeparator,
+		AlignVertical:           s.alignVertical,
+		Direction:               s.direction,
+		BidiReorder:             s.bidiReorder,
+		Hyphenate:               s.hyphenate,
+		LongWords:               s.longWords,
+		PaddingTop:              s.paddingTop,
+		PaddingRight:            s.paddingRight,
+		PaddingBottom:           s.paddingBottom,
+		PaddingLeft:             s.paddingLeft,
+		MarginTop:               s.marginTop,
+		MarginRight:             s.marginRight,
+		MarginBottom:            s.marginBottom,
+		MarginLeft:              s.marginLeft,
+		PaddingTopBackground:    s.paddingTopBackground,
+		PaddingRightBackground:  s.paddingRightBackground,
+		PaddingBottomBackground: s.paddingBottomBackground,
+		PaddingLeftBackground:   s.paddingLeftBackground,
+		Border:                  s.borderType,
+		BorderTop:               s.borderTop,
+		BorderRight:             s.borderRight,
+		BorderBottom:            s.borderBottom,
+		BorderLeft:              s.borderLeft,
+		BorderForeground:        s.borderForeground,
+		BorderBackground:        s.borderBackground,
+		BorderTopForeground:     s.borderTopForeground,
+		BorderRightForeground:   s.borderRightForeground,
+		BorderBottomForeground:  s.borderBottomForeground,
+		BorderLeftForeground:    s.borderLeftForeground,
+		BorderTopBackground:     s.borderTopBackground,
+		BorderRightBackground:   s.borderRightBackground,
+		BorderBottomBackground:  s.borderBottomBackground,
+		BorderLeftBackground:    s.borderLeftBackground,
+		Shadow:                  s.shadow,
+		ShadowColor:             s.shadowColor,
+		BackgroundPattern:       s.backgroundPattern,
+	}
+}
+
+// UnmarshalJSON decodes a Style previously written by MarshalJSON.
+//
+// Colors are validated with NewColor and negative sizes are clamped to 0,
+// mirroring the builder methods. Unknown fields are ignored so newer minor
+// additions do not break older readers; documents with a version newer than
+// StyleJSONVersion are rejected.
+func (s *Style) UnmarshalJSON(data []byte) error {
+	var raw styleJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return fmt.Errorf("invalid style JSON: %w", err)
+	}
+
+	if raw.Version > StyleJSONVersion {
+		return fmt.Errorf("unsupported style JSON version %d (max %d)", raw.Version, StyleJSONVersion)
+	}
+
+	*s = fromJSON(raw)
+	return nil
+}
+
+// fromJSON builds a Style from its serialized form, clamping sizes as the
+// builder methods do
+func fromJSON(raw styleJSON) Style {
+	return Style{
+		bold:                    raw.Bold,
+		italic:                  raw.Italic,
+		underline:               raw.Underline,
+		strikethrough:           raw.Strikethrough,
+		faint:                   raw.Faint,
+		blink:                   raw.Blink,
+		reverse:                 raw.Reverse,
+		foreground:              raw.Foreground,
+		background:              raw.Background,
+		autoForeground:          raw.AutoForeground,
+		width:                   clampNonNegative(raw.Width),
+		height:                  clampNonNegative(raw.Height),
+		maxWidth:                clampNonNegative(raw.MaxWidth),
+		maxHeight:               clampNonNegative(raw.MaxHeight),
+		widthPercent:            clampNonNegative(raw.WidthPercent),
+		heightPercent:           clampNonNegative(raw.HeightPercent),
+		truncateMode:            raw.TruncateMode,
+		truncateExact:           raw.TruncateExact,
+		align:                   raw.Align,
+		alignVertical:           raw.AlignVertical,
+		decimalSeparator:        raw.DecimalSeparator,
+		direction:               raw.Direction,
+		bidiReorder:             raw.BidiReorder,
+		hyphenate:               raw.Hyphenate,
+		longWords:               raw.LongWords,
+		paddingTop:              clampNonNegative(raw.PaddingTop),
+		paddingRight:            clampNonNegative(raw.PaddingRight),
+		paddingBottom:           clampNonNegative(raw.PaddingBottom),
+		paddingLeft:             clampNonNegative(raw.PaddingLeft),
+		marginTop:               clampNonNegative(raw.MarginTop),
+		marginRight:             clampNonNegative(raw.MarginRight),
+		marginBottom:            clampNonNegative(raw.MarginBottom),
+		marginLeft:              clampNonNegative(raw.MarginLeft),
+		paddingTopBackground:    raw.PaddingTopBackground,
+		paddingRightBackground:  raw.PaddingRightBackground,
+		paddingBottomBackground: raw.PaddingBottomBackground,
+		paddingLeftBackground:   raw.PaddingLeftBackground,
+		borderType:              raw.Border,
+		borderTop:               raw.BorderTop,
+		borderRight:             raw.BorderRight,
+		borderBottom:            raw.BorderBottom,
+		borderLeft:              raw.BorderLeft,
+		borderForeground:        raw.BorderForeground,
+		borderBackground:        raw.BorderBackground,
+		borderTopForeground:     raw.BorderTopForeground,
+		borderRightForeground:   raw.BorderRightForeground,
+		borderBottomForeground:  raw.BorderBottomForeground,
+		borderLeftForeground:    raw.BorderLeftForeground,
+		borderTopBackground:     raw.BorderTopBackground,
+		borderRightBackground:   raw.BorderRightBackground,
+		borderBottomBackground:  raw.BorderBottomBackground,
+		borderLeftBackground:    raw.BorderLeftBackground,
+		shadow:                  raw.Shadow,
+		shadowColor:             raw.ShadowColor,
+		backgroundPattern:       raw.BackgroundPattern,
+	}
+}
+
+// clampNonNegative returns a copy of v clamped to 0, or nil if v is nil
+func clampNonNegative(v *int) *int {
+	if v == nil {
+		return nil
+	}
+	n := *v
+	if n < 0 {
+		n = 0
+	}
+	return &n
+}
+
+// MarshalJSON encodes the Color as a JSON string.
+func (c Color) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(c))
+}
+
+// UnmarshalJSON decodes a JSON string into a Color, validating it with NewColor.
+func (c *Color) UnmarshalJSON(data []byte) error {
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return fmt.Errorf("color must be a JSON string: %w", err)
+	}
+
+	parsed, err := NewColor(str)
+	if err != nil {
+		return err
+	}
+
+	*c = parsed
+	return nil
+}
+
+// MarshalText encodes the Position as its lowercase name ("left", "center", ...).
+func (p Position) MarshalText() ([]byte, error) {
+	if !p.IsValid() {
+		return nil, fmt.Errorf("invalid position: %d", int(p))
+	}
+	return []byte(strings.ToLower(p.String())), nil
+}
+
+// UnmarshalText decodes a position name (case-insensitive).
+func (p *Position) UnmarshalText(text []byte) error {
+	name := strings.ToLower(string(text))
+	for candidate := Left; candidate <= Decimal; candidate++ {
+		if strings.ToLower(candidate.String()) == name {
+			*p = candidate
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid position: %q", string(text))
+}
+
+// borderJSON is the wire representation of a custom Border.
+type borderJSON struct {
+	Top         string `json:"top"`
+	Bottom      string `json:"bottom"`
+	Left        string `json:"left"`
+	Right       string `json:"right"`
+	TopLeft     string `json:"top_left"`
+	TopRight    string `json:"top_right"`
+	BottomLeft  string `json:"bottom_left"`
+	BottomRight string `json:"bottom_right"`
+}
+
+// MarshalJSON encodes the Border.
+//
+// Predefined borders are written by name ("rounded", "thick", ...) so
+// persisted styles stay readable; custom borders are written as an object
+// with one field per border character.
+func (b Border) MarshalJSON() ([]byte, error) {
+	if name, ok := BorderName(b); ok {
+		return json.Marshal(name)
+	}
+	return json.Marshal(borderJSON(b))
+}
+
+// UnmarshalJSON decodes either a predefined border name or a border object.
+func (b *Border) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err == nil {
+		border, ok := BorderByName(name)
+		if !ok {
+			return fmt.Errorf("unknown border name: %q", name)
+		}
+		*b = border
+		return nil
+	}
+
+	var raw borderJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return fmt.Errorf("border must be a name or an object: %w", err)
+	}
+
+	*b = Border(raw)
+	return nil
+}
@@ -0,0 +1,137 @@
+package tuistyles
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestStyleJSON_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name  string
+		style Style
+	}{
+		{"empty style", NewStyle()},
+		{"text attributes", NewStyle().Bold(true).Italic(false).Underline(true).Faint(true)},
+		{"colors", NewStyle().Foreground(Color("#FF0000")).Background(Color("blue"))},
+		{"layout", NewStyle().Width(40).Height(3).MaxWidth(60).MaxHeight(10).Align(Center).AlignVertical(Bottom)},
+		{"percent sizes", NewStyle().WidthPercent(50).HeightPercent(25).Width(20)},
+		{"spacing", NewStyle().Padding(1, 2, 3, 4).Margin(0, 2)},
+		{"direction", NewStyle().Direction(DirectionRTL).BidiReorder(true)},
+		{"hyphenate", NewStyle().Width(12).Hyphenate(true)},
+		{"long words", NewStyle().Width(12).LongWords(TruncateToken)},
+		{"truncate mode", NewStyle().MaxWidth(12).TruncateMode(TruncateHead)},
+		{"truncate exact", NewStyle().MaxWidth(6).TruncateExact(true)},
+		{"decimal alignment", NewStyle().Width(10).Align(Decimal).DecimalSeparator(",")},
+		{"background pattern", NewStyle().Width(10).BackgroundPattern("░▒")},
+		{"predefined border", NewStyle().Border(RoundedBorder(), true, false, true, false).BorderForeground(Color("214"))},
+		{"custom border", NewStyle().Border(Border{Top: "-", Bottom: "-", Left: "|", Right: "|", TopLeft: "+", TopRight: "+", BottomLeft: "+", BottomRight: "+"})},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.style)
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+
+			var got Style
+			if err := json.Unmarshal(data, &got); err != nil {
+				t.Fatalf("Unmarshal(%s) error = %v", data, err)
+			}
+
+			if !reflect.DeepEqual(got, tt.style) {
+				t.Errorf("round trip mismatch for %s", data)
+			}
+			if got.Render("x") != tt.style.Render("x") {
+				t.Errorf("round trip renders differently for %s", data)
+			}
+		})
+	}
+}
+
+func TestStyleJSON_StableFieldNames(t *testing.T) {
+	s := NewStyle().
+		Bold(true).
+		Foreground(Color("red")).
+		Align(Right).
+		PaddingLeft(2).
+		Border(ThickBorder())
+
+	data, err := json.Marshal(s)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+
+	want := `{"version":1,"bold":true,"foreground":"red","align":"right","padding_left":2,` +
+		`"border":"thick","border_top":true,"border_right":true,"border_bottom":true,"border_left":true}`
+	if string(data) != want {
+		t.Errorf("Marshal() =\n%s\nwant\n%s", data, want)
+	}
+}
+
+func TestStyleJSON_UnmarshalErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr string
+	}{
+		{"newer version", `{"version":2}`, "unsupported style JSON version"},
+		{"invalid color", `{"foreground":"#GGG"}`, "invalid hex color"},
+		{"unknown border name", `{"border":"wavy"}`, "unknown border name"},
+		{"invalid position", `{"align":"middle"}`, "invalid position"},
+		{"invalid direction", `{"direction":"up"}`, "invalid text direction"},
+		{"not an object", `[1,2]`, "invalid style JSON"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var s Style
+			err := json.Unmarshal([]byte(tt.input), &s)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Unmarshal(%s) error = %v, want containing %q", tt.input, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestStyleJSON_UnmarshalNormalizes(t *testing.T) {
+	var s Style
+	input := `{"foreground":"#f00","width":-5,"padding_top":-1,"unknown_field":true}`
+	if err := json.Unmarshal([]byte(input), &s); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	if s.foreground == nil || *s.foreground != "#FF0000" {
+		t.Errorf("foreground = %v, want #FF0000", s.foreground)
+	}
+	if s.width == nil || *s.width != 0 {
+		t.Errorf("width = %v, want clamped to 0", s.width)
+	}
+	if s.paddingTop == nil || *s.paddingTop != 0 {
+		t.Errorf("paddingTop = %v, want clamped to 0", s.paddingTop)
+	}
+}
+
+func TestBorderByName(t *testing.T) {
+	for _, nb := range namedBorders {
+		t.Run(nb.name, func(t *testing.T) {
+			b, ok := BorderByName(nb.name)
+			if !ok || b != nb.border() {
+				t.Fatalf("BorderByName(%q) = %v, %v", nb.name, b, ok)
+			}
+			name, ok := BorderName(b)
+			if !ok || name != nb.name {
+				t.Errorf("BorderName() = %q, %v, want %q", name, ok, nb.name)
+			}
+		})
+	}
+
+	if _, ok := BorderByName("wavy"); ok {
+		t.Error("BorderByName(\"wavy\") should not be found")
+	}
+	if _, ok := BorderName(Border{Top: "~"}); ok {
+		t.Error("BorderName() of a custom border should not be found")
+	}
+}
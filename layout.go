@@ -311,53 +311,8 @@ func Place(width, height int, hPos, vPos Position, content string) string {
 	}
 
 	lines := strings.Split(content, "\n")
-
-	// Measure content dimensions
-	contentHeight := len(lines)
-	contentWidth := 0
-	for _, line := range lines {
-		w := measure.Width(line)
-		if w > contentWidth {
-			contentWidth = w
-		}
-	}
-
-	// Calculate start position
-	var startRow, startCol int
-
-	switch vPos {
-	case Top:
-		startRow = 0
-	case Center:
-		startRow = (height - contentHeight) / 2
-		if startRow < 0 {
-			startRow = 0
-		}
-	case Bottom:
-		startRow = height - contentHeight
-		if startRow < 0 {
-			startRow = 0
-		}
-	default:
-		startRow = 0
-	}
-
-	switch hPos {
-	case Left:
-		startCol = 0
-	case Center:
-		startCol = (width - contentWidth) / 2
-		if startCol < 0 {
-			startCol = 0
-		}
-	case Right:
-		startCol = width - contentWidth
-		if startCol < 0 {
-			startCol = 0
-		}
-	default:
-		startCol = 0
-	}
+	area := placement(width, height, hPos, vPos, lines)
+	startRow, startCol := area.Y, area.X
 
 	// Create box filled with spaces
 	box := make([]string, height)
@@ -381,8 +336,9 @@ func Place(width, height int, hPos, vPos Position, content string) string {
 			if lineWidth <= 0 {
 				continue
 			}
-			// Use measure.Truncate for ANSI-aware truncation
-			line = measure.Truncate(line, lineWidth, "")
+			// Keep styling and replace a wide rune split at the edge with a
+			// space so the line stays exactly lineWidth cells
+			line = measure.TruncateStyled(line, lineWidth)
 		}
 
 		// Place line in box (handle ANSI codes properly)
@@ -394,3 +350,83 @@ func Place(width, height int, hPos, vPos Position, content string) string {
 
 	return strings.Join(box, "\n")
 }
+
+// PlaceRect returns the area Place puts content in within a width x height
+// box, clipped to the box, so callers can tell where placed content ended
+// up. It is the zero Rect when the box is empty.
+//
+// Example:
+//
+//	area := PlaceRect(80, 24, Center, Center, dialog)
+//	if area.Contains(Point{X: clickX, Y: clickY}) { ... }
+func PlaceRect(width, height int, hPos, vPos Position, content string) Rect {
+	if width <= 0 || height <= 0 {
+		return Rect{}
+	}
+	box := Rect{Width: width, Height: height}
+	return placement(width, height, hPos, vPos, strings.Split(content, "\n")).Intersect(box)
+}
+
+// Overlay draws foreground over background with its top-left corner at
+// column x and row y (counting from 0), keeping the styling of both: the
+// cells foreground covers are replaced and the rest of each background
+// line is left as it was. Lines of foreground that fall above or below
+// background are dropped, as are cells left of column 0; background lines
+// shorter than x are padded with spaces. Overlay is how popups, toasts,
+// and menus go on top of an already rendered screen.
+//
+// Example:
+//
+//	dialog := NewStyle().Border(RoundedBorder()).Render("Save changes?")
+//	area := PlaceRect(80, 24, Center, Center, dialog)
+//	fmt.Print(Overlay(screen, dialog, area.X, area.Y))
+func Overlay(background, foreground string, x, y int) string {
+	lines := strings.Split(background, "\n")
+	for i, line := range strings.Split(foreground, "\n") {
+		row := y + i
+		if row < 0 || row >= len(lines) {
+			continue
+		}
+
+		from, to := x, x+measure.Width(line)
+		if from < 0 {
+			line = CutANSI(line, -from, to-from)
+			from = 0
+		}
+		if to <= from {
+			continue
+		}
+		under := lines[row]
+		underWidth := measure.Width(under)
+		lines[row] = CutANSI(under, 0, from) + strings.Repeat(" ", max(from-underWidth, 0)) +
+			line + CutANSI(under, to, underWidth)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// placement returns where Place puts lines within a width x height box,
+// before clipping
+func placement(width, height int, hPos, vPos Position, lines []string) Rect {
+	contentHeight := len(lines)
+	contentWidth := 0
+	for _, line := range lines {
+		contentWidth = max(contentWidth, measure.Width(line))
+	}
+
+	offset := func(space, size int, pos, far Position) int {
+		switch pos {
+		case Center:
+			return max((space-size)/2, 0)
+		case far:
+			return max(space-size, 0)
+		default:
+			return 0
+		}
+	}
+	return Rect{
+		X:      offset(width, contentWidth, hPos, Right),
+		Y:      offset(height, contentHeight, vPos, Bottom),
+		Width:  contentWidth,
+		Height: contentHeight,
+	}
+}
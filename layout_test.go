@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/orchard9/tui-styles/internal/measure"
 )
 
 // TestDimensionMethods tests all dimension methods with table-driven approach.
@@ -694,3 +696,67 @@ func TestPlace(t *testing.T) {
 		})
 	}
 }
+
+func TestPlace_WideRuneTruncation(t *testing.T) {
+	tests := []struct {
+		name    string
+		width   int
+		hPos    Position
+		content string
+		want    string
+	}{
+		{"split wide rune becomes space", 5, Left, "你好世界", "你好 "},
+		{"wide runes cut on boundary", 4, Left, "你好世界", "你好"},
+		{"offset content", 6, Right, "日本語テキスト", "日本語"},
+		{"mixed widths", 4, Left, "a你好", "a你 "},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output := Place(tt.width, 1, tt.hPos, Top, tt.content)
+			require.Equal(t, tt.want, output)
+			require.Equal(t, tt.width, measure.Width(output))
+		})
+	}
+}
+
+func TestPlace_WideRuneTruncationInsideBorder(t *testing.T) {
+	// A clipped CJK line must not shift the right border of a box built around it
+	placed := Place(5, 2, Left, Top, "你好世界\nab")
+	boxed := NewStyle().Border(NormalBorder()).Render(placed)
+
+	widths := measure.WidthPerLine(boxed)
+	for i, w := range widths {
+		require.Equal(t, 7, w, "line %d of %q", i, boxed)
+	}
+}
+
+func TestPlace_StyledTruncationDoesNotBleed(t *testing.T) {
+	content := NewStyle().Foreground(Color("red")).Render("你好世界")
+	output := Place(5, 1, Left, Top, content)
+
+	require.Equal(t, 5, measure.Width(output))
+	require.True(t, strings.HasSuffix(output, "\x1b[0m"), "clipped styled content should be reset")
+}
+
+func TestPlaceRect(t *testing.T) {
+	tests := []struct {
+		name       string
+		hPos, vPos Position
+		content    string
+		want       Rect
+	}{
+		{"top left", Left, Top, "ab\nc", Rect{Width: 2, Height: 2}},
+		{"centered", Center, Center, "ab\nc", Rect{X: 4, Y: 1, Width: 2, Height: 2}},
+		{"bottom right", Right, Bottom, "ab\nc", Rect{X: 8, Y: 2, Width: 2, Height: 2}},
+		{"clipped", Left, Top, strings.Repeat("x", 15), Rect{Width: 10, Height: 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, PlaceRect(10, 4, tt.hPos, tt.vPos, tt.content))
+		})
+	}
+
+	require.Equal(t, Rect{}, PlaceRect(0, 4, Left, Top, "x"))
+}
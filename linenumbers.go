@@ -0,0 +1,149 @@
+package tuistyles
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+// LineNumbered renders text through a Style with a line number gutter
+// down the left of the content, inside any padding and border. Build one
+// with WithLineNumbers.
+type LineNumbered struct {
+	style     Style
+	start     int
+	gutter    Style
+	separator string
+}
+
+// WithLineNumbers wraps style so each rendered content line is prefixed
+// with its number, counting from startAt. Numbers are right-aligned to the
+// widest one and drawn in a faint gutter style, followed by a " │ "
+// separator; change both with GutterStyle and Separator.
+//
+// Width and MaxWidth keep measuring the whole content area: the gutter is
+// taken out of them, so a numbered block is as wide as the unnumbered one
+// and alignment and truncation apply to the text beside the gutter.
+//
+// Example:
+//
+//	code := WithLineNumbers(NewStyle().Border(RoundedBorder()).Width(60), 1)
+//	fmt.Println(code.Render(source))
+func WithLineNumbers(style Style, startAt int) LineNumbered {
+	return LineNumbered{
+		style:     style,
+		start:     startAt,
+		gutter:    NewStyle().Faint(true),
+		separator: " │ ",
+	}
+}
+
+// GutterStyle sets the style of the line numbers.
+func (l LineNumbered) GutterStyle(s Style) LineNumbered {
+	l.gutter = s
+	return l
+}
+
+// Separator sets the text between the gutter and the content.
+func (l LineNumbered) Separator(sep string) LineNumbered {
+	l.separator = sep
+	return l
+}
+
+// Render renders str with line numbers. Lines added by the style itself,
+// such as the blank lines Height pads with, get an empty gutter.
+func (l LineNumbered) Render(str string) string {
+	lines := strings.Split(str, "\n")
+	last := l.start + len(lines) - 1
+	digits := max(len(strconv.Itoa(l.start)), len(strconv.Itoa(last)))
+	gutterWidth := digits + measure.Width(l.separator)
+
+	// Lay the text out without the frame, in the space beside the gutter
+	inner := l.style.withoutFrame()
+	if inner.width != nil {
+		inner = inner.Width(*inner.width - gutterWidth)
+	}
+	if inner.maxWidth != nil {
+		inner = inner.MaxWidth(max(*inner.maxWidth-gutterWidth, 1))
+	}
+	body := strings.Split(inner.Render(str), "\n")
+
+	// Vertical alignment may have moved the text down
+	first := 0
+	if l.style.height != nil && len(lines) < len(body) {
+		first = verticalOffset(l.style, len(body)-len(lines))
+	}
+
+	numbered := make([]string, len(body))
+	for i, line := range body {
+		number := strings.Repeat(" ", digits)
+		if n := i - first; n >= 0 && n < len(lines) {
+			number = fmt.Sprintf("%*d", digits, l.start+n)
+		}
+		numbered[i] = l.gutter.Render(number) + l.separator + line
+	}
+	return l.style.frameOnly().Render(strings.Join(numbered, "\n"))
+}
+
+// verticalOffset returns how many of the extra lines a style's vertical
+// alignment places above the content
+func verticalOffset(s Style, extra int) int {
+	align := Top
+	if s.alignVertical != nil {
+		align = *s.alignVertical
+	}
+	switch align {
+	case Center:
+		return extra / 2
+	case Bottom:
+		return extra
+	default:
+		return 0
+	}
+}
+
+// withoutFrame returns s without padding, border, and shadow
+func (s Style) withoutFrame() Style {
+	s.paddingTop, s.paddingRight, s.paddingBottom, s.paddingLeft = nil, nil, nil, nil
+	s.paddingTopBackground, s.paddingRightBackground = nil, nil
+	s.paddingBottomBackground, s.paddingLeftBackground = nil, nil
+	s.borderType = nil
+	s.shadow, s.shadowColor = nil, nil
+	return s
+}
+
+// frameOnly returns the padding, border, and shadow of s, with its
+// background for the padding
+func (s Style) frameOnly() Style {
+	return Style{
+		background:              s.background,
+		backgroundPattern:       s.backgroundPattern,
+		paddingTop:              s.paddingTop,
+		paddingRight:            s.paddingRight,
+		paddingBottom:           s.paddingBottom,
+		paddingLeft:             s.paddingLeft,
+		paddingTopBackground:    s.paddingTopBackground,
+		paddingRightBackground:  s.paddingRightBackground,
+		paddingBottomBackground: s.paddingBottomBackground,
+		paddingLeftBackground:   s.paddingLeftBackground,
+		borderType:              s.borderType,
+		borderTop:               s.borderTop,
+		borderRight:             s.borderRight,
+		borderBottom:            s.borderBottom,
+		borderLeft:              s.borderLeft,
+		borderForeground:        s.borderForeground,
+		borderBackground:        s.borderBackground,
+		borderTopForeground:     s.borderTopForeground,
+		borderRightForeground:   s.borderRightForeground,
+		borderBottomForeground:  s.borderBottomForeground,
+		borderLeftForeground:    s.borderLeftForeground,
+		borderTopBackground:     s.borderTopBackground,
+		borderRightBackground:   s.borderRightBackground,
+		borderBottomBackground:  s.borderBottomBackground,
+		borderLeftBackground:    s.borderLeftBackground,
+		shadow:                  s.shadow,
+		shadowColor:             s.shadowColor,
+	}
+}
@@ -0,0 +1,90 @@
+package tuistyles
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+func TestWithLineNumbers(t *testing.T) {
+	plain := NewStyle().Faint(false)
+
+	tests := []struct {
+		name  string
+		style Style
+		start int
+		input string
+		want  string
+	}{
+		{
+			name:  "single line",
+			style: NewStyle(),
+			start: 1,
+			input: "hello",
+			want:  "1 | hello",
+		},
+		{
+			name:  "gutter aligned to widest number",
+			style: NewStyle(),
+			start: 9,
+			input: "a\nb\nc",
+			want:  " 9 | a\n10 | b\n11 | c",
+		},
+		{
+			name:  "starts at zero",
+			style: NewStyle(),
+			start: 0,
+			input: "a\nb",
+			want:  "0 | a\n1 | b",
+		},
+		{
+			name:  "width includes gutter",
+			style: NewStyle().Width(8).Align(Left),
+			start: 1,
+			input: "ab",
+			want:  "1 | ab  ",
+		},
+		{
+			name:  "height lines get blank gutter",
+			style: NewStyle().Height(3).AlignVertical(Center),
+			start: 1,
+			input: "a",
+			want:  "  |  \n1 | a\n  |  ",
+		},
+		{
+			name:  "border around gutter",
+			style: NewStyle().Border(NormalBorder()),
+			start: 1,
+			input: "ab",
+			want:  "┌──────┐\n│1 | ab│\n└──────┘",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := WithLineNumbers(tt.style, tt.start).GutterStyle(plain).Separator(" | ").Render(tt.input)
+			require.Equal(t, tt.want, measure.StripANSI(got))
+		})
+	}
+}
+
+func TestWithLineNumbers_KeepsWidth(t *testing.T) {
+	style := NewStyle().Width(30).Align(Left).Padding(0, 1).Border(RoundedBorder())
+	text := "first line\nsecond line\nthird"
+
+	numbered := WithLineNumbers(style, 1).Render(text)
+	require.Equal(t, Width(style.Render(text)), Width(numbered))
+	for _, line := range strings.Split(numbered, "\n") {
+		require.Equal(t, Width(numbered), Width(line))
+	}
+}
+
+func TestWithLineNumbers_MaxWidth(t *testing.T) {
+	numbered := WithLineNumbers(NewStyle().MaxWidth(10), 1).Separator(" ").Render("a long line of text")
+	for _, line := range strings.Split(measure.StripANSI(numbered), "\n") {
+		require.LessOrEqual(t, measure.Width(line), 10)
+	}
+}
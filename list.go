@@ -0,0 +1,115 @@
+package tuistyles
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+// List renders items one per row behind a bullet or number, wrapping long
+// items with a hanging indent:
+//
+//   - build the binary
+//   - run the tests and
+//     check the coverage
+//
+// Example:
+//
+//	list := List{
+//	    Items:    []string{"build", "test", "deploy"},
+//	    Numbered: true,
+//	    RowStyle: Stripe(NewStyle(), NewStyle().Background(Color("#262626"))),
+//	}
+//	fmt.Println(list.Render(40))
+type List struct {
+	Items    []string
+	Marker   string        // Before each item; "•" by default
+	Numbered bool          // Number the items ("1.", "2.", ...) instead of using Marker
+	RowStyle LineStyleFunc // Style for each item, layered over ItemStyle
+	Theme    Theme
+
+	MarkerStyle Style // Muted by default
+	ItemStyle   Style // Unstyled by default
+}
+
+// Render draws the list within width cells, wrapping items that do not fit.
+// Every item's text is padded to the same width, so a row background from
+// RowStyle forms an even block. A width of 0 or less never wraps.
+func (l List) Render(width int) string {
+	if len(l.Items) == 0 {
+		return ""
+	}
+
+	theme := l.Theme.WithDefaults()
+	markerStyle := orDefault(l.MarkerStyle, NewStyle().Foreground(theme.Muted))
+
+	markers, markerWidth := l.markers()
+
+	textWidth := width - markerWidth - 1
+	if width <= 0 {
+		textWidth = 0
+		for _, item := range l.Items {
+			textWidth = max(textWidth, measure.MaxWidth(item))
+		}
+	}
+	textWidth = max(textWidth, 1)
+
+	indent := strings.Repeat(" ", markerWidth+1)
+	var lines []string
+	for i, item := range l.Items {
+		style := l.ItemStyle
+		if l.RowStyle != nil {
+			style = l.RowStyle(i, item).over(style)
+		}
+		style = style.withoutFrame().Width(textWidth).Align(Left)
+
+		// Numbers line up on their right edge, bullets on their left
+		marker := markers[i]
+		gap := strings.Repeat(" ", markerWidth-measure.Width(marker))
+		if l.Numbered {
+			marker = gap + marker
+		} else {
+			marker += gap
+		}
+
+		for j, line := range strings.Split(Wrap(item, textWidth), "\n") {
+			if line == "" {
+				line = " "
+			}
+			prefix := indent
+			if j == 0 {
+				prefix = markerStyle.Render(marker) + " "
+			}
+			lines = append(lines, prefix+style.Render(line))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// MinWidth returns the width of the markers and one cell of item text.
+func (l List) MinWidth() int {
+	if len(l.Items) == 0 {
+		return 0
+	}
+	_, markerWidth := l.markers()
+	return markerWidth + 2
+}
+
+// markers returns each item's marker and the widest marker's width
+func (l List) markers() ([]string, int) {
+	markers := make([]string, len(l.Items))
+	width := 0
+	for i := range l.Items {
+		switch {
+		case l.Numbered:
+			markers[i] = strconv.Itoa(i+1) + "."
+		case l.Marker != "":
+			markers[i] = l.Marker
+		default:
+			markers[i] = "•"
+		}
+		width = max(width, measure.Width(markers[i]))
+	}
+	return markers, width
+}
@@ -0,0 +1,58 @@
+package tuistyles
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+func TestList_Render(t *testing.T) {
+	tests := []struct {
+		name  string
+		list  List
+		width int
+		want  string
+	}{
+		{"empty", List{}, 0, ""},
+		{"bullets", List{Items: []string{"one", "three"}}, 0, "• one  \n• three"},
+		{"custom marker", List{Items: []string{"a"}, Marker: "->"}, 0, "-> a"},
+		{
+			name:  "numbers right-aligned",
+			list:  List{Items: []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j"}, Numbered: true},
+			want:  " 1. a\n 2. b\n 3. c\n 4. d\n 5. e\n 6. f\n 7. g\n 8. h\n 9. i\n10. j",
+			width: 0,
+		},
+		{
+			name:  "wraps with hanging indent",
+			list:  List{Items: []string{"run the tests and check"}},
+			width: 12,
+			want:  "• run the   \n  tests and \n  check     ",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, measure.StripANSI(tt.list.Render(tt.width)))
+		})
+	}
+}
+
+func TestList_RowStyle(t *testing.T) {
+	selected := NewStyle().Reverse(true)
+	list := List{
+		Items: []string{"a", "bb", "c"},
+		RowStyle: func(i int, _ string) Style {
+			if i == 1 {
+				return selected
+			}
+			return NewStyle()
+		},
+	}
+
+	lines := strings.Split(list.Render(0), "\n")
+	require.Contains(t, lines[1], selected.Width(2).Align(Left).Render("bb"))
+	require.NotContains(t, lines[0], "\x1b[7m")
+}
@@ -0,0 +1,274 @@
+package markdown
+
+import (
+	"strings"
+
+	tuistyles "github.com/orchard9/tui-styles"
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+// run is a piece of inline text with the styles to apply, innermost first,
+// and an optional link target
+type run struct {
+	text   string
+	styles []tuistyles.Style
+	url    string
+}
+
+// inlineParser turns inline markdown into runs
+type inlineParser struct {
+	theme      Theme
+	hyperlinks bool
+
+	runs   []run
+	buf    strings.Builder
+	bold   bool
+	italic bool
+}
+
+// parseInline parses emphasis, code spans, and links in text
+func parseInline(text string, theme Theme, hyperlinks bool) []run {
+	p := &inlineParser{theme: theme, hyperlinks: hyperlinks}
+	p.parse(text)
+	p.flush()
+	return p.runs
+}
+
+// parse scans text, emitting a run whenever the active styling changes
+func (p *inlineParser) parse(s string) {
+	for i := 0; i < len(s); {
+		c := s[i]
+
+		switch {
+		case c == '\\' && i+1 < len(s) && isPunct(s[i+1]):
+			p.buf.WriteByte(s[i+1])
+			i += 2
+			continue
+
+		case c == '`':
+			if end := strings.IndexByte(s[i+1:], '`'); end >= 0 {
+				p.flush()
+				p.emit(s[i+1:i+1+end], p.theme.Code, "")
+				i += end + 2
+				continue
+			}
+
+		case (c == '*' || c == '_') && i+1 < len(s) && s[i+1] == c:
+			marker := s[i : i+2]
+			if p.bold || strings.Contains(s[i+2:], marker) {
+				p.flush()
+				p.bold = !p.bold
+				i += 2
+				continue
+			}
+
+		case c == '*' || c == '_':
+			if p.toggleItalic(s, i) {
+				i++
+				continue
+			}
+
+		case c == '[':
+			if n := p.link(s[i:]); n > 0 {
+				i += n
+				continue
+			}
+
+		case c == '<':
+			if n := p.autolink(s[i:]); n > 0 {
+				i += n
+				continue
+			}
+		}
+
+		p.buf.WriteByte(c)
+		i++
+	}
+}
+
+// toggleItalic opens or closes emphasis at s[i] if the marker is in a valid
+// position. Underscores only count at word boundaries so snake_case survives.
+func (p *inlineParser) toggleItalic(s string, i int) bool {
+	c := s[i]
+	if p.italic {
+		if c == '_' && i+1 < len(s) && isWordByte(s[i+1]) {
+			return false
+		}
+	} else {
+		if i+1 >= len(s) || s[i+1] == ' ' || strings.IndexByte(s[i+1:], c) < 0 {
+			return false
+		}
+		if c == '_' && i > 0 && isWordByte(s[i-1]) {
+			return false
+		}
+	}
+
+	p.flush()
+	p.italic = !p.italic
+	return true
+}
+
+// link parses [text](url) at the start of s, returning the bytes consumed
+// (0 if s does not start with a link)
+func (p *inlineParser) link(s string) int {
+	closeText := strings.Index(s, "](")
+	if closeText < 0 {
+		return 0
+	}
+	closeURL := strings.IndexByte(s[closeText+2:], ')')
+	if closeURL < 0 {
+		return 0
+	}
+
+	text := s[1:closeText]
+	url := strings.TrimSpace(s[closeText+2 : closeText+2+closeURL])
+	p.emitLink(text, url)
+	return closeText + 2 + closeURL + 1
+}
+
+// autolink parses <https://...> at the start of s
+func (p *inlineParser) autolink(s string) int {
+	end := strings.IndexByte(s, '>')
+	if end < 0 {
+		return 0
+	}
+	url := s[1:end]
+	if !strings.HasPrefix(url, "http://") && !strings.HasPrefix(url, "https://") || strings.ContainsAny(url, " \t") {
+		return 0
+	}
+
+	p.emitLink(url, url)
+	return end + 1
+}
+
+// emitLink adds link text, spelling out the target when hyperlinks are off
+func (p *inlineParser) emitLink(text, url string) {
+	p.flush()
+	if p.hyperlinks {
+		p.emit(text, p.theme.Link, url)
+		return
+	}
+
+	p.emit(text, p.theme.Link, "")
+	if url != text {
+		p.runs = append(p.runs, run{text: " (" + url + ")", styles: p.active()})
+	}
+}
+
+// emit adds a run of text in style plus the active emphasis
+func (p *inlineParser) emit(text string, style tuistyles.Style, url string) {
+	if text == "" {
+		return
+	}
+	styles := append([]tuistyles.Style{style}, p.active()...)
+	p.runs = append(p.runs, run{text: text, styles: styles, url: url})
+}
+
+// flush turns buffered plain text into a run with the active emphasis
+func (p *inlineParser) flush() {
+	if p.buf.Len() == 0 {
+		return
+	}
+	p.runs = append(p.runs, run{text: p.buf.String(), styles: p.active()})
+	p.buf.Reset()
+}
+
+// active returns the emphasis styles currently in effect
+func (p *inlineParser) active() []tuistyles.Style {
+	var styles []tuistyles.Style
+	if p.italic {
+		styles = append(styles, p.theme.Italic)
+	}
+	if p.bold {
+		styles = append(styles, p.theme.Bold)
+	}
+	return styles
+}
+
+// withStyle returns runs with outer added as their outermost style
+func withStyle(runs []run, outer tuistyles.Style) []run {
+	styled := make([]run, len(runs))
+	for i, r := range runs {
+		styles := make([]tuistyles.Style, 0, len(r.styles)+1)
+		styles = append(styles, r.styles...)
+		styled[i] = run{text: r.text, styles: append(styles, outer), url: r.url}
+	}
+	return styled
+}
+
+// wrapRuns word-wraps runs to width cells (0 for no wrapping)
+func wrapRuns(runs []run, width int) [][]run {
+	plain := plainText(runs)
+	if width <= 0 || measure.Width(plain) <= width {
+		return [][]run{runs}
+	}
+
+	var lines [][]run
+	col := 0
+	for _, line := range strings.Split(tuistyles.Wrap(plain, width), "\n") {
+		w := measure.Width(line)
+		lines = append(lines, sliceRuns(runs, col, col+w))
+		col += w
+
+		// Wrap drops the single space at each soft break
+		if measure.SliceCells(plain, col, col+1) == " " {
+			col++
+		}
+	}
+	return lines
+}
+
+// sliceRuns returns the cells [from, to) of runs
+func sliceRuns(runs []run, from, to int) []run {
+	var result []run
+	col := 0
+	for _, r := range runs {
+		w := measure.Width(r.text)
+		start, end := col, col+w
+		col = end
+
+		if end <= from || w == 0 {
+			continue
+		}
+		if start >= to {
+			break
+		}
+
+		if text := measure.SliceCells(r.text, from-start, to-start); text != "" {
+			result = append(result, run{text: text, styles: r.styles, url: r.url})
+		}
+	}
+	return result
+}
+
+// renderRuns applies each run's styles and link
+func renderRuns(runs []run) string {
+	var b strings.Builder
+	for _, r := range runs {
+		text := r.text
+		for _, style := range r.styles {
+			text = style.Render(text)
+		}
+		b.WriteString(tuistyles.Hyperlink(r.url, text))
+	}
+	return b.String()
+}
+
+// plainText concatenates the text of runs
+func plainText(runs []run) string {
+	var b strings.Builder
+	for _, r := range runs {
+		b.WriteString(r.text)
+	}
+	return b.String()
+}
+
+// isPunct reports whether c is ASCII punctuation that a backslash can escape
+func isPunct(c byte) bool {
+	return strings.IndexByte("!\"#$%&'()*+,-./:;<=>?@[\\]^_`{|}~", c) >= 0
+}
+
+// isWordByte reports whether c is an ASCII letter or digit
+func isWordByte(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+}
@@ -0,0 +1,264 @@
+// Package markdown renders a practical subset of Markdown as styled terminal
+// text using tuistyles primitives.
+//
+// Supported: ATX headings, paragraphs, **bold**, *italic*, `code`, fenced
+// code blocks (highlighted for languages the syntax package knows), bullet
+// and numbered lists (nested by indentation), blockquotes, horizontal
+// rules, and links, which become clickable OSC 8 hyperlinks. Anything else
+// is rendered as plain text.
+//
+// Example:
+//
+//	fmt.Println(markdown.Render("# Title\n\nSome **bold** text.", 80))
+package markdown
+
+import (
+	"regexp"
+	"strings"
+
+	tuistyles "github.com/orchard9/tui-styles"
+	"github.com/orchard9/tui-styles/internal/measure"
+	"github.com/orchard9/tui-styles/syntax"
+)
+
+// Render renders src with the default theme, wrapping text to width cells
+// (0 for no wrapping).
+func Render(src string, width int) string {
+	return New().Width(width).Render(src)
+}
+
+// Renderer renders markdown with a configurable theme and width.
+//
+// Like tuistyles.Style, Renderer is immutable: builder methods return a new
+// Renderer, so one can be shared freely between goroutines.
+type Renderer struct {
+	theme      Theme
+	width      int
+	hyperlinks bool
+}
+
+// New returns a Renderer with DefaultTheme, no wrapping, and hyperlinks on.
+func New() Renderer {
+	return Renderer{theme: DefaultTheme(), hyperlinks: true}
+}
+
+// Theme sets the styles used for each element.
+//
+// Returns a new Renderer, leaving the original unchanged.
+func (r Renderer) Theme(t Theme) Renderer {
+	r2 := r
+	r2.theme = t
+	return r2
+}
+
+// Width sets the wrap width in cells; 0 or less disables wrapping.
+//
+// Returns a new Renderer, leaving the original unchanged.
+func (r Renderer) Width(w int) Renderer {
+	r2 := r
+	r2.width = max(w, 0)
+	return r2
+}
+
+// Hyperlinks controls whether links become OSC 8 hyperlinks. When off, the
+// target is written after the link text in parentheses instead.
+//
+// Returns a new Renderer, leaving the original unchanged.
+func (r Renderer) Hyperlinks(v bool) Renderer {
+	r2 := r
+	r2.hyperlinks = v
+	return r2
+}
+
+// Render renders src, separating blocks with a blank line.
+func (r Renderer) Render(src string) string {
+	src = strings.ReplaceAll(src, "\r\n", "\n")
+	src = strings.ReplaceAll(src, "\t", "    ")
+	return strings.Join(r.renderBlocks(strings.Split(src, "\n"), r.width), "\n\n")
+}
+
+var (
+	headingRegex  = regexp.MustCompile(`^ {0,3}(#{1,6})(?:\s+(.*?))?(?:\s+#+)?\s*$`)
+	ruleRegex     = regexp.MustCompile(`^ {0,3}(?:(?:-\s*){3,}|(?:\*\s*){3,}|(?:_\s*){3,})$`)
+	listItemRegex = regexp.MustCompile(`^(\s*)([-*+]|\d{1,9}[.)])\s+(.*)$`)
+	fenceRegex    = regexp.MustCompile("^ {0,3}(```+|~~~+)\\s*(\\S*)")
+	quoteRegex    = regexp.MustCompile(`^ {0,3}> ?(.*)$`)
+)
+
+// renderBlocks renders each block in lines to fit width
+func (r Renderer) renderBlocks(lines []string, width int) []string {
+	var blocks []string
+
+	for i := 0; i < len(lines); {
+		line := lines[i]
+
+		switch {
+		case strings.TrimSpace(line) == "":
+			i++
+
+		case fenceRegex.MatchString(line):
+			m := fenceRegex.FindStringSubmatch(line)
+			fence := m[1]
+			var code []string
+			i++
+			for i < len(lines) && !strings.HasPrefix(strings.TrimSpace(lines[i]), fence) {
+				code = append(code, lines[i])
+				i++
+			}
+			i++ // closing fence
+			blocks = append(blocks, r.renderCode(code, m[2], width))
+
+		case headingRegex.MatchString(line):
+			m := headingRegex.FindStringSubmatch(line)
+			blocks = append(blocks, r.renderHeading(len(m[1]), m[2], width))
+			i++
+
+		case ruleRegex.MatchString(line):
+			blocks = append(blocks, r.renderRule(width))
+			i++
+
+		case quoteRegex.MatchString(line):
+			var quoted []string
+			for i < len(lines) && quoteRegex.MatchString(lines[i]) {
+				quoted = append(quoted, quoteRegex.FindStringSubmatch(lines[i])[1])
+				i++
+			}
+			blocks = append(blocks, r.renderQuote(quoted, width))
+
+		case listItemRegex.MatchString(line):
+			var items []string
+			for i < len(lines) && strings.TrimSpace(lines[i]) != "" {
+				if listItemRegex.MatchString(lines[i]) || len(items) == 0 {
+					items = append(items, lines[i])
+				} else if startsBlock(lines[i]) {
+					break
+				} else {
+					// Continuation line: fold into the previous item
+					items[len(items)-1] += " " + strings.TrimSpace(lines[i])
+				}
+				i++
+			}
+			blocks = append(blocks, r.renderList(items, width))
+
+		default:
+			var para []string
+			for i < len(lines) && strings.TrimSpace(lines[i]) != "" && (len(para) == 0 || !startsBlock(lines[i])) {
+				para = append(para, strings.TrimSpace(lines[i]))
+				i++
+			}
+			blocks = append(blocks, r.renderText(strings.Join(para, " "), width, nil))
+		}
+	}
+
+	return blocks
+}
+
+// startsBlock reports whether line begins a block other than a paragraph
+func startsBlock(line string) bool {
+	return fenceRegex.MatchString(line) ||
+		headingRegex.MatchString(line) ||
+		ruleRegex.MatchString(line) ||
+		quoteRegex.MatchString(line) ||
+		listItemRegex.MatchString(line)
+}
+
+// renderText renders inline markdown wrapped to width, adding outer (if not
+// nil) around every piece
+func (r Renderer) renderText(text string, width int, outer *tuistyles.Style) string {
+	runs := parseInline(text, r.theme, r.hyperlinks)
+	if outer != nil {
+		runs = withStyle(runs, *outer)
+	}
+
+	wrapped := wrapRuns(runs, width)
+	lines := make([]string, len(wrapped))
+	for i, line := range wrapped {
+		lines[i] = renderRuns(line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// renderHeading renders a heading of the given level (1-6)
+func (r Renderer) renderHeading(level int, text string, width int) string {
+	style := r.theme.Heading
+	switch level {
+	case 1:
+		style = r.theme.Heading1
+	case 2:
+		style = r.theme.Heading2
+	}
+	return r.renderText(text, width, &style)
+}
+
+// renderCode renders a code block as a solid rectangle, highlighted when
+// lang names a known language. Lines are never wrapped; lines too long for
+// width are truncated.
+func (r Renderer) renderCode(code []string, lang string, width int) string {
+	theme := r.theme.Syntax
+	if theme == nil {
+		theme = syntax.Theme{}
+	}
+
+	return syntax.Block{
+		Code:     strings.Join(code, "\n"),
+		Language: lang,
+		Theme:    theme,
+		Frame:    &r.theme.CodeBlock,
+	}.Render(width)
+}
+
+// renderQuote renders quoted lines as nested blocks inside the Quote style
+func (r Renderer) renderQuote(quoted []string, width int) string {
+	inner := width
+	if width > 0 {
+		inner = max(width-frameWidth(r.theme.Quote), 1)
+	}
+	return r.theme.Quote.Render(strings.Join(r.renderBlocks(quoted, inner), "\n\n"))
+}
+
+// renderList renders list items with hanging indents. Two spaces of
+// indentation nest an item one level deeper.
+func (r Renderer) renderList(items []string, width int) string {
+	lines := make([]string, 0, len(items))
+
+	for _, item := range items {
+		m := listItemRegex.FindStringSubmatch(item)
+		indent := len(m[1]) / 2 * 2
+		marker := m[2]
+		if marker == "-" || marker == "*" || marker == "+" {
+			marker = "•"
+		}
+
+		hang := indent + measure.Width(marker) + 1
+		text := width
+		if width > 0 {
+			text = max(width-hang, 1)
+		}
+
+		body := strings.Split(r.renderText(m[3], text, nil), "\n")
+		for j, line := range body {
+			if j == 0 {
+				line = strings.Repeat(" ", indent) + r.theme.Bullet.Render(marker) + " " + line
+			} else {
+				line = strings.Repeat(" ", hang) + line
+			}
+			lines = append(lines, line)
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// renderRule renders a horizontal rule across width (or 40 cells when unwrapped)
+func (r Renderer) renderRule(width int) string {
+	if width <= 0 {
+		width = 40
+	}
+	return r.theme.Rule.Render(strings.Repeat("─", width))
+}
+
+// frameWidth returns the horizontal cells a block style adds around content
+// (padding and borders)
+func frameWidth(s tuistyles.Style) int {
+	return measure.MaxWidth(s.Render(" ")) - 1
+}
@@ -0,0 +1,128 @@
+package markdown
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tuistyles "github.com/orchard9/tui-styles"
+	"github.com/orchard9/tui-styles/internal/measure"
+	"github.com/orchard9/tui-styles/syntax"
+)
+
+// plain renders src and strips styling and trailing spaces for layout checks
+func plain(r Renderer, src string) string {
+	lines := strings.Split(measure.StripANSI(r.Render(src)), "\n")
+	for i := range lines {
+		lines[i] = strings.TrimRight(lines[i], " ")
+	}
+	return strings.Join(lines, "\n")
+}
+
+func TestRender_Blocks(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		width int
+		want  string
+	}{
+		{"heading", "## Install ##", 0, "Install"},
+		{"paragraph joins lines", "one\ntwo", 0, "one two"},
+		{"paragraph wraps", "the quick brown fox jumps", 10, "the quick\nbrown fox\njumps"},
+		{"blocks separated by blank line", "# A\n\n\n\nbody", 0, "A\n\nbody"},
+		{"bullets", "- one\n* two\n+ three", 0, "• one\n• two\n• three"},
+		{"numbered", "1. one\n2. two", 0, "1. one\n2. two"},
+		{"nested list", "- a\n  - b", 0, "• a\n  • b"},
+		{"hanging indent", "- alpha beta gamma", 10, "• alpha\n  beta\n  gamma"},
+		{"list continuation", "- alpha\n  beta", 0, "• alpha beta"},
+		{"quote", "> quoted\n> text", 0, "┃ quoted text"},
+		{"nested quote blocks", "> # Title\n>\n> body", 0, "┃ Title\n┃\n┃ body"},
+		{"rule", "---", 5, "─────"},
+		{"code block", "```go\nx := 1\n```", 0, " x := 1"},
+		{"unterminated fence runs to end", "```\ncode", 0, " code"},
+		{"escaped marker", `\*not italic\*`, 0, "*not italic*"},
+		{"snake case", "a snake_case_name", 0, "a snake_case_name"},
+		{"unclosed emphasis is literal", "2 * 3", 0, "2 * 3"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := plain(New().Width(tt.width), tt.input)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRender_InlineStyles(t *testing.T) {
+	theme := DefaultTheme()
+	got := Render("**b** *i* `c`", 0)
+
+	require.Contains(t, got, theme.Bold.Render("b"))
+	require.Contains(t, got, theme.Italic.Render("i"))
+	require.Contains(t, got, theme.Code.Render("c"))
+}
+
+func TestRender_NestedEmphasis(t *testing.T) {
+	got := Render("**bold *both* bold**", 0)
+
+	require.Equal(t, "bold both bold", measure.StripANSI(got))
+	require.Contains(t, got, DefaultTheme().Bold.Render(DefaultTheme().Italic.Render("both")))
+}
+
+func TestRender_Links(t *testing.T) {
+	got := Render("see [docs](https://example.com)", 0)
+	require.Contains(t, got, "\x1b]8;;https://example.com\x1b\\")
+	require.Equal(t, "see docs", measure.StripANSI(got))
+
+	got = New().Hyperlinks(false).Render("see [docs](https://example.com)")
+	require.NotContains(t, got, "\x1b]8;;")
+	require.Equal(t, "see docs (https://example.com)", measure.StripANSI(got))
+
+	got = Render("<https://example.com>", 0)
+	require.Contains(t, got, "\x1b]8;;https://example.com\x1b\\")
+}
+
+func TestRender_WidthRespected(t *testing.T) {
+	src := "# A heading that is fairly long\n\n" +
+		"Paragraph with **bold words** and a [link to somewhere](https://example.com) that wraps.\n\n" +
+		"- a list item that is long enough to wrap\n\n" +
+		"> a quote that is also long enough to wrap\n\n" +
+		"```\na code line that is much too long to fit in the block\n```"
+
+	for _, line := range strings.Split(Render(src, 24), "\n") {
+		require.LessOrEqual(t, measure.Width(line), 24, "line %q", line)
+	}
+}
+
+func TestRender_CodeBlockIsSolid(t *testing.T) {
+	got := Render("```\nshort\nmuch longer line\n```", 0)
+
+	widths := measure.WidthPerLine(got)
+	require.Len(t, widths, 2)
+	require.Equal(t, widths[0], widths[1], "code lines should be padded to the same width")
+}
+
+func TestThemeFrom(t *testing.T) {
+	theme := ThemeFrom(tuistyles.Theme{Primary: tuistyles.Color("#7C3AED")})
+	got := New().Theme(theme).Render("# Title")
+
+	require.Contains(t, got, strings.TrimPrefix(tuistyles.Color("#7C3AED").ToANSI(), "\x1b["))
+}
+
+func TestRenderer_Immutable(t *testing.T) {
+	r := New()
+	_ = r.Width(10).Hyperlinks(false)
+
+	require.Equal(t, "see docs", measure.StripANSI(r.Render("see [docs](https://x.io)")))
+}
+
+func TestRender_HighlightsKnownLanguages(t *testing.T) {
+	theme := DefaultTheme()
+	got := New().Theme(theme).Render("```go\nreturn nil\n```")
+	require.Contains(t, got, theme.Syntax[syntax.Keyword].Render("return"))
+
+	theme.Syntax = nil
+	got = New().Theme(theme).Render("```go\nreturn nil\n```")
+	require.NotContains(t, got, DefaultTheme().Syntax[syntax.Keyword].Render("return"))
+}
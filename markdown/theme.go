@@ -0,0 +1,62 @@
+package markdown
+
+import (
+	tuistyles "github.com/orchard9/tui-styles"
+	"github.com/orchard9/tui-styles/syntax"
+)
+
+// Theme holds the styles used for each markdown element.
+//
+// Inline styles (headings, Bold, Italic, Code, Link, Bullet) should only set
+// text attributes and colors; they are applied word by word after wrapping.
+// Block styles (CodeBlock, Quote, Rule) may also use padding and borders, and
+// their frame is subtracted from the available width before wrapping.
+type Theme struct {
+	Heading1  tuistyles.Style // # headings
+	Heading2  tuistyles.Style // ## headings
+	Heading   tuistyles.Style // ### to ###### headings
+	Bold      tuistyles.Style // **strong** text
+	Italic    tuistyles.Style // *emphasized* text
+	Code      tuistyles.Style // `inline code`
+	CodeBlock tuistyles.Style // Fenced code blocks, drawn as a solid block
+	Link      tuistyles.Style // Link text
+	Quote     tuistyles.Style // Box drawn around blockquotes
+	Bullet    tuistyles.Style // List markers
+	Rule      tuistyles.Style // Horizontal rules
+	Syntax    syntax.Theme    // Highlighting for fenced code in known languages; empty to disable
+}
+
+// DefaultTheme returns the markdown theme built from tuistyles.DefaultTheme.
+func DefaultTheme() Theme {
+	return ThemeFrom(tuistyles.DefaultTheme())
+}
+
+// ThemeFrom builds a markdown theme from a palette of semantic colors, so
+// rendered documents match the rest of an application's components. Empty
+// palette colors fall back to tuistyles.DefaultTheme.
+//
+// Example:
+//
+//	theme := markdown.ThemeFrom(tuistyles.Theme{Primary: tuistyles.Color("#7C3AED")})
+func ThemeFrom(palette tuistyles.Theme) Theme {
+	p := palette.WithDefaults()
+	surface := tuistyles.AdaptiveColor{Light: tuistyles.Color("254"), Dark: tuistyles.Color("236")}.ToColor()
+
+	return Theme{
+		Heading1:  tuistyles.NewStyle().Bold(true).Underline(true).Foreground(p.Primary),
+		Heading2:  tuistyles.NewStyle().Bold(true).Foreground(p.Primary),
+		Heading:   tuistyles.NewStyle().Bold(true),
+		Bold:      tuistyles.NewStyle().Bold(true),
+		Italic:    tuistyles.NewStyle().Italic(true),
+		Code:      tuistyles.NewStyle().Foreground(p.Primary).Background(surface),
+		CodeBlock: tuistyles.NewStyle().Background(surface).Padding(0, 1),
+		Link:      tuistyles.NewStyle().Underline(true).Foreground(p.Info),
+		Quote: tuistyles.NewStyle().
+			Border(tuistyles.ThickBorder(), false, false, false, true).
+			BorderForeground(p.Muted).
+			PaddingLeft(1),
+		Bullet: tuistyles.NewStyle().Foreground(p.Primary),
+		Rule:   tuistyles.NewStyle().Foreground(p.Border),
+		Syntax: syntax.ThemeFrom(p),
+	}
+}
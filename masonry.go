@@ -0,0 +1,73 @@
+package tuistyles
+
+import (
+	"strings"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+// Masonry packs rendered cards of uneven height into columns, like a
+// dashboard of panels: as many columns as fit in width (each as wide as
+// the widest card), with each card in turn going to the column that is
+// currently shortest, so columns end up close to the same height. Columns
+// and the cards within them are separated by gap cells and gap lines.
+//
+// Cards keep their order reading down each column's top first. A width of
+// 0 or less stacks every card in one column.
+//
+// Example:
+//
+//	fmt.Println(Masonry(120, 1, cpuPanel, memPanel, logPanel, alertsPanel))
+func Masonry(width, gap int, cards ...string) string {
+	if len(cards) == 0 {
+		return ""
+	}
+	gap = max(gap, 0)
+
+	cardWidth := 0
+	for _, card := range cards {
+		cardWidth = max(cardWidth, measure.MaxWidth(card))
+	}
+	cols := 1
+	if width > 0 {
+		cols = min(max((width+gap)/(cardWidth+gap), 1), len(cards))
+	}
+
+	columns := make([][]string, cols)
+	heights := make([]int, cols)
+	for _, card := range cards {
+		shortest := 0
+		for c, h := range heights {
+			if h < heights[shortest] {
+				shortest = c
+			}
+		}
+		if len(columns[shortest]) > 0 {
+			heights[shortest] += gap
+		}
+		columns[shortest] = append(columns[shortest], card)
+		heights[shortest] += Height(card)
+	}
+
+	blank := strings.Repeat(" ", cardWidth)
+	spacer := strings.Repeat(" ", gap)
+	blocks := make([]string, 0, 2*cols-1)
+	for c, column := range columns {
+		var lines []string
+		for i, card := range column {
+			if i > 0 {
+				for range gap {
+					lines = append(lines, blank)
+				}
+			}
+			for _, line := range strings.Split(card, "\n") {
+				lines = append(lines, line+strings.Repeat(" ", cardWidth-measure.Width(line)))
+			}
+		}
+		if c > 0 && gap > 0 {
+			blocks = append(blocks, spacer)
+		}
+		blocks = append(blocks, strings.Join(lines, "\n"))
+	}
+	return JoinHorizontal(Top, blocks...)
+}
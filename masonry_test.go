@@ -0,0 +1,61 @@
+package tuistyles
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMasonry(t *testing.T) {
+	tall := "A\nA\nA"
+	short := "B"
+	mid := "C\nC"
+
+	tests := []struct {
+		name  string
+		width int
+		gap   int
+		cards []string
+		want  string
+	}{
+		{"empty", 80, 1, nil, ""},
+		{"one column without width", 0, 0, []string{short, mid}, "B\nC\nC"},
+		{
+			name:  "shortest column gets the next card",
+			width: 3, gap: 1,
+			cards: []string{tall, short, mid},
+			want:  "A B\nA  \nA C\n  C",
+		},
+		{
+			name:  "no gap",
+			width: 2, gap: 0,
+			cards: []string{tall, short, mid, short},
+			want:  "AB\nAC\nAC\nB ",
+		},
+		{
+			name:  "cards padded to widest",
+			width: 20, gap: 1,
+			cards: []string{"xx", "y"},
+			want:  "xx y ",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, Masonry(tt.width, tt.gap, tt.cards...))
+		})
+	}
+}
+
+func TestMasonry_FitsWidth(t *testing.T) {
+	box := NewStyle().Border(RoundedBorder()).Width(10).Align(Left)
+	cards := []string{box.Render("a"), box.Render("b\nb\nb"), box.Render("c\nc"), box.Render("d")}
+
+	got := Masonry(40, 2, cards...)
+	for _, line := range strings.Split(got, "\n") {
+		require.LessOrEqual(t, Width(line), 40)
+	}
+	require.Equal(t, 3*12+2*2, Width(got))
+	require.Equal(t, 3+2+3, Height(got), "d stacks under a, the shortest column")
+}
@@ -0,0 +1,186 @@
+package tuistyles
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+// MenuItem is one entry of a Menu
+type MenuItem struct {
+	Label    string
+	Disabled bool // Drawn muted; Menu.Move skips it
+}
+
+// Menu renders a list of choices with a cursor beside the selected one,
+// a page at a time when there are more than fit:
+//
+//	  Open
+//	❯ Save
+//	  Save as…
+//	  ● ○ ○
+//
+// Menu only draws; the caller owns the input loop and keeps Selected up to
+// date, for example with Move.
+//
+// Example:
+//
+//	menu := Menu{Items: []MenuItem{{Label: "Open"}, {Label: "Save"}, {Label: "Close", Disabled: true}}}
+//	for key := range keys {
+//	    switch key {
+//	    case "up":
+//	        menu = menu.Move(-1)
+//	    case "down":
+//	        menu = menu.Move(1)
+//	    }
+//	    fmt.Print(menu.Render(30))
+//	}
+type Menu struct {
+	Items    []MenuItem
+	Selected int    // Index of the item under the cursor
+	Height   int    // Items per page; every item on one page when 0 or less
+	Cursor   string // Before the selected item; "❯" by default
+	Theme    Theme
+
+	CursorStyle   Style // Bold primary by default
+	SelectedStyle Style // Bold primary by default
+	ItemStyle     Style // Unstyled by default
+	DisabledStyle Style // Faint muted by default
+	PagerStyle    Style // Muted by default; the current page's dot is Primary
+}
+
+// maxPagerDots is the most pages shown as dots; beyond it the pager reads
+// "page/pages"
+const maxPagerDots = 10
+
+// Render draws the page holding the selected item within width cells,
+// truncating labels that do not fit with "…". When the items take more
+// than one page, the page is padded to Height lines and followed by a
+// pager line, so the menu keeps its height as the selection moves. A width
+// of 0 or less never truncates.
+func (m Menu) Render(width int) string {
+	if len(m.Items) == 0 {
+		return ""
+	}
+
+	theme := m.Theme.WithDefaults()
+	cursorStyle := orDefault(m.CursorStyle, NewStyle().Bold(true).Foreground(theme.Primary))
+	selectedStyle := orDefault(m.SelectedStyle, NewStyle().Bold(true).Foreground(theme.Primary))
+	disabledStyle := orDefault(m.DisabledStyle, NewStyle().Faint(true).Foreground(theme.Muted))
+	pagerStyle := orDefault(m.PagerStyle, NewStyle().Foreground(theme.Muted))
+
+	cursor := m.cursor()
+	cursorWidth := measure.Width(cursor)
+	blank := strings.Repeat(" ", cursorWidth)
+	labelWidth := max(width-cursorWidth-1, 1)
+
+	selected := m.selected()
+	page, pages := m.Page()
+	start, end := m.pageBounds(page)
+	var lines []string
+	for i := start; i < end; i++ {
+		item := m.Items[i]
+		label := item.Label
+		if width > 0 {
+			label = truncateKeeping(label, labelWidth, "…", labelWidth, false)
+		}
+
+		style := m.ItemStyle
+		switch {
+		case item.Disabled:
+			style = disabledStyle
+		case i == selected:
+			style = selectedStyle
+		}
+		marker := blank
+		if i == selected {
+			marker = cursorStyle.Render(cursor)
+		}
+		lines = append(lines, marker+" "+style.Render(label))
+	}
+	if pages == 1 {
+		return strings.Join(lines, "\n")
+	}
+
+	for len(lines) < m.Height {
+		lines = append(lines, "")
+	}
+	// Dots when they fit, else "page/pages", truncated if even that does not
+	pager := pagerStyle.Render(fmt.Sprintf("%d/%d", page+1, pages))
+	if pages <= maxPagerDots && (width <= 0 || cursorWidth+2*pages <= width) {
+		dots := make([]string, pages)
+		for p := range dots {
+			dots[p] = pagerStyle.Render("○")
+			if p == page {
+				dots[p] = pagerStyle.Foreground(theme.Primary).Render("●")
+			}
+		}
+		pager = strings.Join(dots, " ")
+	}
+	pagerLine := blank + " " + pager
+	if width > 0 {
+		pagerLine = measure.TruncateStyled(pagerLine, width)
+	}
+	lines = append(lines, pagerLine)
+	return strings.Join(lines, "\n")
+}
+
+// MinWidth returns the width of the cursor and one cell of label.
+func (m Menu) MinWidth() int {
+	if len(m.Items) == 0 {
+		return 0
+	}
+	return measure.Width(m.cursor()) + 2
+}
+
+// Page returns the page holding the selected item and the number of
+// pages, counting from 0.
+func (m Menu) Page() (page, pages int) {
+	if m.Height <= 0 || len(m.Items) == 0 {
+		return 0, 1
+	}
+	return m.selected() / m.Height, (len(m.Items) + m.Height - 1) / m.Height
+}
+
+// Move returns a copy of the menu with the selection moved by delta
+// enabled items: down for a positive delta, up for a negative one. The
+// selection stops at the first or last enabled item rather than wrapping
+// around.
+func (m Menu) Move(delta int) Menu {
+	step := 1
+	if delta < 0 {
+		step, delta = -1, -delta
+	}
+	selected := m.selected()
+	for i := selected + step; delta > 0 && i >= 0 && i < len(m.Items); i += step {
+		if !m.Items[i].Disabled {
+			selected = i
+			delta--
+		}
+	}
+	m.Selected = selected
+	return m
+}
+
+// cursor returns the cursor glyph, "❯" when none is set
+func (m Menu) cursor() string {
+	if m.Cursor == "" {
+		return "❯"
+	}
+	return m.Cursor
+}
+
+// selected returns Selected clamped to the items
+func (m Menu) selected() int {
+	return min(max(m.Selected, 0), len(m.Items)-1)
+}
+
+// pageBounds returns the items on page: from start up to end
+func (m Menu) pageBounds(page int) (start, end int) {
+	if m.Height <= 0 {
+		return 0, len(m.Items)
+	}
+	start = page * m.Height
+	return start, min(start+m.Height, len(m.Items))
+}
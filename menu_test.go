@@ -0,0 +1,106 @@
+package tuistyles
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+func menuItems(labels ...string) []MenuItem {
+	items := make([]MenuItem, len(labels))
+	for i, label := range labels {
+		items[i] = MenuItem{Label: label}
+	}
+	return items
+}
+
+func TestMenu_Render(t *testing.T) {
+	tests := []struct {
+		name  string
+		menu  Menu
+		width int
+		want  string
+	}{
+		{"empty", Menu{}, 0, ""},
+		{"cursor", Menu{Items: menuItems("Open", "Save"), Selected: 1}, 0, "  Open\n❯ Save"},
+		{"custom cursor", Menu{Items: menuItems("a", "b"), Cursor: "->"}, 0, "-> a\n   b"},
+		{"out of range selection", Menu{Items: menuItems("a", "b"), Selected: 7}, 0, "  a\n❯ b"},
+		{"truncates", Menu{Items: menuItems("Save as PDF")}, 8, "❯ Save …"},
+		{
+			name: "pages",
+			menu: Menu{Items: menuItems("a", "b", "c", "d", "e"), Selected: 4, Height: 2},
+			want: "❯ e\n\n  ○ ○ ●",
+		},
+		{
+			name: "many pages",
+			menu: Menu{Items: menuItems(strings.Split(strings.Repeat("x", 12), "")...), Selected: 3, Height: 1},
+			want: "❯ x\n  4/12",
+		},
+		{
+			name:  "dots too wide",
+			menu:  Menu{Items: menuItems("a", "b", "c", "d", "e", "f"), Height: 1},
+			width: 10,
+			want:  "❯ a\n  1/6",
+		},
+		{
+			name:  "pager truncated",
+			menu:  Menu{Items: menuItems(strings.Split(strings.Repeat("x", 30), "")...), Height: 1},
+			width: 4,
+			want:  "❯ x\n  1/",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.menu.Render(tt.width)
+			require.Equal(t, tt.want, measure.StripANSI(got))
+			if tt.width > 0 {
+				require.LessOrEqual(t, Width(got), tt.width)
+			}
+		})
+	}
+}
+
+func TestMenu_Styles(t *testing.T) {
+	menu := Menu{
+		Items:         []MenuItem{{Label: "Open"}, {Label: "Close", Disabled: true}},
+		SelectedStyle: NewStyle().Reverse(true),
+		DisabledStyle: NewStyle().Strikethrough(true),
+	}
+
+	lines := strings.Split(menu.Render(0), "\n")
+	require.Contains(t, lines[0], NewStyle().Reverse(true).Render("Open"))
+	require.Contains(t, lines[1], NewStyle().Strikethrough(true).Render("Close"))
+}
+
+func TestMenu_Move(t *testing.T) {
+	menu := Menu{Items: []MenuItem{{Label: "a"}, {Label: "b", Disabled: true}, {Label: "c"}, {Label: "d"}}}
+
+	require.Equal(t, 2, menu.Move(1).Selected, "skips disabled items")
+	require.Equal(t, 3, menu.Move(2).Selected)
+	require.Equal(t, 3, menu.Move(9).Selected, "stops at the last item")
+	require.Equal(t, 0, menu.Move(-1).Selected)
+	require.Equal(t, 2, menu.Move(2).Move(-1).Selected)
+	require.Equal(t, 0, menu.Move(2).Move(-2).Selected)
+	require.Equal(t, 0, menu.Selected, "Move returns a copy")
+}
+
+func TestMenu_Page(t *testing.T) {
+	menu := Menu{Items: menuItems("a", "b", "c", "d", "e"), Height: 2, Selected: 2}
+	page, pages := menu.Page()
+	require.Equal(t, 1, page)
+	require.Equal(t, 3, pages)
+
+	page, pages = Menu{Items: menuItems("a")}.Page()
+	require.Equal(t, 0, page)
+	require.Equal(t, 1, pages)
+}
+
+func TestMenu_MinWidth(t *testing.T) {
+	require.Equal(t, 0, Menu{}.MinWidth())
+	require.Equal(t, 3, Menu{Items: menuItems("Open")}.MinWidth())
+	require.Equal(t, 3, Width(Menu{Items: menuItems("Open")}.Render(3)))
+}
@@ -0,0 +1,321 @@
+package tuistyles
+
+import (
+	"strings"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+// MergeBorders fuses the borders of boxes that touch inside a rendered
+// block, so nested and side-by-side boxes share lines instead of drawing
+// them twice:
+//
+//	┌──────────┐
+//	│┌──┐┌────┐│        ┌──┬────┐
+//	││a ││ b  ││   =>   │a │ b  │
+//	│└──┘└────┘│        └──┴────┘
+//	└──────────┘
+//
+// Two adjacent columns fuse when both are box-drawing lines from top to
+// bottom, and two adjacent rows when both are box-drawing lines from edge
+// to edge; the fused lines get the right corners and T-junctions. Borders
+// that touch for only part of their length are left as they are, so give
+// boxes placed side by side the same height. The result is narrower and
+// shorter by the lines removed.
+//
+// Light, heavy, double, and rounded box drawing are recognized; where line
+// weights meet without a matching junction glyph, the light one is used.
+// Each fused line keeps the colors of its first copy.
+//
+// Example:
+//
+//	box := NewStyle().Border(NormalBorder())
+//	inner := JoinHorizontal(Top, box.Render("a "), box.Render(" b  "))
+//	fmt.Println(MergeBorders(box.Render(inner)))
+func MergeBorders(block string) string {
+	grid := parseMergeGrid(block)
+	if len(grid.rows) == 0 {
+		return block
+	}
+
+	for c := 0; c+1 < grid.cols(); {
+		if grid.columnsTouch(c) {
+			grid.mergeColumns(c)
+			continue
+		}
+		c++
+	}
+	for r := 0; r+1 < len(grid.rows); {
+		if grid.rowsTouch(r) {
+			grid.mergeRows(r)
+			continue
+		}
+		r++
+	}
+
+	grid.redrawMerged()
+	return grid.String()
+}
+
+// Directions a box-drawing line leaves its cell in, indexing lineLinks
+const (
+	linkUp = iota
+	linkRight
+	linkDown
+	linkLeft
+)
+
+// lineLinks holds the weight of the line leaving a cell in each direction:
+// 0 for none, then lineLight, lineHeavy, or lineDouble
+type lineLinks [4]uint8
+
+// Line weights
+const (
+	lineLight uint8 = iota + 1
+	lineHeavy
+	lineDouble
+)
+
+// boxGlyphs lists box-drawing glyphs by their links, in preference order:
+// where two glyphs share links the first is drawn
+var boxGlyphs = []struct {
+	glyph string
+	links lineLinks
+}{
+	{"─", lineLinks{0, 1, 0, 1}}, {"│", lineLinks{1, 0, 1, 0}},
+	{"┌", lineLinks{0, 1, 1, 0}}, {"┐", lineLinks{0, 0, 1, 1}},
+	{"└", lineLinks{1, 1, 0, 0}}, {"┘", lineLinks{1, 0, 0, 1}},
+	{"├", lineLinks{1, 1, 1, 0}}, {"┤", lineLinks{1, 0, 1, 1}},
+	{"┬", lineLinks{0, 1, 1, 1}}, {"┴", lineLinks{1, 1, 0, 1}},
+	{"┼", lineLinks{1, 1, 1, 1}},
+	{"╭", lineLinks{0, 1, 1, 0}}, {"╮", lineLinks{0, 0, 1, 1}},
+	{"╰", lineLinks{1, 1, 0, 0}}, {"╯", lineLinks{1, 0, 0, 1}},
+	{"━", lineLinks{0, 2, 0, 2}}, {"┃", lineLinks{2, 0, 2, 0}},
+	{"┏", lineLinks{0, 2, 2, 0}}, {"┓", lineLinks{0, 0, 2, 2}},
+	{"┗", lineLinks{2, 2, 0, 0}}, {"┛", lineLinks{2, 0, 0, 2}},
+	{"┣", lineLinks{2, 2, 2, 0}}, {"┫", lineLinks{2, 0, 2, 2}},
+	{"┳", lineLinks{0, 2, 2, 2}}, {"┻", lineLinks{2, 2, 0, 2}},
+	{"╋", lineLinks{2, 2, 2, 2}},
+	{"═", lineLinks{0, 3, 0, 3}}, {"║", lineLinks{3, 0, 3, 0}},
+	{"╔", lineLinks{0, 3, 3, 0}}, {"╗", lineLinks{0, 0, 3, 3}},
+	{"╚", lineLinks{3, 3, 0, 0}}, {"╝", lineLinks{3, 0, 0, 3}},
+	{"╠", lineLinks{3, 3, 3, 0}}, {"╣", lineLinks{3, 0, 3, 3}},
+	{"╦", lineLinks{0, 3, 3, 3}}, {"╩", lineLinks{3, 3, 0, 3}},
+	{"╬", lineLinks{3, 3, 3, 3}},
+}
+
+// glyphLinks maps each box-drawing glyph to its links
+var glyphLinks = func() map[string]lineLinks {
+	m := make(map[string]lineLinks, len(boxGlyphs))
+	for _, g := range boxGlyphs {
+		m[g.glyph] = g.links
+	}
+	return m
+}()
+
+// linkGlyphs maps links to the preferred glyph drawing them
+var linkGlyphs = func() map[lineLinks]string {
+	m := make(map[lineLinks]string, len(boxGlyphs))
+	for _, g := range boxGlyphs {
+		if _, ok := m[g.links]; !ok {
+			m[g.links] = g.glyph
+		}
+	}
+	return m
+}()
+
+// glyphFor returns the glyph drawing links, falling back to the heaviest
+// and then the lightest uniform weight when the mix has no glyph
+func glyphFor(links lineLinks) (string, bool) {
+	if g, ok := linkGlyphs[links]; ok {
+		return g, true
+	}
+	heaviest := max(links[0], links[1], links[2], links[3])
+	for _, weight := range []uint8{heaviest, lineLight} {
+		uniform := links
+		for d := range uniform {
+			if uniform[d] != 0 {
+				uniform[d] = weight
+			}
+		}
+		if g, ok := linkGlyphs[uniform]; ok {
+			return g, true
+		}
+	}
+	return "", false
+}
+
+// mergeCell is one cell of a rendered block being merged
+type mergeCell struct {
+	escapes string // Escape sequences written before the cell
+	text    string // "" for the second cell of a wide character
+	line    bool   // A box-drawing glyph
+	links   lineLinks
+	merged  bool // Fused from several cells; redrawn at the end
+}
+
+// mergeRow is one line of a block: its cells and trailing escapes
+type mergeRow struct {
+	cells []mergeCell
+	tail  string
+}
+
+// mergeGrid is a rendered block split into cells
+type mergeGrid struct {
+	rows []mergeRow
+}
+
+// parseMergeGrid splits block into cells, padding rows to equal width
+func parseMergeGrid(block string) mergeGrid {
+	var grid mergeGrid
+	width := 0
+	for _, line := range strings.Split(block, "\n") {
+		var row mergeRow
+		var pending strings.Builder
+		for _, seg := range measure.Segments(line) {
+			if seg.Escape {
+				pending.WriteString(seg.Text)
+				continue
+			}
+			measure.EachGrapheme(seg.Text, func(cluster string, w int) bool {
+				links, line := glyphLinks[cluster]
+				row.cells = append(row.cells, mergeCell{escapes: pending.String(), text: cluster, line: line, links: links})
+				pending.Reset()
+				for ; w > 1; w-- {
+					row.cells = append(row.cells, mergeCell{})
+				}
+				return true
+			})
+		}
+		row.tail = pending.String()
+		width = max(width, len(row.cells))
+		grid.rows = append(grid.rows, row)
+	}
+	for i := range grid.rows {
+		for len(grid.rows[i].cells) < width {
+			grid.rows[i].cells = append(grid.rows[i].cells, mergeCell{text: " "})
+		}
+	}
+	return grid
+}
+
+// cols returns the number of columns
+func (g *mergeGrid) cols() int {
+	return len(g.rows[0].cells)
+}
+
+// columnsTouch reports whether columns c and c+1 are both lines from top
+// to bottom
+func (g *mergeGrid) columnsTouch(c int) bool {
+	for _, row := range g.rows {
+		if !row.cells[c].line || !row.cells[c+1].line {
+			return false
+		}
+	}
+	return true
+}
+
+// rowsTouch reports whether rows r and r+1 are both lines from edge to edge
+func (g *mergeGrid) rowsTouch(r int) bool {
+	for c := range g.rows[r].cells {
+		if !g.rows[r].cells[c].line || !g.rows[r+1].cells[c].line {
+			return false
+		}
+	}
+	return true
+}
+
+// mergeColumns fuses column c+1 into column c. The removed cells' escapes
+// move to the cells after them, so styling changes are not lost.
+func (g *mergeGrid) mergeColumns(c int) {
+	for i := range g.rows {
+		row := &g.rows[i]
+		fuse(&row.cells[c], row.cells[c+1])
+		if c+2 < len(row.cells) {
+			row.cells[c+2].escapes = row.cells[c+1].escapes + row.cells[c+2].escapes
+		} else {
+			row.tail = row.cells[c+1].escapes + row.tail
+		}
+		row.cells = append(row.cells[:c+1], row.cells[c+2:]...)
+	}
+}
+
+// mergeRows fuses row r+1 into row r
+func (g *mergeGrid) mergeRows(r int) {
+	for c := range g.rows[r].cells {
+		fuse(&g.rows[r].cells[c], g.rows[r+1].cells[c])
+	}
+	g.rows = append(g.rows[:r+1], g.rows[r+2:]...)
+}
+
+// fuse merges the links of other into cell
+func fuse(cell *mergeCell, other mergeCell) {
+	for d := range cell.links {
+		cell.links[d] = max(cell.links[d], other.links[d])
+	}
+	cell.merged = true
+}
+
+// redrawMerged draws each fused cell with the glyph for its links, keeping
+// only links that continue into a neighboring line
+func (g *mergeGrid) redrawMerged() {
+	neighbor := func(r, c, d int) (lineLinks, bool) {
+		switch d {
+		case linkUp:
+			r--
+		case linkDown:
+			r++
+		case linkLeft:
+			c--
+		case linkRight:
+			c++
+		}
+		if r < 0 || r >= len(g.rows) || c < 0 || c >= len(g.rows[r].cells) || !g.rows[r].cells[c].line {
+			return lineLinks{}, false
+		}
+		return g.rows[r].cells[c].links, true
+	}
+
+	redrawn := make([][]lineLinks, len(g.rows))
+	for r, row := range g.rows {
+		redrawn[r] = make([]lineLinks, len(row.cells))
+		for c, cell := range row.cells {
+			links := cell.links
+			if cell.merged {
+				for d := range links {
+					if next, ok := neighbor(r, c, d); !ok || next[(d+2)%4] == 0 {
+						links[d] = 0
+					}
+				}
+			}
+			redrawn[r][c] = links
+		}
+	}
+
+	for r := range g.rows {
+		for c := range g.rows[r].cells {
+			cell := &g.rows[r].cells[c]
+			if !cell.merged || redrawn[r][c] == glyphLinks[cell.text] {
+				continue
+			}
+			if glyph, ok := glyphFor(redrawn[r][c]); ok {
+				cell.text = glyph
+			}
+		}
+	}
+}
+
+// String joins the grid back into a block
+func (g *mergeGrid) String() string {
+	lines := make([]string, len(g.rows))
+	for i, row := range g.rows {
+		var b strings.Builder
+		for _, cell := range row.cells {
+			b.WriteString(cell.escapes)
+			b.WriteString(cell.text)
+		}
+		b.WriteString(row.tail)
+		lines[i] = b.String()
+	}
+	return strings.Join(lines, "\n")
+}
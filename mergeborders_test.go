@@ -0,0 +1,141 @@
+package tuistyles
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeBorders(t *testing.T) {
+	tests := []struct {
+		name  string
+		block []string
+		want  []string
+	}{
+		{
+			name: "nested box",
+			block: []string{
+				"┌───┐",
+				"│┌─┐│",
+				"││x││",
+				"│└─┘│",
+				"└───┘",
+			},
+			want: []string{
+				"┌─┐",
+				"│x│",
+				"└─┘",
+			},
+		},
+		{
+			name: "side by side",
+			block: []string{
+				"┌────────┐",
+				"│┌──┐┌──┐│",
+				"││a ││b ││",
+				"│└──┘└──┘│",
+				"└────────┘",
+			},
+			want: []string{
+				"┌──┬──┐",
+				"│a │b │",
+				"└──┴──┘",
+			},
+		},
+		{
+			name: "stacked",
+			block: []string{
+				"┌──┐",
+				"│a │",
+				"└──┘",
+				"┌──┐",
+				"│b │",
+				"└──┘",
+			},
+			want: []string{
+				"┌──┐",
+				"│a │",
+				"├──┤",
+				"│b │",
+				"└──┘",
+			},
+		},
+		{
+			name: "rounded corners kept",
+			block: []string{
+				"╭──╮╭──╮",
+				"│a ││b │",
+				"╰──╯╰──╯",
+			},
+			want: []string{
+				"╭──┬──╮",
+				"│a │b │",
+				"╰──┴──╯",
+			},
+		},
+		{
+			name: "heavy meets light",
+			block: []string{
+				"┏━━┓┌──┐",
+				"┃a ┃│b │",
+				"┗━━┛└──┘",
+			},
+			want: []string{
+				"┏━━┳──┐",
+				"┃a ┃b │",
+				"┗━━┻──┘",
+			},
+		},
+		{
+			name: "partial contact left alone",
+			block: []string{
+				"┌─┐┌─┐",
+				"│a││b│",
+				"└─┘│ │",
+				"   └─┘",
+			},
+			want: []string{
+				"┌─┐┌─┐",
+				"│a││b│",
+				"└─┘│ │",
+				"   └─┘",
+			},
+		},
+		{
+			name:  "padded box untouched",
+			block: []string{"┌───┐", "│ x │", "└───┘"},
+			want:  []string{"┌───┐", "│ x │", "└───┘"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := MergeBorders(strings.Join(tt.block, "\n"))
+			require.Equal(t, strings.Join(tt.want, "\n"), got)
+		})
+	}
+}
+
+func TestMergeBorders_RenderedBoxes(t *testing.T) {
+	box := NewStyle().Border(NormalBorder())
+	inner := JoinHorizontal(Top, box.Render("a "), box.Render("b "))
+	got := MergeBorders(box.Render(inner))
+
+	require.Equal(t, "┌──┬──┐\n│a │b │\n└──┴──┘", got)
+}
+
+func TestMergeBorders_KeepsColors(t *testing.T) {
+	red := "\x1b[31m"
+	block := red + "┌─┐┌─┐" + "\x1b[0m\n" +
+		red + "│a││b│" + "\x1b[0m\n" +
+		red + "└─┘└─┘" + "\x1b[0m"
+	got := MergeBorders(block)
+
+	require.Equal(t, "┌─┬─┐\n│a│b│\n└─┴─┘", measure.StripANSI(got))
+	for _, line := range strings.Split(got, "\n") {
+		require.True(t, strings.HasPrefix(line, red), line)
+		require.True(t, strings.HasSuffix(line, "\x1b[0m"), line)
+	}
+}
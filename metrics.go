@@ -0,0 +1,108 @@
+package tuistyles
+
+import (
+	"expvar"
+	"time"
+)
+
+// RenderStats describes one call to Renderer.Render.
+type RenderStats struct {
+	Duration      time.Duration // Time spent rendering
+	Bytes         int           // Length of the output, escape sequences included
+	Width, Height int           // Size of the output in cells
+}
+
+// MetricsRecorder receives the stats of every render, so long-running TUIs
+// can find rendering hot spots in production. Renderers are shared between
+// goroutines, so RecordRender must be safe for concurrent use.
+type MetricsRecorder interface {
+	RecordRender(stats RenderStats)
+}
+
+// Metrics reports every render to m. Pass nil to stop recording; without a
+// recorder, rendering is not timed at all.
+//
+// Returns a new Renderer, leaving the original unchanged.
+//
+// Example:
+//
+//	r := NewRenderer().Metrics(NewExpvarMetrics("tui_render"))
+func (r Renderer) Metrics(m MetricsRecorder) Renderer {
+	r2 := r
+	r2.metrics = m
+	return r2
+}
+
+// renderDurationBuckets are the upper bounds of ExpvarMetrics' duration
+// histogram
+var renderDurationBuckets = []struct {
+	name  string
+	limit time.Duration
+}{
+	{"le_10us", 10 * time.Microsecond},
+	{"le_100us", 100 * time.Microsecond},
+	{"le_1ms", time.Millisecond},
+	{"le_10ms", 10 * time.Millisecond},
+	{"le_100ms", 100 * time.Millisecond},
+}
+
+// ExpvarMetrics is a MetricsRecorder that publishes render counters through
+// expvar, where /debug/vars and other expvar readers can see them:
+//
+//	calls        number of renders
+//	bytes        total output length
+//	cells        total output area (width times height)
+//	nanoseconds  total time spent rendering
+//	duration     histogram of render times: le_10us, le_100us, le_1ms,
+//	             le_10ms, le_100ms, and inf, each counting renders no slower
+//	             than its bound and faster than the one before
+type ExpvarMetrics struct {
+	vars                       *expvar.Map
+	calls, bytes, cells, nanos *expvar.Int
+	duration                   *expvar.Map
+}
+
+// NewExpvarMetrics publishes a new set of render counters under name. Like
+// expvar.NewMap, it panics if name is already in use.
+func NewExpvarMetrics(name string) *ExpvarMetrics {
+	m := &ExpvarMetrics{
+		vars:     expvar.NewMap(name),
+		calls:    new(expvar.Int),
+		bytes:    new(expvar.Int),
+		cells:    new(expvar.Int),
+		nanos:    new(expvar.Int),
+		duration: new(expvar.Map).Init(),
+	}
+	m.vars.Set("calls", m.calls)
+	m.vars.Set("bytes", m.bytes)
+	m.vars.Set("cells", m.cells)
+	m.vars.Set("nanoseconds", m.nanos)
+	m.vars.Set("duration", m.duration)
+	for _, b := range renderDurationBuckets {
+		m.duration.Add(b.name, 0)
+	}
+	m.duration.Add("inf", 0)
+	return m
+}
+
+// RecordRender adds stats to the counters.
+func (m *ExpvarMetrics) RecordRender(stats RenderStats) {
+	m.calls.Add(1)
+	m.bytes.Add(int64(stats.Bytes))
+	m.cells.Add(int64(stats.Width * stats.Height))
+	m.nanos.Add(int64(stats.Duration))
+
+	bucket := "inf"
+	for _, b := range renderDurationBuckets {
+		if stats.Duration <= b.limit {
+			bucket = b.name
+			break
+		}
+	}
+	m.duration.Add(bucket, 1)
+}
+
+// Vars returns the published map of counters.
+func (m *ExpvarMetrics) Vars() *expvar.Map {
+	return m.vars
+}
@@ -0,0 +1,63 @@
+package tuistyles
+
+import (
+	"expvar"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// statsRecorder collects the stats it receives
+type statsRecorder struct {
+	mu    sync.Mutex
+	stats []RenderStats
+}
+
+func (r *statsRecorder) RecordRender(stats RenderStats) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.stats = append(r.stats, stats)
+}
+
+func TestRenderer_Metrics(t *testing.T) {
+	rec := &statsRecorder{}
+	r := NewRenderer().Target(TargetPlain).GlyphSupport(GlyphsFull).Metrics(rec)
+
+	out := r.Render(NewStyle().Border(NormalBorder()), "hello")
+	r.Render(NewStyle(), "x")
+
+	require.Len(t, rec.stats, 2)
+	require.Equal(t, len(out), rec.stats[0].Bytes)
+	require.Equal(t, 7, rec.stats[0].Width)
+	require.Equal(t, 3, rec.stats[0].Height)
+	require.GreaterOrEqual(t, rec.stats[0].Duration, time.Duration(0))
+
+	r.Metrics(nil).Render(NewStyle(), "x")
+	require.Len(t, rec.stats, 2, "a nil recorder stops recording")
+}
+
+func TestExpvarMetrics(t *testing.T) {
+	// Unique per run, since expvar names can only be published once
+	name := fmt.Sprintf("tuistyles_test_render_%d", time.Now().UnixNano())
+	m := NewExpvarMetrics(name)
+	m.RecordRender(RenderStats{Duration: 5 * time.Microsecond, Bytes: 10, Width: 4, Height: 2})
+	m.RecordRender(RenderStats{Duration: 2 * time.Millisecond, Bytes: 6, Width: 3, Height: 1})
+	m.RecordRender(RenderStats{Duration: time.Second})
+
+	vars := m.Vars()
+	require.Same(t, vars, expvar.Get(name))
+	require.Equal(t, "3", vars.Get("calls").String())
+	require.Equal(t, "16", vars.Get("bytes").String())
+	require.Equal(t, "11", vars.Get("cells").String())
+
+	duration := vars.Get("duration").(*expvar.Map)
+	require.Equal(t, "1", duration.Get("le_10us").String())
+	require.Equal(t, "0", duration.Get("le_1ms").String())
+	require.Equal(t, "1", duration.Get("le_10ms").String())
+	require.Equal(t, "1", duration.Get("inf").String())
+
+	require.Panics(t, func() { NewExpvarMetrics(name) }, "names are unique")
+}
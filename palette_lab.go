@@ -0,0 +1,265 @@
+// Code generated by go run gen_palette.go; DO NOT EDIT.
+
+package tuistyles
+
+import "github.com/orchard9/tui-styles/internal/colorspace"
+
+// paletteLab holds the CIE L*a*b* value of each xterm 256-color code
+var paletteLab = [256]colorspace.Lab{
+	{L: 0.0000, A: 0.0000, B: 0.0000},      // 0
+	{L: 42.7385, A: 67.9442, B: 57.0100},   // 1
+	{L: 72.0005, A: -73.1107, B: 70.5629},  // 2
+	{L: 79.9786, A: -18.2845, B: 80.1478},  // 3
+	{L: 29.8396, A: 75.1584, B: -102.3722}, // 4
+	{L: 48.7475, A: 83.3343, B: -51.5991},  // 5
+	{L: 74.8665, A: -40.7937, B: -11.9878}, // 6
+	{L: 90.9411, A: -0.0000, B: 0.0000},    // 7
+	{L: 53.1928, A: -0.0000, B: 0.0000},    // 8
+	{L: 53.2408, A: 80.0925, B: 67.2032},   // 9
+	{L: 87.7347, A: -86.1827, B: 83.1793},  // 10
+	{L: 97.1393, A: -21.5537, B: 94.4780},  // 11
+	{L: 48.4452, A: 47.9751, B: -81.0073},  // 12
+	{L: 60.3242, A: 98.2343, B: -60.8249},  // 13
+	{L: 91.1132, A: -48.0875, B: -14.1312}, // 14
+	{L: 100.0000, A: -0.0000, B: 0.0000},   // 15
+	{L: 0.0000, A: 0.0000, B: 0.0000},      // 16
+	{L: 7.4607, A: 38.3910, B: -52.3441},   // 17
+	{L: 14.1088, A: 49.3662, B: -67.2410},  // 18
+	{L: 20.4168, A: 59.7088, B: -81.3284},  // 19
+	{L: 26.4612, A: 69.6192, B: -94.8273},  // 20
+	{L: 32.2970, A: 79.1875, B: -107.8602}, // 21
+	{L: 34.3629, A: -41.8415, B: 40.3833},  // 22
+	{L: 36.0032, A: -23.3464, B: -6.8607},  // 23
+	{L: 37.7211, A: -8.2803, B: -28.8381},  // 24
+	{L: 40.0447, A: 8.0504, B: -49.0779},   // 25
+	{L: 42.8962, A: 24.2321, B: -67.6659},  // 26
+	{L: 46.1791, A: 39.6116, B: -84.8356},  // 27
+	{L: 48.6692, A: -53.7271, B: 51.8548},  // 28
+	{L: 49.6808, A: -41.4682, B: 12.8713},  // 29
+	{L: 50.7754, A: -29.9782, B: -8.8095},  // 30
+	{L: 52.3097, A: -16.0877, B: -29.6684}, // 31
+	{L: 54.2717, A: -0.9845, B: -49.3466},  // 32
+	{L: 56.6287, A: 14.4366, B: -67.8258},  // 33
+	{L: 62.2178, A: -64.9833, B: 62.7186},  // 34
+	{L: 62.9140, A: -56.2748, B: 30.5528},  // 35
+	{L: 63.6775, A: -47.5337, B: 9.9898},   // 36
+	{L: 64.7652, A: -36.2588, B: -10.6552}, // 37
+	{L: 66.1843, A: -23.1800, B: -30.6592}, // 38
+	{L: 67.9287, A: -9.0219, B: -49.7922},  // 39
+	{L: 75.2003, A: -75.7691, B: 73.1287},  // 40
+	{L: 75.7141, A: -69.2381, B: 46.4158},  // 41
+	{L: 76.2813, A: -62.4371, B: 27.3589},  // 42
+	{L: 77.0961, A: -53.3178, B: 7.4148},   // 43
+	{L: 78.1706, A: -42.2770, B: -12.4237}, // 44
+	{L: 79.5085, A: -29.8039, B: -31.7438}, // 45
+	{L: 87.7347, A: -86.1827, B: 83.1793},  // 46
+	{L: 88.1325, A: -81.0793, B: 60.7843},  // 47
+	{L: 88.5734, A: -75.6499, B: 43.3692},  // 48
+	{L: 89.2097, A: -68.1923, B: 24.4088},  // 49
+	{L: 90.0539, A: -58.9039, B: 5.0549},   // 50
+	{L: 91.1132, A: -48.0875, B: -14.1312}, // 51
+	{L: 17.6162, A: 38.8847, B: 27.2082},   // 52
+	{L: 21.0552, A: 47.6925, B: -29.5303},  // 53
+	{L: 24.2655, A: 55.1093, B: -50.1099},  // 54
+	{L: 28.1885, A: 63.4973, B: -68.1894},  // 55
+	{L: 32.5650, A: 72.2784, B: -84.4951},  // 56
+	{L: 37.2091, A: 81.1577, B: -99.5393},  // 57
+	{L: 38.9288, A: -10.4643, B: 45.8688},  // 58
+	{L: 40.3177, A: -0.0000, B: 0.0000},    // 59
+	{L: 41.7924, A: 9.7169, B: -22.1848},   // 60
+	{L: 43.8166, A: 21.3585, B: -42.8295},  // 61
+	{L: 46.3413, A: 33.9106, B: -61.9152},  // 62
+	{L: 49.2955, A: 46.6510, B: -79.6094},  // 63
+	{L: 51.5654, A: -31.1069, B: 55.3623},  // 64
+	{L: 52.4939, A: -22.3661, B: 17.1864},  // 65
+	{L: 53.5023, A: -13.7557, B: -4.4596},  // 66
+	{L: 54.9222, A: -2.8603, B: -25.4129},  // 67
+	{L: 56.7477, A: 9.5228, B: -45.2638},   // 68
+	{L: 58.9540, A: 22.6697, B: -63.9619},  // 69
+	{L: 64.2350, A: -48.2033, B: 65.1701},  // 70
+	{L: 64.8971, A: -41.1710, B: 33.4874},  // 71
+	{L: 65.6241, A: -33.9633, B: 13.0130},  // 72
+	{L: 66.6616, A: -24.4646, B: -7.6263},  // 73
+	{L: 68.0178, A: -13.1893, B: -27.6801}, // 74
+	{L: 69.6891, A: -0.7082, B: -46.9001},  // 75
+	{L: 76.6980, A: -62.8807, B: 74.9519},  // 76
+	{L: 77.1954, A: -57.2215, B: 48.5373},  // 77
+	{L: 77.7449, A: -51.2709, B: 29.5709},  // 78
+	{L: 78.5348, A: -43.2061, B: 9.6644},   // 79
+	{L: 79.5774, A: -33.3213, B: -10.1754}, // 80
+	{L: 80.8770, A: -22.0101, B: -29.5248}, // 81
+	{L: 88.8984, A: -75.9684, B: 84.5972},  // 82
+	{L: 89.2874, A: -71.3547, B: 62.3925},  // 83
+	{L: 89.7188, A: -66.4221, B: 45.0556},  // 84
+	{L: 90.3414, A: -59.6085, B: 26.1405},  // 85
+	{L: 91.1680, A: -51.0639, B: 6.8045},   // 86
+	{L: 92.2057, A: -41.0388, B: -12.3846}, // 87
+	{L: 27.1653, A: 49.9304, B: 40.1367},   // 88
+	{L: 29.3584, A: 55.7250, B: -15.9030},  // 89
+	{L: 31.5812, A: 61.2402, B: -37.9188},  // 90
+	{L: 34.4915, A: 68.0434, B: -57.6118},  // 91
+	{L: 37.9450, A: 75.6529, B: -75.4330},  // 92
+	{L: 41.7985, A: 83.7069, B: -91.7918},  // 93
+	{L: 43.2660, A: 9.1346, B: 50.9300},    // 94
+	{L: 44.4650, A: 16.3110, B: 6.5128},    // 95
+	{L: 45.7507, A: 23.3730, B: -15.7667},  // 96
+	{L: 47.5344, A: 32.3009, B: -36.7030},  // 97
+	{L: 49.7873, A: 42.4445, B: -56.1845},  // 98
+	{L: 52.4579, A: 53.2240, B: -74.3206},  // 99
+	{L: 54.5321, A: -13.4368, B: 58.8984},  // 100
+	{L: 55.3855, A: -6.7681, B: 21.5809},   // 101
+	{L: 56.3155, A: -0.0000, B: 0.0000},    // 102
+	{L: 57.6300, A: 8.8257, B: -21.0213},   // 103
+	{L: 59.3281, A: 19.1795, B: -41.0222},  // 104
+	{L: 61.3919, A: 30.5082, B: -59.9207},  // 105
+	{L: 66.3749, A: -33.3356, B: 67.7458},  // 106
+	{L: 67.0034, A: -27.5272, B: 36.5829},  // 107
+	{L: 67.6945, A: -21.4824, B: 16.2125},  // 108
+	{L: 68.6821, A: -13.3847, B: -4.4107},  // 109
+	{L: 69.9759, A: -3.5941, B: -24.5072},  // 110
+	{L: 71.5740, A: 7.4479, B: -43.8100},   // 111
+	{L: 78.3159, A: -50.5853, B: 76.9091},  // 112
+	{L: 78.7965, A: -45.6514, B: 50.8185},  // 113
+	{L: 79.3278, A: -40.4218, B: 31.9538},  // 114
+	{L: 80.0920, A: -33.2698, B: 12.0921},  // 115
+	{L: 81.1015, A: -24.4098, B: -7.7451},  // 116
+	{L: 82.3614, A: -14.1550, B: -27.1219}, // 117
+	{L: 90.1685, A: -65.7702, B: 86.1383},  // 118
+	{L: 90.5484, A: -61.5991, B: 64.1416},  // 119
+	{L: 90.9696, A: -57.1199, B: 46.8915},  // 120
+	{L: 91.5779, A: -50.9008, B: 28.0279},  // 121
+	{L: 92.3858, A: -43.0524, B: 8.7133},   // 122
+	{L: 93.4007, A: -33.7793, B: -10.4771}, // 123
+	{L: 36.2088, A: 60.3911, B: 50.5738},   // 124
+	{L: 37.7400, A: 64.4953, B: -2.4383},   // 125
+	{L: 39.3534, A: 68.6503, B: -25.1287},  // 126
+	{L: 41.5498, A: 74.0704, B: -45.8630},  // 127
+	{L: 44.2640, A: 80.4584, B: -64.8486},  // 128
+	{L: 47.4104, A: 87.5204, B: -82.3566},  // 129
+	{L: 48.6370, A: 27.3303, B: 57.0292},   // 130
+	{L: 49.6497, A: 32.3459, B: 14.5363},   // 131
+	{L: 50.7452, A: 37.4832, B: -7.7434},   // 132
+	{L: 52.2809, A: 44.2496, B: -28.9309},  // 133
+	{L: 54.2444, A: 52.2803, B: -48.8060},  // 134
+	{L: 56.6032, A: 61.1783, B: -67.4119},  // 135
+	{L: 58.4560, A: 5.0733, B: 63.4951},    // 136
+	{L: 59.2232, A: 10.0700, B: 27.3480},   // 137
+	{L: 60.0623, A: 15.2673, B: 5.8948},    // 138
+	{L: 61.2535, A: 22.2258, B: -15.1760},  // 139
+	{L: 62.8007, A: 30.6335, B: -35.3367},  // 140
+	{L: 64.6929, A: 40.1112, B: -54.4651},  // 141
+	{L: 69.3090, A: -16.2519, B: 71.2380},  // 142
+	{L: 69.8954, A: -11.5993, B: 40.7968},  // 143
+	{L: 70.5412, A: -6.6872, B: 20.5850},   // 144
+	{L: 71.4660, A: -0.0000, B: 0.0000},    // 145
+	{L: 72.6804, A: 8.2385, B: -20.1396},   // 146
+	{L: 74.1850, A: 17.7163, B: -39.5407},  // 147
+	{L: 80.5799, A: -35.5139, B: 79.6274},  // 148
+	{L: 81.0384, A: -31.3470, B: 53.9923},  // 149
+	{L: 81.5456, A: -26.8929, B: 35.2760},  // 150
+	{L: 82.2758, A: -20.7422, B: 15.4841},  // 151
+	{L: 83.2417, A: -13.0326, B: -4.3424},  // 152
+	{L: 84.4488, A: -3.9933, B: -23.7508},  // 153
+	{L: 91.9678, A: -52.7013, B: 88.3097},  // 154
+	{L: 92.3352, A: -49.0367, B: 66.6080},  // 155
+	{L: 92.7427, A: -45.0819, B: 49.4836},  // 156
+	{L: 93.3315, A: -39.5582, B: 30.6961},  // 157
+	{L: 94.1140, A: -32.5360, B: 11.4152},  // 158
+	{L: 95.0977, A: -24.1695, B: -7.7737},  // 159
+	{L: 44.8743, A: 70.4148, B: 59.0829},   // 160
+	{L: 46.0126, A: 73.4883, B: 10.5290},   // 161
+	{L: 47.2367, A: 76.7062, B: -12.3486},  // 162
+	{L: 48.9409, A: 81.0514, B: -33.6818},  // 163
+	{L: 51.1019, A: 86.3645, B: -53.4753},  // 164
+	{L: 53.6746, A: 92.4463, B: -71.8790},  // 165
+	{L: 54.6953, A: 43.5489, B: 63.7269},   // 166
+	{L: 55.5449, A: 47.1953, B: 23.4949},   // 167
+	{L: 56.4708, A: 51.0292, B: 1.3459},    // 168
+	{L: 57.7798, A: 56.2254, B: -20.0002},  // 169
+	{L: 59.4713, A: 62.5971, B: -40.2046},  // 170
+	{L: 61.5275, A: 69.8974, B: -59.2414},  // 171
+	{L: 63.1597, A: 22.8599, B: 68.8974},   // 172
+	{L: 63.8396, A: 26.6342, B: 34.1856},   // 173
+	{L: 64.5858, A: 30.6329, B: 12.9412},   // 174
+	{L: 65.6496, A: 36.0982, B: -8.1342},   // 175
+	{L: 67.0388, A: 42.8642, B: -28.4339},  // 176
+	{L: 68.7486, A: 50.6913, B: -47.7889},  // 177
+	{L: 72.9642, A: 1.4301, B: 75.5292},    // 178
+	{L: 73.5039, A: 5.1195, B: 45.9964},    // 179
+	{L: 74.0992, A: 9.0621, B: 26.0054},    // 180
+	{L: 74.9534, A: 14.5048, B: 5.4923},    // 181
+	{L: 76.0782, A: 21.3240, B: -14.6771},  // 182
+	{L: 77.4762, A: 29.3157, B: -34.1779},  // 183
+	{L: 83.4685, A: -18.9494, B: 83.0621},  // 184
+	{L: 83.9010, A: -15.4926, B: 58.0100},  // 185
+	{L: 84.3797, A: -11.7687, B: 39.4933},  // 186
+	{L: 85.0697, A: -6.5791, B: 19.8016},   // 187
+	{L: 85.9836, A: -0.0000, B: 0.0000},    // 188
+	{L: 87.1277, A: 7.8131, B: -19.4378},   // 189
+	{L: 94.2983, A: -37.6682, B: 91.1024},  // 190
+	{L: 94.6505, A: -34.5124, B: 69.7829},  // 191
+	{L: 95.0412, A: -31.0895, B: 52.8255},  // 192
+	{L: 95.6060, A: -26.2802, B: 34.1421},  // 193
+	{L: 96.3573, A: -20.1199, B: 14.9106},  // 194
+	{L: 97.3025, A: -12.7160, B: -4.2707},  // 195
+	{L: 53.2408, A: 80.0925, B: 67.2032},   // 196
+	{L: 54.1258, A: 82.4922, B: 22.9110},   // 197
+	{L: 55.0888, A: 85.0546, B: 0.1681},    // 198
+	{L: 56.4478, A: 88.5910, B: -21.4507},  // 199
+	{L: 58.1998, A: 93.0251, B: -41.7660},  // 200
+	{L: 60.3242, A: 98.2343, B: -60.8249},  // 201
+	{L: 61.1778, A: 58.0072, B: 70.7252},   // 202
+	{L: 61.8926, A: 60.7691, B: 32.9401},   // 203
+	{L: 62.6760, A: 63.7229, B: 11.0592},   // 204
+	{L: 63.7910, A: 67.8052, B: -10.3331},  // 205
+	{L: 65.2440, A: 72.9293, B: -30.7731},  // 206
+	{L: 67.0277, A: 78.9505, B: -50.1652},  // 207
+	{L: 68.4562, A: 39.3470, B: 74.8585},   // 208
+	{L: 69.0544, A: 42.2564, B: 41.7783},   // 209
+	{L: 69.7130, A: 45.3797, B: 20.8326},   // 210
+	{L: 70.6554, A: 49.7148, B: -0.1847},   // 211
+	{L: 71.8921, A: 55.1836, B: -20.5799},  // 212
+	{L: 73.4231, A: 61.6435, B: -40.1322},  // 213
+	{L: 77.2361, A: 18.7156, B: 80.4677},   // 214
+	{L: 77.7278, A: 21.6519, B: 52.0010},   // 215
+	{L: 78.2712, A: 24.8198, B: 32.2977},   // 216
+	{L: 79.0524, A: 29.2427, B: 11.8997},   // 217
+	{L: 80.0838, A: 34.8627, B: -8.2740},   // 218
+	{L: 81.3700, A: 41.5547, B: -27.8613},  // 219
+	{L: 86.9306, A: -1.9237, B: 87.1320},   // 220
+	{L: 87.3346, A: 0.9256, B: 62.7789},    // 221
+	{L: 87.7823, A: 4.0156, B: 44.5147},    // 222
+	{L: 88.4282, A: 8.3564, B: 24.9586},    // 223
+	{L: 89.2849, A: 13.9152, B: 5.2026},    // 224
+	{L: 90.3595, A: 20.5942, B: -14.2549},  // 225
+	{L: 97.1393, A: -21.5537, B: 94.4780},  // 226
+	{L: 97.4740, A: -18.8669, B: 73.6233},  // 227
+	{L: 97.8456, A: -15.9394, B: 56.8756},  // 228
+	{L: 98.3832, A: -11.8032, B: 38.3269},  // 229
+	{L: 99.0987, A: -6.4672, B: 19.1639},   // 230
+	{L: 100.0000, A: -0.0000, B: 0.0000},   // 231
+	{L: 2.1934, A: -0.0000, B: 0.0000},     // 232
+	{L: 5.4639, A: -0.0000, B: 0.0000},     // 233
+	{L: 10.2682, A: -0.0000, B: 0.0000},    // 234
+	{L: 15.1597, A: -0.0000, B: 0.0000},    // 235
+	{L: 19.8655, A: -0.0000, B: 0.0000},    // 236
+	{L: 24.4213, A: -0.0000, B: 0.0000},    // 237
+	{L: 28.8519, A: -0.0000, B: 0.0000},    // 238
+	{L: 33.1755, A: -0.0000, B: 0.0000},    // 239
+	{L: 37.4059, A: -0.0000, B: 0.0000},    // 240
+	{L: 41.5540, A: -0.0000, B: 0.0000},    // 241
+	{L: 45.6287, A: -0.0000, B: 0.0000},    // 242
+	{L: 49.6370, A: -0.0000, B: 0.0000},    // 243
+	{L: 53.5850, A: -0.0000, B: 0.0000},    // 244
+	{L: 57.4778, A: -0.0000, B: 0.0000},    // 245
+	{L: 61.3196, A: -0.0000, B: 0.0000},    // 246
+	{L: 65.1142, A: -0.0000, B: 0.0000},    // 247
+	{L: 68.8650, A: -0.0000, B: 0.0000},    // 248
+	{L: 72.5748, A: -0.0000, B: 0.0000},    // 249
+	{L: 76.2461, A: -0.0000, B: 0.0000},    // 250
+	{L: 79.8812, A: -0.0000, B: 0.0000},    // 251
+	{L: 83.4822, A: -0.0000, B: 0.0000},    // 252
+	{L: 87.0509, A: -0.0000, B: 0.0000},    // 253
+	{L: 90.5889, A: -0.0000, B: 0.0000},    // 254
+	{L: 94.0978, A: -0.0000, B: 0.0000},    // 255
+}
@@ -0,0 +1,144 @@
+package tuistyles
+
+import (
+	"strings"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+// Panel is a bordered box with a title set into its top border, a body,
+// and an optional footer set into its bottom border:
+//
+//	╭─ Metrics ───────────╮
+//	│ CPU  45%            │
+//	│ MEM  2.1 GB         │
+//	╰──────── updated 2s ─╯
+//
+// Style controls the box: border, colors, and padding. Without a border it
+// gets a rounded one in the theme's Border color, and without horizontal
+// padding the body is kept one cell away from the sides. The zero Theme
+// renders with DefaultTheme.
+//
+// Example:
+//
+//	p := Panel{Title: "Metrics", Body: metrics, Footer: "updated 2s", FooterAlign: Right}
+//	fmt.Println(p.Render(40))
+type Panel struct {
+	Title       string
+	Body        string
+	Footer      string
+	TitleAlign  Position // Within the top border: Left (default), Center, or Right
+	FooterAlign Position // Within the bottom border: Left (default), Center, or Right
+	Style       Style
+	Theme       Theme
+
+	TitleStyle  Style // Bold primary by default
+	FooterStyle Style // Muted by default
+}
+
+// Render draws the panel width cells wide, wrapping the body to fit. A
+// width of 0 or less sizes the panel to its body, title, and footer. A
+// title or footer too long for the border is truncated with "…".
+func (p Panel) Render(width int) string {
+	theme := p.Theme.WithDefaults()
+	titleStyle := orDefault(p.TitleStyle, NewStyle().Bold(true).Foreground(theme.Primary))
+	footerStyle := orDefault(p.FooterStyle, NewStyle().Foreground(theme.Muted))
+
+	style := p.boxStyle(theme)
+	box := style
+	box.shadow = nil
+
+	// The border between the corners must fit each label with a space on
+	// both sides and a line segment before and after
+	padding := intOrZero(style.paddingLeft) + intOrZero(style.paddingRight)
+	inner := width - style.horizontalFrame()
+	if width <= 0 {
+		inner = measure.MaxWidth(p.Body)
+		for _, label := range []string{p.Title, p.Footer} {
+			if label != "" {
+				inner = max(inner, measure.Width(label)+4-padding)
+			}
+		}
+	}
+	inner = max(inner, 1)
+
+	lines := strings.Split(box.Width(inner).Render(Wrap(p.Body, inner)), "\n")
+	border := *style.borderType
+	left := style.borderLeft == nil || *style.borderLeft
+	right := style.borderRight == nil || *style.borderRight
+	span := inner + padding
+
+	if p.Title != "" && (style.borderTop == nil || *style.borderTop) {
+		lines[0] = style.labeledBorderLine(border.TopLeft, border.Top, border.TopRight, sideTop,
+			left, right, span, titleStyle, p.Title, p.TitleAlign)
+	}
+	if p.Footer != "" && (style.borderBottom == nil || *style.borderBottom) {
+		lines[len(lines)-1] = style.labeledBorderLine(border.BottomLeft, border.Bottom, border.BottomRight, sideBottom,
+			left, right, span, footerStyle, p.Footer, p.FooterAlign)
+	}
+
+	out := strings.Join(lines, "\n")
+	if style.hasShadow() {
+		out = style.applyShadow(out)
+	}
+	return out
+}
+
+// MinWidth returns the width of the panel's frame and one cell of body.
+func (p Panel) MinWidth() int {
+	return p.boxStyle(p.Theme.WithDefaults()).horizontalFrame() + 1
+}
+
+// boxStyle returns Style with the default border, padding, and alignment
+// filled in
+func (p Panel) boxStyle(theme Theme) Style {
+	style := p.Style
+	if !style.hasBorder() {
+		style = style.Border(RoundedBorder())
+		if style.borderForeground == nil {
+			style = style.BorderForeground(theme.Border)
+		}
+	}
+	if style.paddingLeft == nil && style.paddingRight == nil {
+		style = style.PaddingLeft(1).PaddingRight(1)
+	}
+	if style.align == nil {
+		style = style.Align(Left)
+	}
+	return style
+}
+
+// labeledBorderLine draws a horizontal border line span cells long between
+// its corners, with label set into it at align
+func (s Style) labeledBorderLine(leftCorner, fill, rightCorner string, side boxSide, left, right bool, span int, labelStyle Style, label string, align Position) string {
+	var b strings.Builder
+	if left {
+		b.WriteString(s.styleBorderChar(leftCorner, side))
+	}
+
+	// One line segment and one space on each side of the label
+	room := span - 4
+	if room < 1 {
+		b.WriteString(s.styleBorderChar(strings.Repeat(fill, span), side))
+	} else {
+		if measure.Width(label) > room {
+			label = truncateKeeping(label, room, "…", room, false)
+		}
+		free := span - measure.Width(label) - 2
+		before := 1
+		switch align {
+		case Center:
+			before = free / 2
+		case Right:
+			before = free - 1
+		}
+		b.WriteString(s.styleBorderChar(strings.Repeat(fill, before)+" ", side))
+		b.WriteString(labelStyle.Render(label))
+		b.WriteString(s.styleBorderChar(" "+strings.Repeat(fill, free-before), side))
+	}
+
+	if right {
+		b.WriteString(s.styleBorderChar(rightCorner, side))
+	}
+	return b.String()
+}
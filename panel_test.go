@@ -0,0 +1,76 @@
+package tuistyles
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+func TestPanel_Render(t *testing.T) {
+	tests := []struct {
+		name  string
+		panel Panel
+		width int
+		want  string
+	}{
+		{
+			name:  "title and body",
+			panel: Panel{Title: "Metrics", Body: "CPU 45%"},
+			width: 16,
+			want: "╭─ Metrics ────╮\n" +
+				"│ CPU 45%      │\n" +
+				"╰──────────────╯",
+		},
+		{
+			name:  "footer right, title centered",
+			panel: Panel{Title: "Log", TitleAlign: Center, Body: "ok", Footer: "2s", FooterAlign: Right},
+			width: 14,
+			want: "╭─── Log ────╮\n" +
+				"│ ok         │\n" +
+				"╰─────── 2s ─╯",
+		},
+		{
+			name:  "natural width fits title",
+			panel: Panel{Title: "Status", Body: "up"},
+			want: "╭─ Status ─╮\n" +
+				"│ up       │\n" +
+				"╰──────────╯",
+		},
+		{
+			name:  "body wraps",
+			panel: Panel{Body: "one two three", Style: NewStyle().Border(NormalBorder())},
+			width: 11,
+			want: "┌─────────┐\n" +
+				"│ one two │\n" +
+				"│ three   │\n" +
+				"└─────────┘",
+		},
+		{
+			name:  "long title truncated",
+			panel: Panel{Title: "A very long title", Body: "x"},
+			width: 12,
+			want: "╭─ A ver… ─╮\n" +
+				"│ x        │\n" +
+				"╰──────────╯",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.panel.Render(tt.width)
+			require.Equal(t, tt.want, measure.StripANSI(got))
+			for _, line := range strings.Split(got, "\n") {
+				require.Equal(t, measure.Width(strings.Split(got, "\n")[0]), measure.Width(line))
+			}
+		})
+	}
+}
+
+func TestPanel_Shadow(t *testing.T) {
+	got := Panel{Title: "T", Body: "x", Style: NewStyle().Border(RoundedBorder()).Shadow(true)}.Render(12)
+	require.Equal(t, 12, Width(got))
+	require.True(t, strings.HasPrefix(measure.StripANSI(got), "╭─ T ─────╮"), measure.StripANSI(got))
+}
@@ -0,0 +1,134 @@
+package tuistyles
+
+import (
+	"strings"
+
+	"github.com/orchard9/tui-styles/internal/ansi"
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+// BackgroundPattern fills the empty area of the box with a repeating
+// pattern instead of spaces, for placeholders and disabled panels.
+//
+// The empty area is the space added by Width/Height alignment and by
+// padding; the content's own spaces are left alone. The pattern repeats by
+// column across the whole padded box, so multi-character patterns line up
+// from row to row. Wide characters are measured like any other text; one
+// that would be cut at the edge of a run is replaced with a space. Pattern
+// characters use the style's Foreground and the Background (or padding
+// background) of the area they fill. An empty pattern restores spaces.
+//
+// With a Width or Height set, an empty string renders as a filled block.
+//
+// Returns a new Style with backgroundPattern set, leaving the original unchanged.
+//
+// Example:
+//
+//	placeholder := NewStyle().Width(20).Height(5).BackgroundPattern("░")
+//	fmt.Println(placeholder.Render(""))
+//
+//	disabled := NewStyle().Width(30).Align(Center).Foreground(Color("gray")).BackgroundPattern("╱")
+//	fmt.Println(disabled.Render("Unavailable"))
+func (s Style) BackgroundPattern(pattern string) Style {
+	s2 := s
+	s2.backgroundPattern = &pattern
+	return s2
+}
+
+// hasBackgroundPattern returns true if a non-empty background pattern is set
+func (s Style) hasBackgroundPattern() bool {
+	return s.backgroundPattern != nil && *s.backgroundPattern != ""
+}
+
+// fillsEmpty reports whether rendering an empty string still produces a
+// visible block
+func (s Style) fillsEmpty() bool {
+	return s.hasBackgroundPattern() && (s.width != nil || s.height != nil)
+}
+
+// contentFill fills width cells of the content area starting at column col
+// of the padded box: the pattern if set, plain spaces otherwise
+func (s Style) contentFill(col, width int) string {
+	if !s.hasBackgroundPattern() {
+		return strings.Repeat(" ", max(width, 0))
+	}
+	return s.patternFill(col, width, s.background)
+}
+
+// paddingFill fills width cells of padding on one side starting at column
+// col of the padded box
+func (s Style) paddingFill(side boxSide, col, width int) string {
+	if !s.hasBackgroundPattern() {
+		return makeColoredSpace(width, s.paddingBackground(side))
+	}
+	return s.patternFill(col, width, s.paddingBackground(side))
+}
+
+// paddingColumns fills the left or right padding of one content line,
+// colored cell by cell when no pattern is set
+func (s Style) paddingColumns(side boxSide, col, width int) string {
+	if !s.hasBackgroundPattern() {
+		return strings.Repeat(makeColoredSpace(1, s.paddingBackground(side)), width)
+	}
+	return s.patternFill(col, width, s.paddingBackground(side))
+}
+
+// patternFill renders a run of the background pattern in the style's
+// foreground over bg
+func (s Style) patternFill(col, width int, bg *Color) string {
+	if width <= 0 {
+		return ""
+	}
+
+	var prefix string
+	if fg := s.resolvedForeground(); fg != nil {
+		prefix += fg.ToANSI()
+	}
+	if bg != nil {
+		prefix += bg.ToANSIBackground()
+	}
+
+	run := patternRun(*s.backgroundPattern, col, width)
+	if prefix == "" {
+		return run
+	}
+	return prefix + run + ansi.Reset()
+}
+
+// patternRun returns width cells of pattern repeated by column, starting at
+// column col. A wide character that does not fit, or whose first column
+// falls before the run, is replaced with spaces.
+func patternRun(pattern string, col, width int) string {
+	// glyphs holds one entry per pattern column: the cluster starting there,
+	// or "" for the trailing columns of a wide cluster
+	var glyphs []string
+	var widths []int
+	measure.EachGrapheme(pattern, func(cluster string, w int) bool {
+		if w <= 0 {
+			return true
+		}
+		glyphs = append(glyphs, cluster)
+		widths = append(widths, w)
+		for i := 1; i < w; i++ {
+			glyphs = append(glyphs, "")
+			widths = append(widths, 0)
+		}
+		return true
+	})
+	if len(glyphs) == 0 {
+		return strings.Repeat(" ", width)
+	}
+
+	var b strings.Builder
+	for i := 0; i < width; {
+		k := (col + i) % len(glyphs)
+		if widths[k] == 0 || i+widths[k] > width {
+			b.WriteByte(' ')
+			i++
+			continue
+		}
+		b.WriteString(glyphs[k])
+		i += widths[k]
+	}
+	return b.String()
+}
@@ -0,0 +1,96 @@
+package tuistyles
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+func TestBackgroundPattern_Render(t *testing.T) {
+	tests := []struct {
+		name  string
+		style Style
+		input string
+		want  []string
+	}{
+		{
+			name:  "placeholder block",
+			style: NewStyle().Width(4).Height(2).BackgroundPattern("░"),
+			input: "",
+			want:  []string{"░░░░", "░░░░"},
+		},
+		{
+			name:  "alignment space only",
+			style: NewStyle().Width(9).Align(Center).BackgroundPattern("░"),
+			input: "a b",
+			want:  []string{"░░░a b░░░"},
+		},
+		{
+			name:  "pattern anchored by column",
+			style: NewStyle().Width(6).Align(Left).BackgroundPattern("╱╲"),
+			input: "abc\nd",
+			want:  []string{"abc╲╱╲", "d╲╱╲╱╲"},
+		},
+		{
+			name:  "padding filled",
+			style: NewStyle().Padding(1, 2).BackgroundPattern("·"),
+			input: "hi",
+			want:  []string{"······", "··hi··", "······"},
+		},
+		{
+			name:  "padding and alignment share columns",
+			style: NewStyle().Width(4).Align(Right).PaddingLeft(1).BackgroundPattern("1234"),
+			input: "x",
+			want:  []string{"1234x"},
+		},
+		{
+			name:  "wide pattern cut at edge",
+			style: NewStyle().Width(5).Align(Left).BackgroundPattern("日"),
+			input: "",
+			want:  []string{"日日 "},
+		},
+		{
+			name:  "empty pattern uses spaces",
+			style: NewStyle().Width(3).Align(Left).BackgroundPattern(""),
+			input: "a",
+			want:  []string{"a  "},
+		},
+		{
+			name:  "empty content without size renders nothing",
+			style: NewStyle().BackgroundPattern("░"),
+			input: "",
+			want:  []string{""},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.style.Render(tt.input)
+			require.Equal(t, strings.Join(tt.want, "\n"), measure.StripANSI(got))
+		})
+	}
+}
+
+func TestBackgroundPattern_Colors(t *testing.T) {
+	s := NewStyle().Width(3).Align(Left).Foreground(Color("gray")).Background(Color("blue")).BackgroundPattern("░")
+	got := s.Render("a")
+	require.Contains(t, got, Color("gray").ToANSI()+Color("blue").ToANSIBackground()+"░░")
+}
+
+func TestPatternRun(t *testing.T) {
+	require.Equal(t, "abcab", patternRun("abc", 0, 5))
+	require.Equal(t, "cabca", patternRun("abc", 2, 5))
+	require.Equal(t, " 日", patternRun("日", 1, 3))
+	require.Equal(t, "   ", patternRun("\u200b", 0, 3))
+	require.Equal(t, "", patternRun("ab", 0, 0))
+}
+
+func TestBackgroundPattern_Immutability(t *testing.T) {
+	s1 := NewStyle()
+	s2 := s1.BackgroundPattern("░")
+	require.Nil(t, s1.backgroundPattern)
+	require.Equal(t, "░", *s2.backgroundPattern)
+}
@@ -0,0 +1,84 @@
+package tuistyles
+
+// WidthPercent sets the width of the whole box, border and padding
+// included, as a percentage of its container, so a view keeps its
+// proportions as the terminal is resized.
+//
+// A percentage takes effect when the style is resolved against a container
+// size: Renderer.Render resolves it against the terminal width, and
+// Resolve against any size. Until then, and when the container width is
+// unknown, Width applies instead. Negative values are clamped to 0.
+//
+// Returns a new Style with widthPercent set, leaving the original
+// unchanged.
+//
+// Example:
+//
+//	sidebar := NewStyle().WidthPercent(30).Border(RoundedBorder())
+//	fmt.Println(NewRenderer().Render(sidebar, menu))
+func (s Style) WidthPercent(p int) Style {
+	p = max(p, 0)
+	s2 := s
+	s2.widthPercent = &p
+	return s2
+}
+
+// HeightPercent sets the height of the whole box, border and padding
+// included, as a percentage of its container. It resolves like
+// WidthPercent, against the terminal height or the height given to
+// Resolve. Negative values are clamped to 0.
+//
+// Returns a new Style with heightPercent set, leaving the original
+// unchanged.
+//
+// Example:
+//
+//	log := NewStyle().HeightPercent(50).Border(NormalBorder())
+func (s Style) HeightPercent(p int) Style {
+	p = max(p, 0)
+	s2 := s
+	s2.heightPercent = &p
+	return s2
+}
+
+// Resolve turns percent sizes into cell sizes for a container width cells
+// wide and height lines high: Width and Height become the content size
+// that makes the whole box, frame included, the set percentage of the
+// container (never less than 0). A dimension of 0 or less is unknown and
+// leaves its percentage unresolved.
+//
+// Returns a new Style, leaving the original unchanged.
+//
+// Example:
+//
+//	half := NewStyle().WidthPercent(50).Padding(0, 1)
+//	fmt.Println(half.Resolve(80, 24).Render(text)) // 40 cells wide
+func (s Style) Resolve(width, height int) Style {
+	if s.widthPercent != nil && width > 0 {
+		s = s.Width(*s.widthPercent*width/100 - s.horizontalFrame())
+		s.widthPercent = nil
+	}
+	if s.heightPercent != nil && height > 0 {
+		s = s.Height(*s.heightPercent*height/100 - s.verticalFrame())
+		s.heightPercent = nil
+	}
+	return s
+}
+
+// verticalFrame returns the number of lines the style adds above and below
+// its content: padding, border sides, and drop shadow
+func (s Style) verticalFrame() int {
+	frame := intOrZero(s.paddingTop) + intOrZero(s.paddingBottom)
+	if s.hasBorder() {
+		if s.borderTop == nil || *s.borderTop {
+			frame++
+		}
+		if s.borderBottom == nil || *s.borderBottom {
+			frame++
+		}
+	}
+	if s.hasShadow() {
+		frame++
+	}
+	return frame
+}
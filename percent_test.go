@@ -0,0 +1,51 @@
+package tuistyles
+
+import (
+	"testing"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStyle_Resolve(t *testing.T) {
+	tests := []struct {
+		name                  string
+		style                 Style
+		wantWidth, wantHeight int
+	}{
+		{"whole box", NewStyle().WidthPercent(50).HeightPercent(50), 40, 12},
+		{"frame included", NewStyle().WidthPercent(50).HeightPercent(50).Padding(1, 2).Border(RoundedBorder()), 34, 8},
+		{"shadow included", NewStyle().WidthPercent(100).HeightPercent(100).Shadow(true), 79, 23},
+		{"rounds down", NewStyle().WidthPercent(33).HeightPercent(33), 26, 7},
+		{"never negative", NewStyle().WidthPercent(1).HeightPercent(1).Padding(2), 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.style.Resolve(80, 24)
+			require.Equal(t, tt.wantWidth, *got.width)
+			require.Equal(t, tt.wantHeight, *got.height)
+			require.Nil(t, got.widthPercent)
+			require.Nil(t, got.heightPercent)
+		})
+	}
+}
+
+func TestStyle_ResolveUnknownSize(t *testing.T) {
+	s := NewStyle().WidthPercent(50).Width(20).HeightPercent(50)
+	got := s.Resolve(0, 0)
+
+	require.Equal(t, s, got, "unknown sizes leave percentages unresolved")
+	require.Equal(t, 20, *got.width, "Width applies until resolved")
+}
+
+func TestRenderer_ResolvesPercentSizes(t *testing.T) {
+	r := NewRenderer().TerminalSize(40, 10).GlyphSupport(GlyphsFull).ColorProfile(ProfileTrueColor).Target(TargetColor)
+	s := NewStyle().WidthPercent(50).HeightPercent(50).Align(Left).Border(NormalBorder())
+
+	got := r.Render(s, "x")
+	w, h := Size(got)
+	require.Equal(t, 20, w)
+	require.Equal(t, 5, h)
+	require.Equal(t, s.Resolve(40, 10).Render("x"), measure.StripANSI(got))
+}
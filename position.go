@@ -15,6 +15,11 @@ const (
 	Top
 	// Bottom represents bottom vertical alignment
 	Bottom
+
+	// Decimal lines numbers up on their decimal separator (see
+	// Style.DecimalSeparator) and right-aligns the block within Width.
+	// It applies to Style.Align only.
+	Decimal
 )
 
 // String returns human-readable position name
@@ -30,6 +35,8 @@ func (p Position) String() string {
 		return "Top"
 	case Bottom:
 		return "Bottom"
+	case Decimal:
+		return "Decimal"
 	default:
 		return "Unknown"
 	}
@@ -37,12 +44,12 @@ func (p Position) String() string {
 
 // IsValid checks if Position is a valid enum value
 func (p Position) IsValid() bool {
-	return p >= Left && p <= Bottom
+	return p >= Left && p <= Decimal
 }
 
-// IsHorizontal returns true if position is Left, Center, or Right
+// IsHorizontal returns true if position is Left, Center, Right, or Decimal
 func (p Position) IsHorizontal() bool {
-	return p == Left || p == Center || p == Right
+	return p == Left || p == Center || p == Right || p == Decimal
 }
 
 // IsVertical returns true if position is Top, Center, or Bottom
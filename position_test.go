@@ -14,6 +14,7 @@ func TestPositionString(t *testing.T) {
 		{Right, "Right"},
 		{Top, "Top"},
 		{Bottom, "Bottom"},
+		{Decimal, "Decimal"},
 		{Position(999), "Unknown"},
 	}
 
@@ -38,6 +39,7 @@ func TestPositionIsValid(t *testing.T) {
 		{"Right valid", Right, true},
 		{"Top valid", Top, true},
 		{"Bottom valid", Bottom, true},
+		{"Decimal valid", Decimal, true},
 		{"Negative invalid", Position(-1), false},
 		{"Too high invalid", Position(999), false},
 	}
@@ -63,6 +65,7 @@ func TestPositionIsHorizontal(t *testing.T) {
 		{"Right is horizontal", Right, true},
 		{"Top not horizontal", Top, false},
 		{"Bottom not horizontal", Bottom, false},
+		{"Decimal is horizontal", Decimal, true},
 	}
 
 	for _, tt := range tests {
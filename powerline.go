@@ -0,0 +1,52 @@
+package tuistyles
+
+import "strings"
+
+// Separator glyphs from powerline-patched fonts (Nerd Fonts include them).
+const (
+	PowerlineArrow   = "\ue0b0" // Solid right-pointing arrow
+	PowerlineRounded = "\ue0b4" // Solid right half circle
+)
+
+// PowerlineSegment pairs a segment's text with the Style that renders it,
+// so JoinPowerline can color the separators around it.
+type PowerlineSegment struct {
+	Content string
+	Style   Style
+}
+
+// JoinPowerline renders segments side by side on one line with sep between
+// them and after the last one, colored so each separator bridges its
+// neighbors: its foreground is the background of the segment before it and
+// its background that of the segment after. The final separator has no
+// background, so it ends the bar against the terminal's own.
+//
+// Segments are rendered as they are; give them padding for room around the
+// text. A segment without a background leaves its separators' matching
+// color unset.
+//
+// Example:
+//
+//	seg := NewStyle().Padding(0, 1)
+//	bar := JoinPowerline(PowerlineArrow,
+//		PowerlineSegment{Content: "~/src", Style: seg.Background(Color("blue"))},
+//		PowerlineSegment{Content: "main", Style: seg.Background(Color("magenta"))},
+//	)
+func JoinPowerline(sep string, segments ...PowerlineSegment) string {
+	var b strings.Builder
+	for i, seg := range segments {
+		b.WriteString(seg.Style.Render(seg.Content))
+
+		bridge := NewStyle()
+		if bg := seg.Style.background; bg != nil {
+			bridge = bridge.Foreground(*bg)
+		}
+		if i+1 < len(segments) {
+			if bg := segments[i+1].Style.background; bg != nil {
+				bridge = bridge.Background(*bg)
+			}
+		}
+		b.WriteString(bridge.Render(sep))
+	}
+	return b.String()
+}
@@ -0,0 +1,37 @@
+package tuistyles
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+func TestJoinPowerline(t *testing.T) {
+	blue := NewStyle().Background(Color("blue"))
+	green := NewStyle().Background(Color("green")).Padding(0, 1)
+
+	got := JoinPowerline(">",
+		PowerlineSegment{Content: "a", Style: blue},
+		PowerlineSegment{Content: "b", Style: green},
+	)
+	want := blue.Render("a") +
+		NewStyle().Foreground(Color("blue")).Background(Color("green")).Render(">") +
+		green.Render("b") +
+		NewStyle().Foreground(Color("green")).Render(">")
+	require.Equal(t, want, got)
+	require.Equal(t, "a> b >", measure.StripANSI(got))
+}
+
+func TestJoinPowerline_NoBackground(t *testing.T) {
+	got := JoinPowerline(PowerlineArrow,
+		PowerlineSegment{Content: "plain"},
+		PowerlineSegment{Content: "x", Style: NewStyle().Background(Color("red"))},
+	)
+	require.Equal(t, "plain"+NewStyle().Background(Color("red")).Render(PowerlineArrow)+
+		NewStyle().Background(Color("red")).Render("x")+
+		NewStyle().Foreground(Color("red")).Render(PowerlineArrow), got)
+
+	require.Empty(t, JoinPowerline(PowerlineArrow))
+}
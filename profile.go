@@ -0,0 +1,152 @@
+package tuistyles
+
+import (
+	"os"
+	"strings"
+
+	"github.com/orchard9/tui-styles/internal/ansi"
+)
+
+// ColorProfile is the range of colors a terminal can display.
+type ColorProfile int
+
+const (
+	// ProfileTrueColor displays 24-bit colors exactly
+	ProfileTrueColor ColorProfile = iota
+	// ProfileANSI256 displays the xterm 256-color palette
+	ProfileANSI256
+	// ProfileANSI16 displays only the 16 basic ANSI colors
+	ProfileANSI16
+)
+
+// String returns human-readable color profile name
+func (p ColorProfile) String() string {
+	switch p {
+	case ProfileTrueColor:
+		return "TrueColor"
+	case ProfileANSI256:
+		return "ANSI256"
+	case ProfileANSI16:
+		return "ANSI16"
+	default:
+		return "Unknown"
+	}
+}
+
+// DetectColorProfile probes the environment for the colors the terminal
+// can display.
+//
+// Heuristics: COLORTERM=truecolor or 24bit means true color; a TERM naming
+// a 256-color terminal (such as xterm-256color) means 256 colors; anything
+// else gets the 16 basic colors.
+func DetectColorProfile() ColorProfile {
+	return detectColorProfile(os.Getenv)
+}
+
+// detectColorProfile implements DetectColorProfile with an injectable environment
+func detectColorProfile(getenv func(string) string) ColorProfile {
+	switch strings.ToLower(getenv("COLORTERM")) {
+	case "truecolor", "24bit":
+		return ProfileTrueColor
+	}
+	if strings.Contains(getenv("TERM"), "256color") {
+		return ProfileANSI256
+	}
+	return ProfileANSI16
+}
+
+// Convert returns the nearest color p can display: hex colors become
+// 256-color codes for ProfileANSI256, and anything richer than the basic
+// colors becomes an ANSI name for ProfileANSI16 (see To256 and To16).
+//
+// Example:
+//
+//	Color("#FF0000").Convert(ProfileANSI256) // "196"
+func (c Color) Convert(p ColorProfile) Color {
+	switch p {
+	case ProfileANSI256:
+		return c.To256()
+	case ProfileANSI16:
+		return c.To16()
+	default:
+		return c
+	}
+}
+
+// CompleteColor specifies a color exactly for each color profile, so a
+// theme controls how it looks on limited terminals instead of relying on
+// automatic quantization.
+//
+// Unset entries are derived from the richest entry that is set. Resolve the
+// color for a terminal with Resolve or Renderer.Color.
+//
+// Example:
+//
+//	accent := CompleteColor{TrueColor: "#7D56F4", ANSI256: "99", ANSI16: "magenta"}
+//	s := NewStyle().Foreground(renderer.Color(accent))
+type CompleteColor struct {
+	TrueColor Color // Used on true color terminals
+	ANSI256   Color // Used on 256-color terminals
+	ANSI16    Color // Used on 16-color terminals
+}
+
+// Resolve returns the color to use with profile p.
+func (c CompleteColor) Resolve(p ColorProfile) Color {
+	candidates := []Color{c.TrueColor, c.ANSI256, c.ANSI16}
+	start := int(p)
+	if start < 0 || start >= len(candidates) {
+		start = 0
+	}
+
+	if exact := candidates[start]; exact != "" {
+		return exact
+	}
+	for i := start - 1; i >= 0; i-- {
+		if candidates[i] != "" {
+			return candidates[i].Convert(p)
+		}
+	}
+	for i := start + 1; i < len(candidates); i++ {
+		if candidates[i] != "" {
+			return candidates[i]
+		}
+	}
+	return ""
+}
+
+// CompleteAdaptiveColor is a CompleteColor chosen by terminal background,
+// like AdaptiveColor.
+type CompleteAdaptiveColor struct {
+	Light CompleteColor // Color for light terminal backgrounds
+	Dark  CompleteColor // Color for dark terminal backgrounds
+}
+
+// Resolve returns the color to use with profile p on the current terminal
+// background.
+func (c CompleteAdaptiveColor) Resolve(p ColorProfile) Color {
+	if ansi.IsLightTerminal() {
+		return c.Light.Resolve(p)
+	}
+	return c.Dark.Resolve(p)
+}
+
+// mapColors returns a copy of s with fn applied to every color property
+func (s Style) mapColors(fn func(Color) Color) Style {
+	for _, field := range []**Color{
+		&s.foreground, &s.background,
+		&s.paddingTopBackground, &s.paddingRightBackground,
+		&s.paddingBottomBackground, &s.paddingLeftBackground,
+		&s.borderForeground, &s.borderBackground,
+		&s.borderTopForeground, &s.borderRightForeground,
+		&s.borderBottomForeground, &s.borderLeftForeground,
+		&s.borderTopBackground, &s.borderRightBackground,
+		&s.borderBottomBackground, &s.borderLeftBackground,
+		&s.shadowColor,
+	} {
+		if *field != nil {
+			c := fn(**field)
+			*field = &c
+		}
+	}
+	return s
+}
@@ -0,0 +1,124 @@
+package tuistyles
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDetectColorProfile(t *testing.T) {
+	tests := []struct {
+		name string
+		env  map[string]string
+		want ColorProfile
+	}{
+		{"truecolor", map[string]string{"COLORTERM": "truecolor", "TERM": "xterm"}, ProfileTrueColor},
+		{"24bit", map[string]string{"COLORTERM": "24bit"}, ProfileTrueColor},
+		{"256color term", map[string]string{"TERM": "xterm-256color"}, ProfileANSI256},
+		{"basic term", map[string]string{"TERM": "xterm"}, ProfileANSI16},
+		{"nothing set", nil, ProfileANSI16},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, detectColorProfile(func(k string) string { return tt.env[k] }))
+		})
+	}
+}
+
+func TestColorProfile_String(t *testing.T) {
+	require.Equal(t, "TrueColor", ProfileTrueColor.String())
+	require.Equal(t, "ANSI256", ProfileANSI256.String())
+	require.Equal(t, "ANSI16", ProfileANSI16.String())
+	require.Equal(t, "Unknown", ColorProfile(9).String())
+}
+
+func TestColor_Convert(t *testing.T) {
+	require.Equal(t, Color("#FF0000"), Color("#FF0000").Convert(ProfileTrueColor))
+	require.Equal(t, Color("196"), Color("#FF0000").Convert(ProfileANSI256))
+	require.Equal(t, Color("bright-red"), Color("#FF0000").Convert(ProfileANSI16))
+	require.Equal(t, Color("bright-red"), Color("196").Convert(ProfileANSI16))
+	require.Equal(t, Color("blue"), Color("blue").Convert(ProfileANSI16))
+}
+
+func TestCompleteColor_Resolve(t *testing.T) {
+	full := CompleteColor{TrueColor: "#7D56F4", ANSI256: "99", ANSI16: "magenta"}
+	onlyHex := CompleteColor{TrueColor: "#FF0000"}
+	onlyBasic := CompleteColor{ANSI16: "red"}
+	noTrue := CompleteColor{ANSI256: "196", ANSI16: "red"}
+
+	tests := []struct {
+		name    string
+		color   CompleteColor
+		profile ColorProfile
+		want    Color
+	}{
+		{"exact true color", full, ProfileTrueColor, "#7D56F4"},
+		{"exact 256", full, ProfileANSI256, "99"},
+		{"exact 16", full, ProfileANSI16, "magenta"},
+		{"derived 256", onlyHex, ProfileANSI256, "196"},
+		{"derived 16", onlyHex, ProfileANSI16, "bright-red"},
+		{"16 from 256 before true color", CompleteColor{TrueColor: "#FF0000", ANSI256: "21"}, ProfileANSI16, "blue"},
+		{"richer profile uses poorer entry", noTrue, ProfileTrueColor, "196"},
+		{"basic only", onlyBasic, ProfileANSI256, "red"},
+		{"empty", CompleteColor{}, ProfileANSI256, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, tt.color.Resolve(tt.profile))
+		})
+	}
+}
+
+func TestCompleteAdaptiveColor_Resolve(t *testing.T) {
+	c := CompleteAdaptiveColor{
+		Light: CompleteColor{TrueColor: "#000000", ANSI16: "black"},
+		Dark:  CompleteColor{TrueColor: "#FFFFFF", ANSI16: "white"},
+	}
+
+	t.Setenv("COLORFGBG", "")
+	t.Setenv("TERM_BACKGROUND", "light")
+	require.Equal(t, Color("black"), c.Resolve(ProfileANSI16))
+
+	t.Setenv("TERM_BACKGROUND", "dark")
+	require.Equal(t, Color("#FFFFFF"), c.Resolve(ProfileTrueColor))
+}
+
+func TestRenderer_ColorProfile(t *testing.T) {
+	s := NewStyle().Foreground(Color("#FF0000")).Border(NormalBorder()).BorderForeground(Color("#0000FF"))
+
+	r := Renderer{}.ColorProfile(ProfileANSI256)
+	require.Equal(t, ProfileANSI256, r.Profile())
+	require.Equal(t,
+		CombineSGR(NewStyle().Foreground(Color("196")).Border(NormalBorder()).BorderForeground(Color("21")).Render("x"), SGRAttributesFirst),
+		r.Render(s, "x"))
+
+	require.Equal(t, CombineSGR(s.Render("x"), SGRAttributesFirst), Renderer{}.Render(s, "x"))
+	require.Equal(t, Color("99"), r.Color(CompleteColor{TrueColor: "#7D56F4", ANSI256: "99"}))
+}
+
+func TestStyle_MapColorsCoversEveryColor(t *testing.T) {
+	colorType := reflect.TypeOf((*Color)(nil))
+	want := 0
+	for i := 0; i < reflect.TypeOf(Style{}).NumField(); i++ {
+		if reflect.TypeOf(Style{}).Field(i).Type == colorType {
+			want++
+		}
+	}
+
+	seen := 0
+	NewStyle().
+		Foreground("a").Background("a").
+		PaddingTopBackground("a").PaddingRightBackground("a").
+		PaddingBottomBackground("a").PaddingLeftBackground("a").
+		BorderForeground("a").BorderBackground("a").
+		BorderTopForeground("a").BorderRightForeground("a").
+		BorderBottomForeground("a").BorderLeftForeground("a").
+		BorderTopBackground("a").BorderRightBackground("a").
+		BorderBottomBackground("a").BorderLeftBackground("a").
+		ShadowColor("a").
+		mapColors(func(c Color) Color { seen++; return c })
+	require.Equal(t, want, seen)
+}
@@ -0,0 +1,153 @@
+package tuistyles
+
+import (
+	"strings"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+// Prompt draws a question asking for text, for CLIs that read the answer
+// themselves and want consistent visuals around it:
+//
+//	? Project name (my-app) › tui-demo
+//	  ✖ name must be lowercase
+//
+// Render it again with Error set to re-prompt after invalid input. It only
+// renders; reading input is up to the caller. The zero Theme renders with
+// DefaultTheme.
+//
+// Example:
+//
+//	p := Prompt{Question: "Project name", Default: "my-app"}
+//	fmt.Print(p.Render(80))
+type Prompt struct {
+	Question string
+	Default  string // Shown as a hint in parentheses when set
+	Value    string // The answer so far, after the pointer
+	Error    string // Shown under the question
+	Theme    Theme
+
+	BadgeStyle    Style // Bold primary by default; red while Error is set
+	QuestionStyle Style // Bold by default
+	HintStyle     Style // Muted by default
+	ValueStyle    Style // Primary by default
+	ErrorStyle    Style // Error color by default
+}
+
+// Render draws the prompt within width cells, wrapping a long question
+// under itself and moving the hint and answer to the next line when they
+// do not fit after it. A width of 0 or less never wraps.
+func (p Prompt) Render(width int) string {
+	theme := p.Theme.WithDefaults()
+	styles := promptStylesFor(theme, p.BadgeStyle, p.QuestionStyle, p.HintStyle, p.ErrorStyle)
+
+	hint := ""
+	if p.Default != "" {
+		hint = "(" + p.Default + ")"
+	}
+	answer := styles.hint.Render("›")
+	if p.Value != "" {
+		answer += " " + orDefault(p.ValueStyle, NewStyle().Foreground(theme.Primary)).Render(p.Value)
+	}
+	return styles.render(p.Question, hint, answer, p.Error, width)
+}
+
+// Confirm draws a yes/no question, with the answer Enter picks in capitals:
+//
+//	? Delete 3 files? [y/N]
+//
+// It only renders; reading input is up to the caller. The zero Theme
+// renders with DefaultTheme.
+//
+// Example:
+//
+//	fmt.Print(Confirm{Question: "Delete 3 files?"}.Render(80))
+type Confirm struct {
+	Question string
+	Default  bool   // The answer Enter picks
+	Error    string // Shown under the question, such as "please answer y or n"
+	Theme    Theme
+
+	BadgeStyle    Style // Bold primary by default; red while Error is set
+	QuestionStyle Style // Bold by default
+	HintStyle     Style // Muted by default
+	ErrorStyle    Style // Error color by default
+}
+
+// Render draws the question within width cells, wrapping a long question
+// under itself and moving the hint to the next line when it does not fit
+// after it. A width of 0 or less never wraps.
+func (c Confirm) Render(width int) string {
+	theme := c.Theme.WithDefaults()
+	styles := promptStylesFor(theme, c.BadgeStyle, c.QuestionStyle, c.HintStyle, c.ErrorStyle)
+
+	hint := "[y/N]"
+	if c.Default {
+		hint = "[Y/n]"
+	}
+	return styles.render(c.Question, hint, "", c.Error, width)
+}
+
+// promptStyles are the resolved styles shared by Prompt and Confirm
+type promptStyles struct {
+	badge, question, hint, err Style
+}
+
+// promptStylesFor fills unset styles with the theme defaults
+func promptStylesFor(theme Theme, badge, question, hint, err Style) promptStyles {
+	return promptStyles{
+		badge:    orDefault(badge, NewStyle().Bold(true).Foreground(theme.Primary)),
+		question: orDefault(question, NewStyle().Bold(true)),
+		hint:     orDefault(hint, NewStyle().Foreground(theme.Muted)),
+		err:      orDefault(err, NewStyle().Foreground(theme.Error)),
+	}
+}
+
+// render lays out "? question hint answer" with any error below, indented
+// under the question
+func (s promptStyles) render(question, hint, answer, errText string, width int) string {
+	badge := s.badge
+	if errText != "" {
+		badge = s.err.inheritInline(s.badge)
+	}
+
+	textWidth := 0
+	if width > 0 {
+		textWidth = max(width-2, 1)
+	}
+	lines := strings.Split(Wrap(question, textWidth), "\n")
+	for i, line := range lines {
+		prefix := "  "
+		if i == 0 {
+			prefix = badge.Render("?") + " "
+		}
+		lines[i] = prefix + s.question.Render(line)
+	}
+
+	// The hint and answer follow the question, or go on lines of their own
+	// when they do not fit after it
+	var tail []string
+	if hint != "" {
+		tail = append(tail, s.hint.Render(hint))
+	}
+	if answer != "" {
+		tail = append(tail, answer)
+	}
+	if rest := strings.Join(tail, " "); rest != "" {
+		last := len(lines) - 1
+		if width <= 0 || measure.Width(lines[last])+1+measure.Width(rest) <= width {
+			lines[last] += " " + rest
+		} else {
+			for _, line := range strings.Split(Wrap(rest, textWidth), "\n") {
+				lines = append(lines, "  "+line)
+			}
+		}
+	}
+
+	if errText != "" {
+		for _, line := range strings.Split(Wrap("✖ "+errText, textWidth), "\n") {
+			lines = append(lines, "  "+s.err.Render(line))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
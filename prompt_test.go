@@ -0,0 +1,82 @@
+package tuistyles
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+func TestPrompt_Render(t *testing.T) {
+	tests := []struct {
+		name   string
+		prompt Prompt
+		width  int
+		want   string
+	}{
+		{"question only", Prompt{Question: "Project name"}, 0, "? Project name ›"},
+		{"default hint", Prompt{Question: "Project name", Default: "my-app"}, 0, "? Project name (my-app) ›"},
+		{"with value", Prompt{Question: "Name", Value: "demo"}, 0, "? Name › demo"},
+		{
+			name:   "error re-prompt",
+			prompt: Prompt{Question: "Name", Value: "Demo", Error: "must be lowercase"},
+			want:   "? Name › Demo\n  ✖ must be lowercase",
+		},
+		{
+			name:   "long question wraps",
+			prompt: Prompt{Question: "Which port should the server listen on", Default: "8080"},
+			width:  20,
+			want:   "? Which port should\n  the server listen\n  on (8080) ›",
+		},
+		{
+			name:   "hint on its own line",
+			prompt: Prompt{Question: "Output directory", Default: "./build/release/assets"},
+			width:  20,
+			want:   "? Output directory\n  (./build/release/a\n  ssets) ›",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.prompt.Render(tt.width)
+			require.Equal(t, tt.want, measure.StripANSI(got))
+			if tt.width > 0 {
+				require.LessOrEqual(t, Width(got), tt.width)
+			}
+		})
+	}
+}
+
+func TestPrompt_ErrorBadge(t *testing.T) {
+	theme := DefaultTheme()
+	ok := Prompt{Question: "Name"}.Render(0)
+	failed := Prompt{Question: "Name", Error: "required"}.Render(0)
+
+	require.Contains(t, ok, NewStyle().Bold(true).Foreground(theme.Primary).Render("?"))
+	require.Contains(t, failed, NewStyle().Bold(true).Foreground(theme.Error).Render("?"))
+}
+
+func TestConfirm_Render(t *testing.T) {
+	tests := []struct {
+		name    string
+		confirm Confirm
+		want    string
+	}{
+		{"default no", Confirm{Question: "Delete 3 files?"}, "? Delete 3 files? [y/N]"},
+		{"default yes", Confirm{Question: "Continue?", Default: true}, "? Continue? [Y/n]"},
+		{"error", Confirm{Question: "Continue?", Error: "please answer y or n"}, "? Continue? [y/N]\n  ✖ please answer y or n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, measure.StripANSI(tt.confirm.Render(80)))
+		})
+	}
+}
+
+func TestConfirm_HintOnItsOwnLine(t *testing.T) {
+	got := Confirm{Question: "Overwrite the existing config file?"}.Render(40)
+	require.Equal(t, "? Overwrite the existing config file?\n  [y/N]", measure.StripANSI(got))
+	require.LessOrEqual(t, Width(got), 40)
+}
@@ -0,0 +1,73 @@
+package tuistyles
+
+import (
+	"strings"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+// NonPrintingMarkers are the markers a shell needs around escape sequences
+// in a prompt. Line editors count every byte of a prompt as a cell unless
+// told otherwise, so raw SGR sequences make them misjudge the prompt's
+// width and redraw long command lines in the wrong place.
+type NonPrintingMarkers struct {
+	Start, End string
+}
+
+var (
+	// ReadlineMarkers are the \001 and \002 bytes GNU readline skips when
+	// measuring a prompt. They work in bash's PS1 and in any program that
+	// prompts through readline.
+	ReadlineMarkers = NonPrintingMarkers{Start: "\x01", End: "\x02"}
+	// ZshMarkers are the %{ and %} prompt escapes zsh uses for the same
+	// purpose. (fish measures escape sequences itself and needs none.)
+	ZshMarkers = NonPrintingMarkers{Start: "%{", End: "%}"}
+)
+
+// MarkNonPrinting wraps every run of escape sequences in s in markers, so
+// styled text can be used in a shell prompt. Text is unchanged. The zero
+// NonPrintingMarkers returns s unchanged.
+//
+// Example:
+//
+//	ps1 := MarkNonPrinting(NewStyle().Bold(true).Render("~/src"), ReadlineMarkers)
+//	// "\x01\x1b[1m\x02~/src\x01\x1b[0m\x02"
+func MarkNonPrinting(s string, markers NonPrintingMarkers) string {
+	if markers == (NonPrintingMarkers{}) || !strings.Contains(s, "\x1b") {
+		return s
+	}
+
+	var b strings.Builder
+	inEscapes := false
+	for _, seg := range measure.Segments(s) {
+		if seg.Escape != inEscapes {
+			if seg.Escape {
+				b.WriteString(markers.Start)
+			} else {
+				b.WriteString(markers.End)
+			}
+			inEscapes = seg.Escape
+		}
+		b.WriteString(seg.Text)
+	}
+	if inEscapes {
+		b.WriteString(markers.End)
+	}
+	return b.String()
+}
+
+// NonPrintingMarkers makes the renderer wrap the escape sequences it writes
+// in markers (see MarkNonPrinting), for output used inside a shell prompt.
+// The markers are added last, after any PostANSI hooks.
+//
+// Returns a new Renderer, leaving the original unchanged.
+//
+// Example:
+//
+//	r := NewRenderer().NonPrintingMarkers(ReadlineMarkers)
+//	fmt.Printf("PS1='%s $ '\n", r.Render(cwdStyle, cwd))
+func (r Renderer) NonPrintingMarkers(markers NonPrintingMarkers) Renderer {
+	r2 := r
+	r2.markers = markers
+	return r2
+}
@@ -0,0 +1,37 @@
+package tuistyles
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarkNonPrinting(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		markers NonPrintingMarkers
+		want    string
+	}{
+		{"plain", "~/src $ ", ReadlineMarkers, "~/src $ "},
+		{"readline", "\x1b[1m~/src\x1b[0m $ ", ReadlineMarkers, "\x01\x1b[1m\x02~/src\x01\x1b[0m\x02 $ "},
+		{"zsh", "\x1b[1m~/src\x1b[0m", ZshMarkers, "%{\x1b[1m%}~/src%{\x1b[0m%}"},
+		{"adjacent escapes share markers", "\x1b[0m\x1b[32mok", ReadlineMarkers, "\x01\x1b[0m\x1b[32m\x02ok"},
+		{"hyperlink", "\x1b]8;;http://x\x1b\\x\x1b]8;;\x1b\\", ReadlineMarkers, "\x01\x1b]8;;http://x\x1b\\\x02x\x01\x1b]8;;\x1b\\\x02"},
+		{"no markers", "\x1b[1mx", NonPrintingMarkers{}, "\x1b[1mx"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, MarkNonPrinting(tt.input, tt.markers))
+		})
+	}
+}
+
+func TestRenderer_NonPrintingMarkers(t *testing.T) {
+	style := NewStyle().Bold(true)
+	r := Renderer{}.NonPrintingMarkers(ReadlineMarkers)
+
+	require.Equal(t, "\x01\x1b[1m\x02~/src\x01\x1b[0m\x02", r.Render(style, "~/src"))
+	require.Equal(t, "\x1b[1m~/src\x1b[0m", Renderer{}.Render(style, "~/src"), "original renderer is unchanged")
+}
@@ -0,0 +1,93 @@
+package tuistyles
+
+import "strconv"
+
+//go:generate go run gen_palette.go
+
+// ansi16Names lists the ANSI color names for codes 0-15, in code order
+var ansi16Names = [16]Color{
+	"black", "red", "green", "yellow", "blue", "magenta", "cyan", "white",
+	"bright-black", "bright-red", "bright-green", "bright-yellow",
+	"bright-blue", "bright-magenta", "bright-cyan", "bright-white",
+}
+
+// To256 returns the nearest xterm 256-color code for c, as a Color such as "196".
+//
+// Matching uses perceptual CIE76 distance in L*a*b* space rather than raw
+// RGB distance, and only considers the fixed color cube and grayscale ramp
+// (codes 16-255), since codes 0-15 are commonly remapped by terminal themes.
+// Colors that are already 256-color codes or ANSI names are returned
+// unchanged; unparseable colors are returned unchanged.
+//
+// Example:
+//
+//	Color("#FF0000").To256() // "196"
+func (c Color) To256() Color {
+	if !c.isHex() {
+		return c
+	}
+	return Color(strconv.Itoa(nearestPaletteCode(c, 16, 256)))
+}
+
+// To16 returns the nearest of the 16 basic ANSI colors for c, as a color name
+// such as "bright-red". Colors that are already ANSI names are returned
+// unchanged; unparseable colors are returned unchanged.
+//
+// Example:
+//
+//	Color("#00FF00").To16() // "bright-green"
+func (c Color) To16() Color {
+	if IsANSIName(c) {
+		return c
+	}
+	if _, _, _, ok := c.RGB(); !ok {
+		return c
+	}
+	return ansi16Names[nearestPaletteCode(c, 0, 16)]
+}
+
+// IsANSIName reports whether c is one of the named 16-color ANSI colors
+func IsANSIName(c Color) bool {
+	return c != "" && c[0] != '#' && !isDigits(string(c)) && c.isValid()
+}
+
+// nearestPaletteCode returns the palette code in [from, to) closest to c
+func nearestPaletteCode(c Color, from, to int) int {
+	target := c.lab()
+	best, bestDist := from, -1.0
+	for code := from; code < to; code++ {
+		d := paletteLab[code].Distance(target)
+		if bestDist < 0 || d < bestDist {
+			best, bestDist = code, d
+		}
+	}
+	return best
+}
+
+// isHex reports whether c is a parseable hex color
+func (c Color) isHex() bool {
+	if len(c) == 0 || c[0] != '#' {
+		return false
+	}
+	_, _, _, ok := c.RGB()
+	return ok
+}
+
+// isValid reports whether c parses as any supported color format
+func (c Color) isValid() bool {
+	_, _, _, ok := c.RGB()
+	return ok
+}
+
+// isDigits reports whether s is non-empty and made only of ASCII digits
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
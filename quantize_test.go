@@ -0,0 +1,100 @@
+package tuistyles
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/orchard9/tui-styles/internal/ansi"
+	"github.com/orchard9/tui-styles/internal/colorspace"
+)
+
+func TestColor_To256(t *testing.T) {
+	tests := []struct {
+		input Color
+		want  Color
+	}{
+		{"#FF0000", "196"},
+		{"#00FF00", "46"},
+		{"#0000FF", "21"},
+		{"#FFFFFF", "231"},
+		{"#000000", "16"},
+		{"#808080", "244"},
+		{"#FF8700", "208"},
+		{"214", "214"},
+		{"red", "red"},
+		{"nope", "nope"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.input.To256(); got != tt.want {
+			t.Errorf("%s.To256() = %s, want %s", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestColor_To16(t *testing.T) {
+	tests := []struct {
+		input Color
+		want  Color
+	}{
+		{"#FF0000", "bright-red"},
+		{"#CD0000", "red"},
+		{"#00FF00", "bright-green"},
+		{"#000000", "black"},
+		{"#FFFFFF", "bright-white"},
+		{"#7F7F7F", "bright-black"},
+		{"196", "bright-red"},
+		{"blue", "blue"},
+		{"nope", "nope"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.input.To16(); got != tt.want {
+			t.Errorf("%s.To16() = %s, want %s", tt.input, got, tt.want)
+		}
+	}
+}
+
+// TestPaletteLab_UpToDate guards against the generated table drifting from the palette
+func TestPaletteLab_UpToDate(t *testing.T) {
+	for code := 0; code < 256; code++ {
+		r, g, b := ansi.PaletteRGB(code)
+		want := colorspace.RGBToLab(uint8(r), uint8(g), uint8(b))
+		if d := paletteLab[code].Distance(want); d > 0.001 {
+			t.Fatalf("paletteLab[%d] is stale (ΔE %f); run go generate", code, d)
+		}
+	}
+}
+
+func TestColor_To256_PaletteRoundTrip(t *testing.T) {
+	// Every cube/grayscale entry converted to hex must map back to itself
+	for code := 16; code < 256; code++ {
+		r, g, b := ansi.PaletteRGB(code)
+		hex := hexFromRGB(uint8(r), uint8(g), uint8(b))
+		got := hex.To256()
+		r2, g2, b2, _ := got.RGB()
+		if int(r2) != r || int(g2) != g || int(b2) != b {
+			t.Errorf("%s.To256() = %s, want equivalent of %s", hex, got, strconv.Itoa(code))
+		}
+	}
+}
+
+func TestIsANSIName(t *testing.T) {
+	for _, c := range []Color{"red", "bright-blue", "gray"} {
+		if !IsANSIName(c) {
+			t.Errorf("IsANSIName(%s) = false", c)
+		}
+	}
+	for _, c := range []Color{"", "#FF0000", "12", "nope"} {
+		if IsANSIName(c) {
+			t.Errorf("IsANSIName(%s) = true", c)
+		}
+	}
+}
+
+func BenchmarkColor_To256(b *testing.B) {
+	c := Color("#3C8DBC")
+	for i := 0; i < b.N; i++ {
+		_ = c.To256()
+	}
+}
@@ -0,0 +1,170 @@
+package tuistyles
+
+import (
+	"fmt"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+// Point is a cell position: column X and line Y, counted from 0 at the
+// top-left corner.
+type Point struct {
+	X, Y int
+}
+
+// Add returns p moved by q.
+func (p Point) Add(q Point) Point {
+	return Point{p.X + q.X, p.Y + q.Y}
+}
+
+// Dimensions is the size of a block of cells. (Size measures a rendered
+// string.)
+type Dimensions struct {
+	Width, Height int
+}
+
+// Rect is a rectangular area of cells: its top-left corner at column X and
+// line Y, and its size. A Rect with no width or height is empty.
+//
+// Rects are values; every operation returns a new Rect.
+//
+// Example:
+//
+//	screen := Rect{Width: 80, Height: 24}
+//	body := screen.Inset(1, 2) // inside a 1-line, 2-column margin
+//	visible := body.Intersect(popup)
+type Rect struct {
+	X, Y          int
+	Width, Height int
+}
+
+// RectAt returns the Rect with its top-left corner at p and size d.
+func RectAt(p Point, d Dimensions) Rect {
+	return Rect{X: p.X, Y: p.Y, Width: d.Width, Height: d.Height}
+}
+
+// Min returns the top-left cell of r.
+func (r Rect) Min() Point {
+	return Point{r.X, r.Y}
+}
+
+// Max returns the position just past the bottom-right cell of r: the first
+// column to its right and the first line below it.
+func (r Rect) Max() Point {
+	return Point{r.X + r.Width, r.Y + r.Height}
+}
+
+// Dimensions returns the size of r.
+func (r Rect) Dimensions() Dimensions {
+	return Dimensions{r.Width, r.Height}
+}
+
+// Empty reports whether r contains no cells.
+func (r Rect) Empty() bool {
+	return r.Width <= 0 || r.Height <= 0
+}
+
+// Contains reports whether the cell at p is inside r.
+func (r Rect) Contains(p Point) bool {
+	return p.X >= r.X && p.X < r.X+r.Width && p.Y >= r.Y && p.Y < r.Y+r.Height
+}
+
+// Offset returns r moved by p.
+func (r Rect) Offset(p Point) Rect {
+	return RectAt(r.Min().Add(p), r.Dimensions())
+}
+
+// Inset returns r shrunk on each side, using the same 1, 2, or 4 value
+// shorthand as Padding; negative values grow it. Insetting by more than
+// r's size leaves it empty. Panics if given 3 or 5+ arguments.
+func (r Rect) Inset(values ...int) Rect {
+	var top, right, bottom, left int
+	switch len(values) {
+	case 1:
+		top, right, bottom, left = values[0], values[0], values[0], values[0]
+	case 2:
+		top, right, bottom, left = values[0], values[1], values[0], values[1]
+	case 4:
+		top, right, bottom, left = values[0], values[1], values[2], values[3]
+	default:
+		panic(fmt.Sprintf("Inset() accepts 1, 2, or 4 arguments, got %d", len(values)))
+	}
+
+	return Rect{
+		X:      r.X + left,
+		Y:      r.Y + top,
+		Width:  max(r.Width-left-right, 0),
+		Height: max(r.Height-top-bottom, 0),
+	}
+}
+
+// Union returns the smallest Rect containing both r and o. An empty Rect
+// adds nothing.
+func (r Rect) Union(o Rect) Rect {
+	switch {
+	case o.Empty():
+		return r
+	case r.Empty():
+		return o
+	}
+	minX, minY := min(r.X, o.X), min(r.Y, o.Y)
+	maxX, maxY := max(r.X+r.Width, o.X+o.Width), max(r.Y+r.Height, o.Y+o.Height)
+	return Rect{X: minX, Y: minY, Width: maxX - minX, Height: maxY - minY}
+}
+
+// Intersect returns the cells r and o have in common, or the zero Rect
+// when they do not overlap.
+func (r Rect) Intersect(o Rect) Rect {
+	minX, minY := max(r.X, o.X), max(r.Y, o.Y)
+	maxX, maxY := min(r.X+r.Width, o.X+o.Width), min(r.Y+r.Height, o.Y+o.Height)
+	if maxX <= minX || maxY <= minY {
+		return Rect{}
+	}
+	return Rect{X: minX, Y: minY, Width: maxX - minX, Height: maxY - minY}
+}
+
+// RenderFunc renders the content f returns for the style's inner area, so
+// content can be laid out for exactly the space it will have instead of
+// guessing what is left after padding and border.
+//
+// f receives the content area: X and Y are the columns and lines the
+// padding and border take up on the left and top, and Width and Height are
+// the content size the style sets (Width or else MaxWidth, Height or else
+// MaxHeight). A dimension the style leaves unconstrained is 0.
+//
+// Example:
+//
+//	box := NewStyle().Width(30).Padding(1, 2).Border(RoundedBorder())
+//	fmt.Println(box.RenderFunc(func(inner Rect) string {
+//		return Wrap(description, inner.Width)
+//	}))
+func (s Style) RenderFunc(f func(inner Rect) string) string {
+	return s.Render(f(s.innerRect()))
+}
+
+// innerRect returns the content area of a box rendered with s
+func (s Style) innerRect() Rect {
+	inner := Rect{X: intOrZero(s.paddingLeft), Y: intOrZero(s.paddingTop)}
+	if s.hasBorder() {
+		if s.borderLeft == nil || *s.borderLeft {
+			inner.X += measure.Width(s.borderType.Left)
+		}
+		if s.borderTop == nil || *s.borderTop {
+			inner.Y++
+		}
+	}
+
+	switch {
+	case s.width != nil:
+		inner.Width = *s.width
+	case s.maxWidth != nil:
+		inner.Width = *s.maxWidth
+	}
+	switch {
+	case s.height != nil:
+		inner.Height = *s.height
+	case s.maxHeight != nil:
+		inner.Height = *s.maxHeight
+	}
+	return inner
+}
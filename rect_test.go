@@ -0,0 +1,109 @@
+package tuistyles
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStyle_RenderFunc(t *testing.T) {
+	tests := []struct {
+		name  string
+		style Style
+		want  Rect
+	}{
+		{"unconstrained", NewStyle(), Rect{}},
+		{"width and height", NewStyle().Width(20).Height(5), Rect{Width: 20, Height: 5}},
+		{"max size", NewStyle().MaxWidth(30).MaxHeight(4), Rect{Width: 30, Height: 4}},
+		{"width wins over max", NewStyle().Width(10).MaxWidth(30), Rect{Width: 10}},
+		{"padding", NewStyle().Width(10).Padding(1, 2), Rect{X: 2, Y: 1, Width: 10}},
+		{"border", NewStyle().Width(10).Border(RoundedBorder()), Rect{X: 1, Y: 1, Width: 10}},
+		{
+			"border and padding",
+			NewStyle().Width(10).Padding(1, 2).Border(NormalBorder()),
+			Rect{X: 3, Y: 2, Width: 10},
+		},
+		{
+			"hidden sides",
+			NewStyle().Width(10).Border(NormalBorder(), false, true, true, false),
+			Rect{Width: 10},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Rect
+			tt.style.RenderFunc(func(inner Rect) string {
+				got = inner
+				return ""
+			})
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestStyle_RenderFuncRendersResult(t *testing.T) {
+	s := NewStyle().Width(6).Align(Left).Border(NormalBorder())
+	got := s.RenderFunc(func(inner Rect) string {
+		return Wrap("one two three", inner.Width)
+	})
+
+	require.Equal(t, s.Render("one\ntwo\nthree"), got)
+}
+
+func TestRect_Geometry(t *testing.T) {
+	r := Rect{X: 2, Y: 1, Width: 4, Height: 3}
+
+	require.Equal(t, Point{2, 1}, r.Min())
+	require.Equal(t, Point{6, 4}, r.Max())
+	require.Equal(t, Dimensions{4, 3}, r.Dimensions())
+	require.Equal(t, r, RectAt(Point{2, 1}, Dimensions{4, 3}))
+	require.Equal(t, Rect{X: 3, Y: 3, Width: 4, Height: 3}, r.Offset(Point{1, 2}))
+	require.False(t, r.Empty())
+	require.True(t, Rect{Width: 3}.Empty())
+
+	require.True(t, r.Contains(Point{2, 1}))
+	require.True(t, r.Contains(Point{5, 3}))
+	require.False(t, r.Contains(Point{6, 3}), "Max is outside")
+	require.False(t, r.Contains(Point{1, 1}))
+}
+
+func TestRect_Inset(t *testing.T) {
+	r := Rect{Width: 10, Height: 6}
+
+	tests := []struct {
+		name   string
+		values []int
+		want   Rect
+	}{
+		{"all sides", []int{1}, Rect{X: 1, Y: 1, Width: 8, Height: 4}},
+		{"vertical and horizontal", []int{1, 2}, Rect{X: 2, Y: 1, Width: 6, Height: 4}},
+		{"each side", []int{1, 2, 3, 4}, Rect{X: 4, Y: 1, Width: 4, Height: 2}},
+		{"negative grows", []int{-1}, Rect{X: -1, Y: -1, Width: 12, Height: 8}},
+		{"too far", []int{4, 6}, Rect{X: 6, Y: 4}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, r.Inset(tt.values...))
+		})
+	}
+
+	require.Panics(t, func() { r.Inset(1, 2, 3) })
+}
+
+func TestRect_UnionIntersect(t *testing.T) {
+	a := Rect{X: 0, Y: 0, Width: 4, Height: 4}
+	b := Rect{X: 2, Y: 3, Width: 4, Height: 2}
+	apart := Rect{X: 10, Y: 10, Width: 1, Height: 1}
+
+	require.Equal(t, Rect{Width: 6, Height: 5}, a.Union(b))
+	require.Equal(t, a.Union(b), b.Union(a))
+	require.Equal(t, a, a.Union(Rect{X: 50, Y: 50}), "empty rects add nothing")
+	require.Equal(t, a, Rect{}.Union(a))
+
+	require.Equal(t, Rect{X: 2, Y: 3, Width: 2, Height: 1}, a.Intersect(b))
+	require.Equal(t, a.Intersect(b), b.Intersect(a))
+	require.Equal(t, Rect{}, a.Intersect(apart))
+	require.Equal(t, Rect{}, a.Intersect(Rect{X: 4, Width: 2, Height: 2}), "touching edges share no cells")
+}
@@ -2,6 +2,8 @@ package tuistyles
 
 import (
 	"strings"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/orchard9/tui-styles/internal/ansi"
 	"github.com/orchard9/tui-styles/internal/measure"
@@ -24,10 +26,33 @@ import (
 //	fmt.Println(styled) // Prints bold red text with 2 cells padding
 func (s Style) Render(str string) string {
 	// Allow rendering if we have padding or border, even with empty content
-	if str == "" && !s.hasPadding() && !s.hasBorder() {
+	if str == "" && !s.hasPadding() && !s.hasBorder() && !s.fillsEmpty() {
 		return ""
 	}
 
+	// A line starting with a combining mark would attach it to the padding
+	// or border drawn before it
+	str = isolateLeadingMarks(str)
+
+	// Resolve the writing direction (mirrors alignment for right-to-left text)
+	s = s.forDirection(str)
+
+	// Wrap to width when hyphenation or a long word policy is set
+	if s.width != nil && *s.width > 0 {
+		var h *Hyphenator
+		if s.hyphenate != nil && *s.hyphenate {
+			d := DefaultHyphenator()
+			h = &d
+		}
+		if h != nil || s.longWords != nil {
+			policy := BreakAnywhere
+			if s.longWords != nil {
+				policy = *s.longWords
+			}
+			str = wrapText(str, *s.width, h, policy)
+		}
+	}
+
 	// Apply basic rendering first
 	var content string
 	if str != "" {
@@ -40,11 +65,19 @@ func (s Style) Render(str string) string {
 		}
 	}
 
-	// Apply horizontal alignment if width is set (before padding)
-	if s.width != nil && s.align != nil {
+	// Apply horizontal alignment if width is set (before padding); decimal
+	// alignment lines up numbers even without one
+	if s.align != nil && *s.align == Decimal {
+		content = s.applyDecimalAlignment(content)
+	} else if s.width != nil && s.align != nil {
 		content = s.applyHorizontalAlignment(content)
 	}
 
+	// Right-to-left blocks start at the right edge even without a width
+	if s.isRTL(str) && s.width == nil && strings.Contains(content, "\n") {
+		content = s.Width(measure.MaxWidth(content)).applyHorizontalAlignment(content)
+	}
+
 	// Apply vertical alignment if height is set (before padding)
 	if s.height != nil {
 		content = s.applyVerticalAlignment(content)
@@ -60,9 +93,46 @@ func (s Style) Render(str string) string {
 		content = s.applyBorder(content)
 	}
 
+	// Apply drop shadow if set (outside the border)
+	if s.hasShadow() {
+		content = s.applyShadow(content)
+	}
+
 	return content
 }
 
+// isolateLeadingMarks gives each line that starts with a combining mark a
+// no-break space to combine with, as Unicode recommends for isolated marks.
+// Measured alone, such a mark takes a cell, but once a border or space is
+// placed before it the two combine into one cell and the line comes out a
+// cell short.
+func isolateLeadingMarks(str string) string {
+	if !strings.ContainsFunc(str, isMark) {
+		return str
+	}
+
+	lines := strings.Split(str, "\n")
+	for i, line := range lines {
+		offset := 0
+		for _, seg := range measure.Segments(line) {
+			if seg.Escape {
+				offset += len(seg.Text)
+				continue
+			}
+			if r, _ := utf8.DecodeRuneInString(seg.Text); isMark(r) {
+				lines[i] = line[:offset] + "\u00a0" + line[offset:]
+			}
+			break
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// isMark reports whether r is a combining mark
+func isMark(r rune) bool {
+	return unicode.In(r, unicode.Mn, unicode.Me, unicode.Mc)
+}
+
 // renderSingleLine applies styling to a single line of text
 func (s Style) renderSingleLine(str string) string {
 	var b strings.Builder
@@ -71,15 +141,7 @@ func (s Style) renderSingleLine(str string) string {
 	// Apply ANSI codes
 	b.WriteString(s.stylePrefix())
 
-	// Apply width constraint if set
-	if s.maxWidth != nil && *s.maxWidth > 0 {
-		width := measure.Width(str)
-		if width > *s.maxWidth {
-			str = measure.Truncate(str, *s.maxWidth, "...")
-		}
-	}
-
-	b.WriteString(str)
+	b.WriteString(s.scopeResets(s.prepareLine(str)))
 
 	// Reset if any style was applied
 	if s.hasAnyStyle() {
@@ -89,19 +151,25 @@ func (s Style) renderSingleLine(str string) string {
 	return b.String()
 }
 
+// prepareLine applies the width constraint to a line, then reorders it for
+// display (truncating first keeps the logical start of right-to-left text)
+func (s Style) prepareLine(line string) string {
+	if s.maxWidth != nil && *s.maxWidth > 0 {
+		width := measure.Width(line)
+		if width > *s.maxWidth {
+			line = s.truncateLine(line, *s.maxWidth)
+		}
+	}
+	return s.visualLine(line)
+}
+
 // renderMultiLine applies styling to multi-line text, styling each line independently
 func (s Style) renderMultiLine(str string) string {
 	lines := strings.Split(str, "\n")
 	styledLines := make([]string, len(lines))
 
 	for i, line := range lines {
-		// Apply width constraint per line if set
-		if s.maxWidth != nil && *s.maxWidth > 0 {
-			width := measure.Width(line)
-			if width > *s.maxWidth {
-				line = measure.Truncate(line, *s.maxWidth, "...")
-			}
-		}
+		line = s.prepareLine(line)
 
 		// Style each line independently
 		if line == "" {
@@ -113,7 +181,7 @@ func (s Style) renderMultiLine(str string) string {
 
 			// Apply ANSI codes to this line
 			b.WriteString(s.stylePrefix())
-			b.WriteString(line)
+			b.WriteString(s.scopeResets(line))
 
 			// Reset after each line to prevent style bleed
 			if s.hasAnyStyle() {
@@ -127,44 +195,63 @@ func (s Style) renderMultiLine(str string) string {
 	return strings.Join(styledLines, "\n")
 }
 
-// stylePrefix returns the ANSI prefix codes for this style
+// stylePrefix returns the escape sequence that opens this style: one SGR
+// sequence with every attribute and color, such as ESC[1;3;38;2;r;g;bm
 func (s Style) stylePrefix() string {
-	var b strings.Builder
-
-	// Apply text attributes
-	if s.bold != nil && *s.bold {
-		b.WriteString(ansi.Bold())
-	}
-	if s.faint != nil && *s.faint {
-		b.WriteString(ansi.Faint())
-	}
-	if s.italic != nil && *s.italic {
-		b.WriteString(ansi.Italic())
+	params := make([]string, 0, 9)
+
+	// Text attributes
+	for _, attr := range []struct {
+		on   *bool
+		code string
+	}{
+		{s.bold, "1"},
+		{s.faint, "2"},
+		{s.italic, "3"},
+		{s.underline, "4"},
+		{s.blink, "5"},
+		{s.reverse, "7"},
+		{s.strikethrough, "9"},
+	} {
+		if attr.on != nil && *attr.on {
+			params = append(params, attr.code)
+		}
 	}
-	if s.underline != nil && *s.underline {
-		b.WriteString(ansi.Underline())
+
+	// Colors
+	if fg := s.resolvedForeground(); fg != nil {
+		params = appendSGRParams(params, fg.ToANSI())
 	}
-	if s.blink != nil && *s.blink {
-		b.WriteString(ansi.Blink())
+	if s.background != nil {
+		params = appendSGRParams(params, s.background.ToANSIBackground())
 	}
-	if s.reverse != nil && *s.reverse {
-		b.WriteString(ansi.Reverse())
+
+	if len(params) == 0 {
+		return ""
 	}
-	if s.strikethrough != nil && *s.strikethrough {
-		b.WriteString(ansi.Strikethrough())
+	return "\x1b[" + strings.Join(params, ";") + "m"
+}
+
+// appendSGRParams appends the parameters of the SGR sequence seq, if any,
+// to params
+func appendSGRParams(params []string, seq string) []string {
+	if body, ok := strings.CutPrefix(seq, "\x1b["); ok && strings.HasSuffix(body, "m") && len(body) > 1 {
+		return append(params, body[:len(body)-1])
 	}
+	return params
+}
 
-	// Apply foreground color
+// resolvedForeground returns the explicit foreground color, or a contrasting
+// one derived from the background when AutoForeground is enabled
+func (s Style) resolvedForeground() *Color {
 	if s.foreground != nil {
-		b.WriteString(s.foreground.ToANSI())
+		return s.foreground
 	}
-
-	// Apply background color
-	if s.background != nil {
-		b.WriteString(s.background.ToANSIBackground())
+	if s.autoForeground != nil && *s.autoForeground && s.background != nil {
+		fg := s.background.ContrastingText()
+		return &fg
 	}
-
-	return b.String()
+	return nil
 }
 
 // String returns a string representation of the Style.
@@ -225,15 +312,12 @@ func (s Style) applyPadding(content string) string {
 		}
 	}
 
-	// Create padding space (with background color if set)
-	paddingSpace := s.makePaddingSpace(1)
-
 	// Build result
 	var b strings.Builder
 
 	// Top padding lines
 	if paddingTop > 0 {
-		topLine := s.makePaddingSpace(contentWidth + paddingLeft + paddingRight)
+		topLine := s.paddingRow(sideTop, contentWidth, paddingLeft, paddingRight)
 		for i := 0; i < paddingTop; i++ {
 			if i > 0 {
 				b.WriteString("\n")
@@ -253,7 +337,7 @@ func (s Style) applyPadding(content string) string {
 
 		// Left padding
 		if paddingLeft > 0 {
-			b.WriteString(strings.Repeat(paddingSpace, paddingLeft))
+			b.WriteString(s.paddingColumns(sideLeft, 0, paddingLeft))
 		}
 
 		// Content
@@ -264,15 +348,15 @@ func (s Style) applyPadding(content string) string {
 			lineWidth := measure.Width(line)
 			// Pad to content width, then add paddingRight
 			if lineWidth < contentWidth {
-				b.WriteString(strings.Repeat(" ", contentWidth-lineWidth))
+				b.WriteString(s.contentFill(paddingLeft+lineWidth, contentWidth-lineWidth))
 			}
-			b.WriteString(strings.Repeat(paddingSpace, paddingRight))
+			b.WriteString(s.paddingColumns(sideRight, paddingLeft+contentWidth, paddingRight))
 		}
 	}
 
 	// Bottom padding lines
 	if paddingBottom > 0 {
-		bottomLine := s.makePaddingSpace(contentWidth + paddingLeft + paddingRight)
+		bottomLine := s.paddingRow(sideBottom, contentWidth, paddingLeft, paddingRight)
 		for i := 0; i < paddingBottom; i++ {
 			b.WriteString("\n")
 			b.WriteString(bottomLine)
@@ -282,8 +366,41 @@ func (s Style) applyPadding(content string) string {
 	return b.String()
 }
 
-// makePaddingSpace creates a padding space string of specified width with background color
-func (s Style) makePaddingSpace(width int) string {
+// paddingRow builds one top or bottom padding row. The left and right
+// padding columns keep their own colors so a gutter runs the full height.
+func (s Style) paddingRow(side boxSide, contentWidth, paddingLeft, paddingRight int) string {
+	if s.paddingLeftBackground == nil && s.paddingRightBackground == nil {
+		return s.paddingFill(side, 0, contentWidth+paddingLeft+paddingRight)
+	}
+
+	return s.paddingFill(sideLeft, 0, paddingLeft) +
+		s.paddingFill(side, paddingLeft, contentWidth) +
+		s.paddingFill(sideRight, paddingLeft+contentWidth, paddingRight)
+}
+
+// paddingBackground returns the background for one padding side, preferring
+// the per-side color over the style background
+func (s Style) paddingBackground(side boxSide) *Color {
+	var sideBg *Color
+	switch side {
+	case sideTop:
+		sideBg = s.paddingTopBackground
+	case sideRight:
+		sideBg = s.paddingRightBackground
+	case sideBottom:
+		sideBg = s.paddingBottomBackground
+	case sideLeft:
+		sideBg = s.paddingLeftBackground
+	}
+
+	if sideBg != nil {
+		return sideBg
+	}
+	return s.background
+}
+
+// makeColoredSpace creates a run of spaces with an optional background color
+func makeColoredSpace(width int, bg *Color) string {
 	if width <= 0 {
 		return ""
 	}
@@ -291,15 +408,15 @@ func (s Style) makePaddingSpace(width int) string {
 	var b strings.Builder
 
 	// Apply background color if set
-	if s.background != nil {
-		b.WriteString(s.background.ToANSIBackground())
+	if bg != nil {
+		b.WriteString(bg.ToANSIBackground())
 	}
 
 	// Write spaces
 	b.WriteString(strings.Repeat(" ", width))
 
 	// Reset if background was applied
-	if s.background != nil {
+	if bg != nil {
 		b.WriteString(ansi.Reset())
 	}
 
@@ -355,7 +472,7 @@ func (s Style) applyBorder(content string) string {
 	for _, line := range lines {
 		// Left border
 		if leftEnabled {
-			result.WriteString(s.styleBorderChar(border.Left))
+			result.WriteString(s.styleBorderChar(border.Left, sideLeft))
 		}
 
 		// Content
@@ -369,7 +486,7 @@ func (s Style) applyBorder(content string) string {
 
 		// Right border
 		if rightEnabled {
-			result.WriteString(s.styleBorderChar(border.Right))
+			result.WriteString(s.styleBorderChar(border.Right, sideRight))
 		}
 
 		result.WriteString("\n")
@@ -389,6 +506,7 @@ func (s Style) renderBorderLine(border Border, contentWidth int, isTop bool, lef
 
 	// Select appropriate horizontal character and corners
 	var horizontal, leftCorner, rightCorner string
+	side := sideTop
 	if isTop {
 		horizontal = border.Top
 		leftCorner = border.TopLeft
@@ -397,46 +515,48 @@ func (s Style) renderBorderLine(border Border, contentWidth int, isTop bool, lef
 		horizontal = border.Bottom
 		leftCorner = border.BottomLeft
 		rightCorner = border.BottomRight
+		side = sideBottom
 	}
 
 	// Left corner
 	if leftEnabled {
-		b.WriteString(s.styleBorderChar(leftCorner))
+		b.WriteString(s.styleBorderChar(leftCorner, side))
 	}
 
 	// Horizontal line
-	b.WriteString(s.styleBorderChar(strings.Repeat(horizontal, contentWidth)))
+	b.WriteString(s.styleBorderChar(strings.Repeat(horizontal, contentWidth), side))
 
 	// Right corner
 	if rightEnabled {
-		b.WriteString(s.styleBorderChar(rightCorner))
+		b.WriteString(s.styleBorderChar(rightCorner, side))
 	}
 
 	return b.String()
 }
 
-// styleBorderChar applies border colors to a border character
-func (s Style) styleBorderChar(char string) string {
-	if s.borderForeground == nil && s.borderBackground == nil {
+// styleBorderChar applies the colors of the given border side to a border character
+func (s Style) styleBorderChar(char string, side boxSide) string {
+	fg, bg := s.borderColors(side)
+	if fg == nil && bg == nil {
 		return char
 	}
 
-	var b strings.Builder
-
-	// Apply border colors
-	if s.borderForeground != nil {
-		b.WriteString(s.borderForeground.ToANSI())
+	var params []string
+	if fg != nil {
+		params = appendSGRParams(params, fg.ToANSI())
 	}
-	if s.borderBackground != nil {
-		b.WriteString(s.borderBackground.ToANSIBackground())
+	if bg != nil {
+		params = appendSGRParams(params, bg.ToANSIBackground())
 	}
 
+	var b strings.Builder
+	if len(params) > 0 {
+		b.WriteString("\x1b[" + strings.Join(params, ";") + "m")
+	}
 	b.WriteString(char)
 
-	// Reset if any border color was applied
-	if s.borderForeground != nil || s.borderBackground != nil {
-		b.WriteString(ansi.Reset())
-	}
+	// Reset since at least one border color was applied
+	b.WriteString(ansi.Reset())
 
 	return b.String()
 }
@@ -465,22 +585,22 @@ func (s Style) applyHorizontalAlignment(content string) string {
 			case Left:
 				// Left align: content on left, padding on right
 				result.WriteString(line)
-				result.WriteString(s.makeAlignmentSpace(padding))
+				result.WriteString(s.makeAlignmentSpace(lineWidth, padding))
 			case Center:
 				// Center align: distribute padding on both sides
 				leftPad := padding / 2
 				rightPad := padding - leftPad
-				result.WriteString(s.makeAlignmentSpace(leftPad))
+				result.WriteString(s.makeAlignmentSpace(0, leftPad))
 				result.WriteString(line)
-				result.WriteString(s.makeAlignmentSpace(rightPad))
+				result.WriteString(s.makeAlignmentSpace(leftPad+lineWidth, rightPad))
 			case Right:
 				// Right align: padding on left, content on right
-				result.WriteString(s.makeAlignmentSpace(padding))
+				result.WriteString(s.makeAlignmentSpace(0, padding))
 				result.WriteString(line)
 			default:
 				// Default to left alignment
 				result.WriteString(line)
-				result.WriteString(s.makeAlignmentSpace(padding))
+				result.WriteString(s.makeAlignmentSpace(lineWidth, padding))
 			}
 		}
 
@@ -493,11 +613,15 @@ func (s Style) applyHorizontalAlignment(content string) string {
 	return result.String()
 }
 
-// makeAlignmentSpace creates alignment padding spaces (respects background color if set)
-func (s Style) makeAlignmentSpace(width int) string {
+// makeAlignmentSpace creates alignment padding spaces starting at content
+// column col (respects background color and pattern if set)
+func (s Style) makeAlignmentSpace(col, width int) string {
 	if width <= 0 {
 		return ""
 	}
+	if s.hasBackgroundPattern() {
+		return s.contentFill(col+intOrZero(s.paddingLeft), width)
+	}
 
 	var b strings.Builder
 
@@ -551,12 +675,12 @@ func (s Style) applyVerticalAlignment(content string) string {
 		if lineWidth < emptyLineWidth {
 			// Pad line to match width
 			padding := emptyLineWidth - lineWidth
-			lines[i] += s.makeAlignmentSpace(padding)
+			lines[i] += s.makeAlignmentSpace(lineWidth, padding)
 		}
 	}
 
 	// Create empty line with background color if set
-	emptyLine := s.makeAlignmentSpace(emptyLineWidth)
+	emptyLine := s.makeAlignmentSpace(0, emptyLineWidth)
 
 	paddingLines := targetHeight - currentHeight
 
@@ -75,7 +75,7 @@ func TestRender(t *testing.T) {
 				return NewStyle().Bold(true).Italic(true)
 			},
 			input: "bold italic",
-			want:  ansi.Bold() + ansi.Italic() + "bold italic" + ansi.Reset(),
+			want:  "\x1b[1;3mbold italic" + ansi.Reset(),
 		},
 		{
 			name: "all text attributes",
@@ -87,8 +87,7 @@ func TestRender(t *testing.T) {
 					Strikethrough(true)
 			},
 			input: "all attrs",
-			want: ansi.Bold() + ansi.Italic() + ansi.Underline() +
-				ansi.Strikethrough() + "all attrs" + ansi.Reset(),
+			want:  "\x1b[1;3;4;9mall attrs" + ansi.Reset(),
 		},
 		{
 			name: "foreground color red",
@@ -125,7 +124,7 @@ func TestRender(t *testing.T) {
 				return NewStyle().Foreground(red).Background(blue)
 			},
 			input: "red on blue",
-			want:  "\x1b[31m\x1b[44mred on blue\x1b[0m",
+			want:  "\x1b[31;44mred on blue\x1b[0m",
 		},
 		{
 			name: "bold red text",
@@ -134,7 +133,7 @@ func TestRender(t *testing.T) {
 				return NewStyle().Bold(true).Foreground(red)
 			},
 			input: "bold red",
-			want:  ansi.Bold() + "\x1b[31mbold red\x1b[0m",
+			want:  "\x1b[1;31mbold red\x1b[0m",
 		},
 		{
 			name: "complex styling",
@@ -148,8 +147,7 @@ func TestRender(t *testing.T) {
 					Background(bg)
 			},
 			input: "complex",
-			want: ansi.Bold() + ansi.Italic() +
-				"\x1b[38;2;255;0;0m\x1b[48;2;0;0;255mcomplex\x1b[0m",
+			want:  "\x1b[1;3;38;2;255;0;0;48;2;0;0;255mcomplex\x1b[0m",
 		},
 		{
 			name: "multi-line text with per-line styling",
@@ -1061,6 +1059,35 @@ func BenchmarkRender(b *testing.B) {
 	}
 }
 
+// BenchmarkRenderOutputSize reports how many bytes a styled multi-line
+// render emits, since output size matters over slow links such as SSH
+func BenchmarkRenderOutputSize(b *testing.B) {
+	input := "line1\nline2\nline3\nline4\nline5"
+
+	benchmarks := []struct {
+		name  string
+		style Style
+	}{
+		{"bold", NewStyle().Bold(true)},
+		{"attributes and colors", NewStyle().Bold(true).Italic(true).Underline(true).
+			Foreground(Color("#FF8800")).Background(Color("#002244"))},
+		{"bordered", NewStyle().Bold(true).Foreground(Color("#FF8800")).Background(Color("#002244")).
+			Border(RoundedBorder()).BorderForeground(Color("#888888")).BorderBackground(Color("#002244")).
+			Padding(0, 1)},
+	}
+
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			b.ReportAllocs()
+			var out string
+			for i := 0; i < b.N; i++ {
+				out = bm.style.Render(input)
+			}
+			b.ReportMetric(float64(len(out)), "output-bytes")
+		})
+	}
+}
+
 // TestVerticalAlignment tests vertical alignment and height handling
 func TestVerticalAlignment(t *testing.T) {
 	tests := []struct {
@@ -1254,3 +1281,31 @@ func TestVerticalAlignment(t *testing.T) {
 		})
 	}
 }
+
+func TestIsolateLeadingMarks(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{"no marks", "abc", "abc"},
+		{"mark after base", "e\u0301", "e\u0301"},
+		{"leading mark", "\u0301x", "\u00a0\u0301x"},
+		{"leading mark after escape", "\x1b[1m\u0734\x1b[0m", "\x1b[1m\u00a0\u0734\x1b[0m"},
+		{"second line", "a\n\u0301b", "a\n\u00a0\u0301b"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isolateLeadingMarks(tt.input); got != tt.want {
+				t.Errorf("isolateLeadingMarks(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+
+	out := NewStyle().Border(NormalBorder()).Render("\u0734")
+	widths := measure.WidthPerLine(out)
+	if widths[0] != widths[1] {
+		t.Errorf("bordered leading mark line widths %v, want equal", widths)
+	}
+}
@@ -0,0 +1,261 @@
+package tuistyles
+
+import (
+	"maps"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/orchard9/tui-styles/capabilities"
+)
+
+// Renderer renders styles for a particular output terminal.
+//
+// Style.Render assumes a fully capable terminal. A Renderer adapts styles to
+// what its terminal can actually display before rendering - for example,
+// swapping rounded borders for plain box drawing on fonts that would show
+// tofu. Like Style, Renderer is immutable: builder methods return a new
+// Renderer, so one can be shared freely between goroutines.
+//
+// Example:
+//
+//	r := NewRenderer() // probes the environment
+//	fmt.Println(r.Render(NewStyle().Border(RoundedBorder()), "Hello"))
+type Renderer struct {
+	glyphs      GlyphSupport       // Glyphs the terminal can display
+	safeBorders bool               // Always restrict borders to the legacy box drawing set
+	width       int                // Terminal width in cells (0 if unknown)
+	height      int                // Terminal height in lines (0 if unknown)
+	debug       bool               // Draw layout guides (see Debug)
+	profile     ColorProfile       // Colors the terminal can display
+	target      OutputTarget       // Kind of output written (color, monochrome, plain, or ASCII)
+	glyphMap    map[string]string  // Extra glyph replacements; never modified after construction
+	emoji       EmojiMode          // How emoji are drawn
+	hooks       []RenderHook       // Run around every render; never modified after construction
+	metrics     MetricsRecorder    // Receives render stats (nil when not recording)
+	sgrOrder    SGROrder           // Order of combined SGR parameters
+	markers     NonPrintingMarkers // Wrapped around escape sequences for shell prompts
+	strictASCII bool               // Replace everything outside 7-bit ASCII in the output
+}
+
+// NewRenderer returns a Renderer configured from the environment (see
+// DetectGlyphSupport, DetectColorProfile, DetectOutputTarget, and
+// DetectTerminalSize). Debug mode is enabled when the TUISTYLES_DEBUG
+// environment variable is true, and StrictASCII when TUISTYLES_ASCII is.
+func NewRenderer() Renderer {
+	width, height := DetectTerminalSize()
+	debug, _ := strconv.ParseBool(os.Getenv(DebugEnv)) //nolint:errcheck // unset or invalid means off
+	ascii, _ := strconv.ParseBool(os.Getenv(ASCIIEnv)) //nolint:errcheck // unset or invalid means off
+	return Renderer{
+		glyphs:      DetectGlyphSupport(),
+		profile:     DetectColorProfile(),
+		target:      DetectOutputTarget(),
+		width:       width,
+		height:      height,
+		debug:       debug,
+		strictASCII: ascii,
+	}
+}
+
+// NewRendererFor returns a Renderer for a terminal with the given
+// capabilities, taking its glyph support and color profile from them
+// instead of probing the environment. The terminal size and debug mode are
+// detected as in NewRenderer.
+//
+// Example:
+//
+//	caps, _ := capabilities.Detect().Query(tty, 100*time.Millisecond)
+//	r := NewRendererFor(caps)
+func NewRendererFor(c capabilities.Capabilities) Renderer {
+	r := NewRenderer()
+	r.glyphs = glyphsFor(c.Unicode)
+	r.profile = profileFor(c.Colors)
+	return r
+}
+
+// glyphsFor maps a capabilities Unicode level to a GlyphSupport level
+func glyphsFor(level capabilities.UnicodeLevel) GlyphSupport {
+	switch level {
+	case capabilities.UnicodeASCII:
+		return GlyphsASCII
+	case capabilities.UnicodeBoxDrawing:
+		return GlyphsBoxDrawing
+	default:
+		return GlyphsFull
+	}
+}
+
+// profileFor maps a capabilities color level to a ColorProfile; terminals
+// without color get the basic colors, the least a style can ask for
+func profileFor(level capabilities.ColorLevel) ColorProfile {
+	switch level {
+	case capabilities.ColorTrue:
+		return ProfileTrueColor
+	case capabilities.Color256:
+		return ProfileANSI256
+	default:
+		return ProfileANSI16
+	}
+}
+
+// GlyphSupport overrides the detected glyph support level.
+//
+// Returns a new Renderer, leaving the original unchanged.
+//
+// Example:
+//
+//	r := NewRenderer().GlyphSupport(GlyphsASCII) // force +-| borders
+func (r Renderer) GlyphSupport(level GlyphSupport) Renderer {
+	r2 := r
+	r2.glyphs = level
+	return r2
+}
+
+// SafeBorders restricts borders to the basic box drawing set regardless of
+// detection, trading rounded and heavy corners for glyphs that every
+// Unicode-capable font includes. ASCII-only detection still wins.
+//
+// Returns a new Renderer, leaving the original unchanged.
+//
+// Example:
+//
+//	r := NewRenderer().SafeBorders(true)
+func (r Renderer) SafeBorders(v bool) Renderer {
+	r2 := r
+	r2.safeBorders = v
+	return r2
+}
+
+// ColorProfile overrides the detected color profile. Colors the profile
+// cannot display are converted to the nearest color it can when rendering.
+//
+// Returns a new Renderer, leaving the original unchanged.
+//
+// Example:
+//
+//	r := NewRenderer().ColorProfile(ProfileANSI256)
+func (r Renderer) ColorProfile(p ColorProfile) Renderer {
+	r2 := r
+	r2.profile = p
+	return r2
+}
+
+// Profile returns the renderer's color profile.
+func (r Renderer) Profile() ColorProfile {
+	return r.profile
+}
+
+// Color resolves a CompleteColor for the renderer's color profile.
+//
+// Example:
+//
+//	fg := r.Color(CompleteColor{TrueColor: "#7D56F4", ANSI256: "99", ANSI16: "magenta"})
+func (r Renderer) Color(c CompleteColor) Color {
+	return c.Resolve(r.profile)
+}
+
+// TerminalSize overrides the detected terminal size, for example after a
+// resize signal or when rendering for a fixed-size output. Negative values
+// are clamped to 0, which means unknown.
+//
+// Returns a new Renderer, leaving the original unchanged.
+//
+// Example:
+//
+//	r := NewRenderer().TerminalSize(120, 40)
+func (r Renderer) TerminalSize(width, height int) Renderer {
+	r2 := r
+	r2.width = max(width, 0)
+	r2.height = max(height, 0)
+	return r2
+}
+
+// Size returns the terminal size in cells, or 0 for an unknown dimension.
+func (r Renderer) Size() (width, height int) {
+	return r.width, r.height
+}
+
+// GlyphMap adds glyph replacements applied to everything the renderer
+// draws, on top of the built-in fallbacks for its glyph support level (see
+// FallbackGlyphs). Use it for glyphs a particular font lacks, or to pick
+// different ASCII equivalents. Each key is a single character; a
+// replacement narrower than its character is padded with spaces, and one
+// wider is ignored, so layouts keep their shape. The map is copied.
+//
+// Returns a new Renderer, leaving the original unchanged.
+//
+// Example:
+//
+//	r := NewRenderer().GlyphMap(map[string]string{"▸": "-", "✓": "+"})
+func (r Renderer) GlyphMap(m map[string]string) Renderer {
+	r2 := r
+	r2.glyphMap = maps.Clone(m)
+	return r2
+}
+
+// Glyphs returns the effective glyph support level used for borders.
+func (r Renderer) Glyphs() GlyphSupport {
+	if r.asciiOnly() {
+		return GlyphsASCII
+	}
+	if r.safeBorders && r.glyphs == GlyphsFull {
+		return GlyphsBoxDrawing
+	}
+	return r.glyphs
+}
+
+// Render renders str with s, adapted to the renderer's terminal and output
+// target. Percent sizes resolve against the terminal size (see Resolve).
+// Glyphs in str that the terminal cannot display are replaced too (see
+// FallbackGlyphs and GlyphMap), as are emoji when an EmojiMode asks for
+// it. Hooks added with Hooks run around each stage, and a recorder set
+// with Metrics receives the stats of each call.
+func (r Renderer) Render(s Style, str string) string {
+	if r.metrics == nil {
+		return r.render(s, str)
+	}
+	start := time.Now()
+	out := r.render(s, str)
+	w, h := Size(out)
+	r.metrics.RecordRender(RenderStats{Duration: time.Since(start), Bytes: len(out), Width: w, Height: h})
+	return out
+}
+
+// render implements Render
+func (r Renderer) render(s Style, str string) string {
+	s, str = preRender(r.hooks, s, ReplaceEmoji(str, r.emoji))
+	var out string
+	if r.debug {
+		out = renderDebug(r.adapt(s), str)
+	} else {
+		out = r.adapt(s).Render(str)
+	}
+	out = postLayout(r.hooks, out)
+	ascii := r.asciiOnly()
+	glyphs := r.glyphs
+	if ascii {
+		glyphs = GlyphsASCII
+	}
+	out = r.forTarget(translateGlyphs(out, glyphs, r.glyphMap))
+	out = postANSI(r.hooks, CombineSGR(out, r.sgrOrder))
+	out = MarkNonPrinting(out, r.markers)
+	if ascii {
+		out = ToASCII(out)
+	}
+	return out
+}
+
+// adapt returns a copy of s with properties the terminal cannot display replaced
+func (r Renderer) adapt(s Style) Style {
+	s = s.Resolve(r.width, r.height)
+	if s.borderType != nil {
+		if level := r.Glyphs(); level != GlyphsFull {
+			border := s.borderType.Fallback(level)
+			s.borderType = &border
+		}
+	}
+	if r.profile != ProfileTrueColor {
+		s = s.mapColors(func(c Color) Color { return c.Convert(r.profile) })
+	}
+	return s
+}
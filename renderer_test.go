@@ -0,0 +1,75 @@
+package tuistyles
+
+import (
+	"testing"
+
+	"github.com/orchard9/tui-styles/capabilities"
+)
+
+func TestRenderer_Render(t *testing.T) {
+	s := NewStyle().Border(RoundedBorder())
+
+	tests := []struct {
+		name     string
+		renderer Renderer
+		want     string
+	}{
+		{"full support", Renderer{}.GlyphSupport(GlyphsFull), s.Render("x")},
+		{"box drawing", Renderer{}.GlyphSupport(GlyphsBoxDrawing), NewStyle().Border(NormalBorder()).Render("x")},
+		{"ASCII", Renderer{}.GlyphSupport(GlyphsASCII), NewStyle().Border(ASCIIBorder()).Render("x")},
+		{"safe borders", Renderer{}.GlyphSupport(GlyphsFull).SafeBorders(true), NewStyle().Border(NormalBorder()).Render("x")},
+		{"safe borders keeps ASCII", Renderer{}.GlyphSupport(GlyphsASCII).SafeBorders(true), NewStyle().Border(ASCIIBorder()).Render("x")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.renderer.Render(s, "x"); got != tt.want {
+				t.Errorf("Render() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRenderer_Immutable(t *testing.T) {
+	r1 := Renderer{}.GlyphSupport(GlyphsFull)
+	r2 := r1.SafeBorders(true)
+
+	if r1.Glyphs() != GlyphsFull {
+		t.Error("SafeBorders mutated the receiver")
+	}
+	if r2.Glyphs() != GlyphsBoxDrawing {
+		t.Errorf("SafeBorders(true).Glyphs() = %s, want BoxDrawing", r2.Glyphs())
+	}
+}
+
+func TestRenderer_DoesNotMutateStyle(t *testing.T) {
+	s := NewStyle().Border(RoundedBorder())
+	_ = Renderer{}.GlyphSupport(GlyphsASCII).Render(s, "x")
+	if *s.borderType != RoundedBorder() {
+		t.Error("Renderer.Render mutated the style's border")
+	}
+}
+
+func TestRenderer_TerminalSize(t *testing.T) {
+	r1 := Renderer{}.TerminalSize(100, 30)
+	r2 := r1.TerminalSize(-1, 50)
+
+	if w, h := r1.Size(); w != 100 || h != 30 {
+		t.Errorf("Size() = %d, %d, want 100, 30", w, h)
+	}
+	if w, h := r2.Size(); w != 0 || h != 50 {
+		t.Errorf("Size() = %d, %d, want 0, 50", w, h)
+	}
+}
+
+func TestNewRendererFor(t *testing.T) {
+	r := NewRendererFor(capabilities.Capabilities{Colors: capabilities.Color256, Unicode: capabilities.UnicodeBoxDrawing})
+	if r.Profile() != ProfileANSI256 || r.Glyphs() != GlyphsBoxDrawing {
+		t.Errorf("NewRendererFor() profile %s glyphs %s, want ANSI256 BoxDrawing", r.Profile(), r.Glyphs())
+	}
+
+	r = NewRendererFor(capabilities.Capabilities{Colors: capabilities.ColorNone, Unicode: capabilities.UnicodeASCII})
+	if r.Profile() != ProfileANSI16 || r.Glyphs() != GlyphsASCII {
+		t.Errorf("NewRendererFor() profile %s glyphs %s, want ANSI16 ASCII", r.Profile(), r.Glyphs())
+	}
+}
@@ -0,0 +1,69 @@
+package tuistyles
+
+import (
+	"strings"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+// LineStyleFunc picks the style of line i of a block, given its text. It
+// styles lines for Style.RenderLines and rows for Table and List.
+type LineStyleFunc func(i int, line string) Style
+
+// RenderLines renders lines as one block in s, letting f pick a style for
+// each line: zebra striping, a highlighted selection, or error rows in red,
+// without splitting the block into separate Render calls that each measure
+// and align on their own.
+//
+// The style f returns for line i is layered over s, so it only needs the
+// properties that change, and it covers the whole row: every line is as
+// wide as the widest (or as Width), so a row background runs edge to edge.
+// Padding, border, shadow, Height, and vertical alignment come from s and
+// apply once around the block. A nil f renders the lines in s.
+//
+// Example:
+//
+//	selected := 2
+//	fmt.Println(list.RenderLines(items, func(i int, _ string) Style {
+//		if i == selected {
+//			return NewStyle().Reverse(true)
+//		}
+//		return NewStyle()
+//	}))
+func (s Style) RenderLines(lines []string, f LineStyleFunc) string {
+	if f == nil {
+		return s.Render(strings.Join(lines, "\n"))
+	}
+
+	// Every row shares one width so row styles fill the block evenly
+	row := s.withoutFrame()
+	row.height, row.alignVertical = nil, nil
+	if row.align == nil {
+		row = row.Align(Left)
+	}
+	if row.width == nil {
+		width := 0
+		for _, line := range lines {
+			width = max(width, measure.MaxWidth(line))
+		}
+		if row.maxWidth != nil {
+			width = min(width, *row.maxWidth)
+		}
+		row = row.Width(width)
+	}
+
+	rendered := make([]string, len(lines))
+	for i, line := range lines {
+		style := f(i, line).withoutFrame().over(row)
+		style.height, style.alignVertical = nil, nil
+		if line == "" && style.width != nil && *style.width > 0 {
+			// Render skips empty text; a space still gets the row filled
+			line = " "
+		}
+		rendered[i] = style.Render(line)
+	}
+
+	block := s.frameOnly()
+	block.height, block.alignVertical = s.height, s.alignVertical
+	return block.Render(strings.Join(rendered, "\n"))
+}
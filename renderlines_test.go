@@ -0,0 +1,52 @@
+package tuistyles
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+func TestStyle_RenderLines(t *testing.T) {
+	lines := []string{"one", "two", "", "three"}
+	highlight := NewStyle().Background(Color("#303030"))
+
+	t.Run("rows share the block width", func(t *testing.T) {
+		got := NewStyle().RenderLines(lines, func(i int, _ string) Style {
+			if i%2 == 1 {
+				return highlight
+			}
+			return NewStyle()
+		})
+		for _, line := range strings.Split(got, "\n") {
+			require.Equal(t, 5, measure.Width(line))
+		}
+		require.Equal(t, "one  \ntwo  \n     \nthree", measure.StripANSI(got))
+		require.Equal(t, highlight.Width(5).Align(Left).Render("two"), strings.Split(got, "\n")[1])
+	})
+
+	t.Run("alignment and frame from the block style", func(t *testing.T) {
+		block := NewStyle().Width(7).Align(Right).Border(NormalBorder())
+		got := block.RenderLines(lines[:2], func(int, string) Style { return NewStyle().Bold(true) })
+		require.Equal(t, "┌───────┐\n│    one│\n│    two│\n└───────┘", measure.StripANSI(got))
+	})
+
+	t.Run("row style overrides block style", func(t *testing.T) {
+		block := NewStyle().Foreground(Color("#ff0000"))
+		row := NewStyle().Foreground(Color("#00ff00"))
+		got := block.RenderLines([]string{"a"}, func(int, string) Style { return row })
+		require.Equal(t, row.Render("a"), got)
+	})
+
+	t.Run("height pads the block", func(t *testing.T) {
+		got := NewStyle().Height(3).RenderLines([]string{"ab"}, func(int, string) Style { return NewStyle() })
+		require.Equal(t, "ab\n  \n  ", measure.StripANSI(got))
+	})
+
+	t.Run("nil callback", func(t *testing.T) {
+		style := NewStyle().Bold(true)
+		require.Equal(t, style.Render("a\nb"), style.RenderLines([]string{"a", "b"}, nil))
+	})
+}
@@ -0,0 +1,370 @@
+package tuistyles
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+// RenderTreeVersion is the schema version of the JSON written for a
+// RenderTree. Field names and box kinds only change together with a
+// version bump.
+const RenderTreeVersion = 1
+
+// Box kinds in a RenderTree, from the outside in
+const (
+	BoxBlock   = "block"   // Everything rendered, including any drop shadow
+	BoxBorder  = "border"  // The border and what it encloses
+	BoxPadding = "padding" // The padding and what it encloses
+	BoxContent = "content" // The aligned text
+)
+
+// RenderTree describes rendered output as nested boxes holding runs of
+// text, for tools that need more than the escape sequences: visual
+// regression diffs, accessibility tooling, and renderers that draw
+// somewhere other than a terminal. It marshals to stable JSON (see
+// RenderTreeVersion):
+//
+//	{"version":1,"width":6,"height":3,"root":{"kind":"block","x":0,"y":0,
+//	 "width":6,"height":3,"runs":[...],"children":[...]}}
+type RenderTree struct {
+	Version int       `json:"version"`
+	Width   int       `json:"width"`
+	Height  int       `json:"height"`
+	Root    RenderBox `json:"root"`
+}
+
+// RenderBox is one box of a RenderTree. Positions are in cells from the
+// top-left corner of the whole output, not of the parent box.
+type RenderBox struct {
+	Kind     string      `json:"kind"` // BoxBlock, BoxBorder, BoxPadding, or BoxContent
+	X        int         `json:"x"`
+	Y        int         `json:"y"`
+	Width    int         `json:"width"`
+	Height   int         `json:"height"`
+	Runs     []TextRun   `json:"runs,omitempty"`     // Text inside this box but outside its children
+	Children []RenderBox `json:"children,omitempty"` // At most one: the next box in
+}
+
+// TextRun is text on one line drawn with one style: the largest piece of
+// a line whose cells share their attributes, colors, hyperlink, and box.
+type TextRun struct {
+	X     int    `json:"x"`
+	Y     int    `json:"y"`
+	Width int    `json:"width"` // In cells
+	Text  string `json:"text"`
+	Style Style  `json:"style"`          // Only text attributes and colors are set
+	Link  string `json:"link,omitempty"` // Hyperlink target, if any
+}
+
+// RenderTree renders str with s like Render and describes the result: the
+// border, padding, and content boxes the style draws, nested in the block
+// holding the whole output, with every run of text in the innermost box
+// that holds it.
+//
+// Example:
+//
+//	tree := NewStyle().Border(RoundedBorder()).Padding(0, 1).RenderTree("Hi")
+//	data, _ := json.Marshal(tree)
+func (s Style) RenderTree(str string) RenderTree {
+	out := s.Render(str)
+	tree := ParseRenderTree(out)
+	if out == "" {
+		return tree
+	}
+
+	// The boxes nest from the output's edges inward; a shadow takes a cell
+	// on the right and bottom
+	frame := Rect{Width: tree.Width, Height: tree.Height}
+	if s.hasShadow() {
+		frame = frame.Inset(0, 1, 1, 0)
+	}
+	var boxes []RenderBox
+	if s.hasBorder() {
+		boxes = append(boxes, boxFor(BoxBorder, frame))
+		frame = frame.Inset(s.borderEdges())
+	}
+	if s.hasPadding() {
+		boxes = append(boxes, boxFor(BoxPadding, frame))
+		frame = frame.Inset(intOrZero(s.paddingTop), intOrZero(s.paddingRight),
+			intOrZero(s.paddingBottom), intOrZero(s.paddingLeft))
+	}
+	boxes = append(boxes, boxFor(BoxContent, frame))
+
+	runs := tree.Root.Runs
+	tree.Root.Runs = nil
+	for i := len(boxes) - 1; i > 0; i-- {
+		boxes[i-1].Children = []RenderBox{boxes[i]}
+	}
+	tree.Root.Children = boxes[:1]
+	tree.Root.place(splitRuns(runs, boxes))
+	return tree
+}
+
+// ParseRenderTree describes output that has already been rendered, such
+// as a component's, as a RenderTree with a single block box holding its
+// runs of text. SGR attributes and colors become each run's Style, and
+// OSC 8 hyperlinks its Link; other escape sequences are dropped.
+//
+// Example:
+//
+//	tree := ParseRenderTree(table.Render(80))
+func ParseRenderTree(rendered string) RenderTree {
+	tree := RenderTree{Version: RenderTreeVersion}
+	if rendered == "" {
+		tree.Root = RenderBox{Kind: BoxBlock}
+		return tree
+	}
+	tree.Width, tree.Height = Size(rendered)
+	tree.Root = boxFor(BoxBlock, Rect{Width: tree.Width, Height: tree.Height})
+	tree.Root.Runs = parseRuns(rendered)
+	return tree
+}
+
+// boxFor returns an empty box of the given kind covering r
+func boxFor(kind string, r Rect) RenderBox {
+	return RenderBox{Kind: kind, X: r.X, Y: r.Y, Width: max(r.Width, 0), Height: max(r.Height, 0)}
+}
+
+// contains reports whether run lies entirely inside b
+func (b RenderBox) contains(run TextRun) bool {
+	return run.Y >= b.Y && run.Y < b.Y+b.Height && run.X >= b.X && run.X+run.Width <= b.X+b.Width
+}
+
+// place gives each run to the innermost box below b that holds it
+func (b *RenderBox) place(runs []TextRun) {
+	for _, run := range runs {
+		box := b
+		for len(box.Children) > 0 && box.Children[0].contains(run) {
+			box = &box.Children[0]
+		}
+		box.Runs = append(box.Runs, run)
+	}
+}
+
+// borderEdges returns the cells the style's border takes on each side:
+// top, right, bottom, left
+func (s Style) borderEdges() (int, int, int, int) {
+	on := func(side *bool) bool { return side == nil || *side }
+	var top, right, bottom, left int
+	if on(s.borderTop) {
+		top = 1
+	}
+	if on(s.borderRight) {
+		right = measure.Width(s.borderType.Right)
+	}
+	if on(s.borderBottom) {
+		bottom = 1
+	}
+	if on(s.borderLeft) {
+		left = measure.Width(s.borderType.Left)
+	}
+	return top, right, bottom, left
+}
+
+// splitRuns cuts runs at every left and right edge of a box on their
+// line, so each piece belongs to one box
+func splitRuns(runs []TextRun, boxes []RenderBox) []TextRun {
+	var split []TextRun
+	for _, run := range runs {
+		var edges []int
+		for _, box := range boxes {
+			if run.Y >= box.Y && run.Y < box.Y+box.Height {
+				edges = append(edges, box.X, box.X+box.Width)
+			}
+		}
+		slices.Sort(edges)
+		for _, edge := range edges {
+			if edge <= run.X || edge >= run.X+run.Width {
+				continue
+			}
+			cut := edge - run.X
+			head := run
+			head.Text, head.Width = measure.SliceCells(run.Text, 0, cut), cut
+			split = append(split, head)
+			run.Text, run.X, run.Width = measure.SliceCells(run.Text, cut, run.Width), edge, run.Width-cut
+		}
+		split = append(split, run)
+	}
+	return split
+}
+
+// runState is the styling in effect at a point in rendered output
+type runState struct {
+	bold, faint, italic, underline, blink, reverse, strikethrough bool
+
+	foreground, background Color
+	link                   string
+}
+
+// style returns the Style that draws text as s does
+func (s runState) style() Style {
+	style := NewStyle()
+	for _, attr := range []struct {
+		on  bool
+		set func(Style, bool) Style
+	}{
+		{s.bold, Style.Bold}, {s.faint, Style.Faint}, {s.italic, Style.Italic},
+		{s.underline, Style.Underline}, {s.blink, Style.Blink},
+		{s.reverse, Style.Reverse}, {s.strikethrough, Style.Strikethrough},
+	} {
+		if attr.on {
+			style = attr.set(style, true)
+		}
+	}
+	if s.foreground != "" {
+		style = style.Foreground(s.foreground)
+	}
+	if s.background != "" {
+		style = style.Background(s.background)
+	}
+	return style
+}
+
+// parseRuns splits rendered output into runs of text sharing a runState.
+// Styling carries over from one line to the next, as it does on a
+// terminal.
+func parseRuns(rendered string) []TextRun {
+	var runs []TextRun
+	var state runState
+	for y, line := range strings.Split(rendered, "\n") {
+		x, start := 0, 0
+		var text strings.Builder
+		var textState runState
+		flush := func() {
+			if text.Len() > 0 {
+				runs = append(runs, TextRun{X: start, Y: y, Width: x - start, Text: text.String(),
+					Style: textState.style(), Link: textState.link})
+				text.Reset()
+			}
+		}
+
+		for _, seg := range measure.Segments(line) {
+			if seg.Escape {
+				if body, ok := strings.CutPrefix(seg.Text, "\x1b["); ok && strings.HasSuffix(body, "m") {
+					state = state.applySGR(body[:len(body)-1])
+				} else if url, ok := hyperlinkTarget(seg.Text); ok {
+					state.link = url
+				}
+				continue
+			}
+			// Escapes that end up changing nothing do not split a run
+			if text.Len() > 0 && state != textState {
+				flush()
+			}
+			if text.Len() == 0 {
+				start, textState = x, state
+			}
+			text.WriteString(seg.Text)
+			x += measure.Width(seg.Text)
+		}
+		flush()
+	}
+	return runs
+}
+
+// hyperlinkTarget returns the URL an OSC 8 sequence opens, "" for the
+// sequence that closes a link
+func hyperlinkTarget(esc string) (string, bool) {
+	body, ok := strings.CutPrefix(esc, "\x1b]8;")
+	if !ok {
+		return "", false
+	}
+	body = strings.TrimSuffix(strings.TrimSuffix(body, "\x1b\\"), "\a")
+	_, url, ok := strings.Cut(body, ";")
+	return url, ok
+}
+
+// sgrColorNames names the eight basic SGR colors in code order
+var sgrColorNames = [...]string{"black", "red", "green", "yellow", "blue", "magenta", "cyan", "white"}
+
+// applySGR returns s updated by the parameters of an SGR sequence, given
+// without its ESC [ and m
+func (s runState) applySGR(body string) runState {
+	fields := strings.Split(body, ";")
+	for k := 0; k < len(fields); k++ {
+		code, sub, colon := strings.Cut(fields[k], ":")
+		n, err := strconv.Atoi(code)
+		if err != nil {
+			n = 0 // an empty parameter means 0
+		}
+		switch {
+		case n == 0:
+			s = runState{link: s.link}
+		case n == 1:
+			s.bold = true
+		case n == 2:
+			s.faint = true
+		case n == 22:
+			s.bold, s.faint = false, false
+		case n == 3, n == 23:
+			s.italic = n == 3
+		case n == 4:
+			s.underline = sub != "0"
+		case n == 24:
+			s.underline = false
+		case n == 5, n == 6, n == 25:
+			s.blink = n != 25
+		case n == 7, n == 27:
+			s.reverse = n == 7
+		case n == 9, n == 29:
+			s.strikethrough = n == 9
+		case n >= 30 && n <= 37:
+			s.foreground = Color(sgrColorNames[n-30])
+		case n >= 90 && n <= 97:
+			s.foreground = Color("bright-" + sgrColorNames[n-90])
+		case n == 39:
+			s.foreground = ""
+		case n >= 40 && n <= 47:
+			s.background = Color(sgrColorNames[n-40])
+		case n >= 100 && n <= 107:
+			s.background = Color("bright-" + sgrColorNames[n-100])
+		case n == 49:
+			s.background = ""
+		case n == 38, n == 48, n == 58:
+			var c Color
+			if colon {
+				c = sgrColor(sgrInts(strings.Split(sub, ":")), true)
+			} else {
+				args := sgrInts(fields[k+1:])
+				c = sgrColor(args, false)
+				k += extendedColorArgs(args)
+			}
+			if n == 38 {
+				s.foreground = c
+			} else if n == 48 {
+				s.background = c
+			}
+		}
+	}
+	return s
+}
+
+// sgrInts parses SGR parameters, treating empty or invalid ones as 0
+func sgrInts(fields []string) []int {
+	ints := make([]int, len(fields))
+	for i, f := range fields {
+		ints[i], _ = strconv.Atoi(f) //nolint:errcheck // empty means 0
+	}
+	return ints
+}
+
+// sgrColor returns the color an extended color's arguments select (5;n
+// or 2;r;g;b), or "". The colon form may put a color space id before r.
+func sgrColor(args []int, colon bool) Color {
+	switch {
+	case len(args) >= 2 && args[0] == 5:
+		return Color(strconv.Itoa(args[1]))
+	case len(args) >= 4 && args[0] == 2:
+		rgb := args[1:4]
+		if colon && len(args) >= 5 {
+			rgb = args[2:5]
+		}
+		return Color(fmt.Sprintf("#%02X%02X%02X", rgb[0], rgb[1], rgb[2]))
+	default:
+		return ""
+	}
+}
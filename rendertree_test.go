@@ -0,0 +1,108 @@
+package tuistyles
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStyle_RenderTree(t *testing.T) {
+	style := NewStyle().Foreground(Color("red")).Border(NormalBorder()).
+		BorderForeground(Color("blue")).Padding(0, 1)
+	tree := style.RenderTree("Hi\nyou")
+
+	require.Equal(t, RenderTreeVersion, tree.Version)
+	require.Equal(t, 7, tree.Width)
+	require.Equal(t, 4, tree.Height)
+
+	root := tree.Root
+	require.Equal(t, BoxBlock, root.Kind)
+	require.Empty(t, root.Runs)
+	require.Len(t, root.Children, 1)
+
+	border := root.Children[0]
+	require.Equal(t, [4]int{0, 0, 7, 4}, [4]int{border.X, border.Y, border.Width, border.Height})
+	require.Equal(t, BoxBorder, border.Kind)
+	require.Len(t, border.Runs, 6, "top and bottom, and each side of two lines")
+	require.Equal(t, TextRun{X: 0, Y: 0, Width: 7, Text: "┌─────┐", Style: NewStyle().Foreground(Color("blue"))}, border.Runs[0])
+
+	padding := border.Children[0]
+	require.Equal(t, BoxPadding, padding.Kind)
+	require.Equal(t, [4]int{1, 1, 5, 2}, [4]int{padding.X, padding.Y, padding.Width, padding.Height})
+	for _, run := range padding.Runs {
+		require.Equal(t, " ", run.Text)
+	}
+
+	content := padding.Children[0]
+	require.Equal(t, BoxContent, content.Kind)
+	require.Equal(t, [4]int{2, 1, 3, 2}, [4]int{content.X, content.Y, content.Width, content.Height})
+	require.Empty(t, content.Children)
+	red := NewStyle().Foreground(Color("red"))
+	require.Equal(t, []TextRun{
+		{X: 2, Y: 1, Width: 2, Text: "Hi", Style: red},
+		{X: 4, Y: 1, Width: 1, Text: " ", Style: NewStyle()},
+		{X: 2, Y: 2, Width: 3, Text: "you", Style: red},
+	}, content.Runs)
+}
+
+func TestStyle_RenderTree_Unstyled(t *testing.T) {
+	for _, style := range []Style{
+		NewStyle().Border(RoundedBorder()).Padding(0, 1),
+		NewStyle().Border(RoundedBorder()).Padding(0, 1).Shadow(true),
+	} {
+		tree := style.RenderTree("Hi")
+		border := tree.Root.Children[0]
+		padding := border.Children[0]
+		content := padding.Children[0]
+
+		require.Equal(t, []TextRun{{X: 2, Y: 1, Width: 2, Text: "Hi", Style: NewStyle()}}, content.Runs)
+		require.Equal(t, []TextRun{
+			{X: 1, Y: 1, Width: 1, Text: " ", Style: NewStyle()},
+			{X: 4, Y: 1, Width: 1, Text: " ", Style: NewStyle()},
+		}, padding.Runs)
+		require.Contains(t, border.Runs, TextRun{X: 0, Y: 1, Width: 1, Text: "│", Style: NewStyle()})
+	}
+}
+
+func TestStyle_RenderTree_Plain(t *testing.T) {
+	tree := NewStyle().RenderTree("Hi")
+	require.Equal(t, RenderBox{Kind: BoxBlock, Width: 2, Height: 1, Children: []RenderBox{
+		{Kind: BoxContent, Width: 2, Height: 1, Runs: []TextRun{{Width: 2, Text: "Hi", Style: NewStyle()}}},
+	}}, tree.Root)
+
+	empty := NewStyle().RenderTree("")
+	require.Equal(t, RenderTree{Version: RenderTreeVersion, Root: RenderBox{Kind: BoxBlock}}, empty)
+}
+
+func TestParseRenderTree(t *testing.T) {
+	rendered := "\x1b[1;38;5;202mab\x1b[0m c\n" +
+		"\x1b[48;2;16;32;48mx\x1b[22;4:3;38:2::255:0:0my\x1b[m" +
+		Hyperlink("https://example.com", "link") + "日"
+
+	tree := ParseRenderTree(rendered)
+	require.Equal(t, 8, tree.Width)
+	require.Equal(t, 2, tree.Height)
+	require.Empty(t, tree.Root.Children)
+	require.Equal(t, []TextRun{
+		{X: 0, Y: 0, Width: 2, Text: "ab", Style: NewStyle().Bold(true).Foreground(Color("202"))},
+		{X: 2, Y: 0, Width: 2, Text: " c", Style: NewStyle()},
+		{X: 0, Y: 1, Width: 1, Text: "x", Style: NewStyle().Background(Color("#102030"))},
+		{X: 1, Y: 1, Width: 1, Text: "y", Style: NewStyle().Underline(true).Foreground(Color("#FF0000")).Background(Color("#102030"))},
+		{X: 2, Y: 1, Width: 4, Text: "link", Style: NewStyle(), Link: "https://example.com"},
+		{X: 6, Y: 1, Width: 2, Text: "日", Style: NewStyle()},
+	}, tree.Root.Runs)
+}
+
+func TestRenderTree_JSON(t *testing.T) {
+	tree := NewStyle().Bold(true).Border(RoundedBorder()).RenderTree("ok")
+
+	data, err := json.Marshal(tree)
+	require.NoError(t, err)
+	require.Contains(t, string(data), `{"version":1,"width":4,"height":3,"root":{"kind":"block","x":0,"y":0,"width":4,"height":3,"children":[{"kind":"border"`)
+	require.Contains(t, string(data), `{"x":1,"y":1,"width":2,"text":"ok","style":{"version":1,"bold":true}}`)
+
+	var decoded RenderTree
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	require.Equal(t, tree, decoded)
+}
@@ -0,0 +1,68 @@
+package tuistyles
+
+// Breakpoint is one alternative layout of a Responsive view, used when at
+// least MinWidth cells are available.
+type Breakpoint struct {
+	MinWidth int                    // Narrowest width this layout is used at
+	View     func(width int) string // Renders the layout at the given width
+}
+
+// Responsive selects among alternative layouts based on the width available
+// at render time, such as stacking panels vertically on narrow terminals and
+// placing them side by side on wide ones.
+//
+// The breakpoint with the largest MinWidth that fits is used; when none
+// fits, the one with the smallest MinWidth is used anyway. Breakpoints may
+// be listed in any order.
+//
+// Example:
+//
+//	view := Responsive{Breakpoints: []Breakpoint{
+//		{MinWidth: 0, View: func(w int) string { return JoinVertical(Left, left, right) }},
+//		{MinWidth: 80, View: func(w int) string { return JoinHorizontal(Top, left, right) }},
+//	}}
+//	fmt.Println(NewRenderer().RenderResponsive(view))
+type Responsive struct {
+	Breakpoints []Breakpoint
+}
+
+// Render renders the layout selected for width. A width of 0 means the
+// width is unknown or unconstrained, which selects the widest layout.
+func (r Responsive) Render(width int) string {
+	bp, ok := r.Select(width)
+	if !ok || bp.View == nil {
+		return ""
+	}
+	return bp.View(width)
+}
+
+// Select returns the breakpoint Render would use for width, and false when
+// there are no breakpoints.
+func (r Responsive) Select(width int) (Breakpoint, bool) {
+	if len(r.Breakpoints) == 0 {
+		return Breakpoint{}, false
+	}
+
+	narrowest, best := 0, -1
+	for i, bp := range r.Breakpoints {
+		if bp.MinWidth < r.Breakpoints[narrowest].MinWidth {
+			narrowest = i
+		}
+		if width > 0 && bp.MinWidth > width {
+			continue
+		}
+		if best < 0 || bp.MinWidth > r.Breakpoints[best].MinWidth {
+			best = i
+		}
+	}
+	if best < 0 {
+		best = narrowest
+	}
+	return r.Breakpoints[best], true
+}
+
+// RenderResponsive renders v at the renderer's terminal width (see
+// TerminalSize).
+func (r Renderer) RenderResponsive(v Responsive) string {
+	return v.Render(r.width)
+}
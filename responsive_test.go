@@ -0,0 +1,61 @@
+package tuistyles
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func namedLayout(name string) Breakpoint {
+	return Breakpoint{View: func(w int) string { return name + "@" + strconv.Itoa(w) }}
+}
+
+func atWidth(minWidth int, bp Breakpoint) Breakpoint {
+	bp.MinWidth = minWidth
+	return bp
+}
+
+func TestResponsive_Render(t *testing.T) {
+	view := Responsive{Breakpoints: []Breakpoint{
+		atWidth(120, namedLayout("wide")),
+		atWidth(40, namedLayout("narrow")),
+		atWidth(80, namedLayout("medium")),
+	}}
+
+	tests := []struct {
+		width int
+		want  string
+	}{
+		{200, "wide@200"},
+		{120, "wide@120"},
+		{119, "medium@119"},
+		{80, "medium@80"},
+		{79, "narrow@79"},
+		{40, "narrow@40"},
+		{10, "narrow@10"},
+		{0, "wide@0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(strconv.Itoa(tt.width), func(t *testing.T) {
+			require.Equal(t, tt.want, view.Render(tt.width))
+		})
+	}
+}
+
+func TestResponsive_Empty(t *testing.T) {
+	require.Equal(t, "", Responsive{}.Render(80))
+	require.Equal(t, "", Responsive{Breakpoints: []Breakpoint{{MinWidth: 10}}}.Render(80))
+
+	_, ok := Responsive{}.Select(80)
+	require.False(t, ok)
+}
+
+func TestRenderer_RenderResponsive(t *testing.T) {
+	view := Responsive{Breakpoints: []Breakpoint{atWidth(0, namedLayout("stacked")), atWidth(80, namedLayout("split"))}}
+
+	require.Equal(t, "stacked@60", Renderer{}.TerminalSize(60, 24).RenderResponsive(view))
+	require.Equal(t, "split@100", Renderer{}.TerminalSize(100, 24).RenderResponsive(view))
+	require.Equal(t, "split@0", Renderer{}.RenderResponsive(view))
+}
@@ -0,0 +1,205 @@
+package tuistyles
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/orchard9/tui-styles/internal/ansi"
+)
+
+// sgrGroup identifies a property that SGR sequences turn on and off together
+type sgrGroup int
+
+const (
+	sgrIntensity sgrGroup = iota // bold and faint (both cleared by 22)
+	sgrItalic
+	sgrUnderline
+	sgrBlink
+	sgrReverse
+	sgrStrikethrough
+	sgrForeground
+	sgrBackground
+	sgrGroupCount
+)
+
+// scopeResets keeps the style in effect across escape sequences embedded in
+// str. Already-styled text ends with a reset (ESC[0m) or a partial reset
+// such as ESC[39m; without help, everything after it would lose this
+// style's colors and attributes. After each such sequence, the parts of this
+// style it turned off are switched back on, so nested styled text behaves
+// like a stack: the inner style applies inside, the outer style resumes after.
+func (s Style) scopeResets(str string) string {
+	if !strings.Contains(str, "\x1b[") || !s.hasAnyStyle() {
+		return str
+	}
+
+	restore := s.sgrRestoreCodes()
+
+	var b strings.Builder
+	b.Grow(len(str) + 32)
+	for i := 0; i < len(str); {
+		params, end, ok := sgrAt(str, i)
+		if !ok {
+			b.WriteByte(str[i])
+			i++
+			continue
+		}
+		b.WriteString(str[i:end])
+		for _, group := range clearedGroups(params) {
+			b.WriteString(restore[group])
+		}
+		i = end
+	}
+	return b.String()
+}
+
+// sgrRestoreCodes returns, for each group, the sequence that re-applies
+// this style's setting ("" when the style leaves the group unset)
+func (s Style) sgrRestoreCodes() [sgrGroupCount]string {
+	var codes [sgrGroupCount]string
+	on := func(p *bool) bool { return p != nil && *p }
+
+	if on(s.bold) {
+		codes[sgrIntensity] += ansi.Bold()
+	}
+	if on(s.faint) {
+		codes[sgrIntensity] += ansi.Faint()
+	}
+	if on(s.italic) {
+		codes[sgrItalic] = ansi.Italic()
+	}
+	if on(s.underline) {
+		codes[sgrUnderline] = ansi.Underline()
+	}
+	if on(s.blink) {
+		codes[sgrBlink] = ansi.Blink()
+	}
+	if on(s.reverse) {
+		codes[sgrReverse] = ansi.Reverse()
+	}
+	if on(s.strikethrough) {
+		codes[sgrStrikethrough] = ansi.Strikethrough()
+	}
+	if fg := s.resolvedForeground(); fg != nil {
+		codes[sgrForeground] = fg.ToANSI()
+	}
+	if s.background != nil {
+		codes[sgrBackground] = s.background.ToANSIBackground()
+	}
+	return codes
+}
+
+// sgrAt parses an SGR sequence (ESC [ params m) starting at str[i],
+// returning its parameters and the index just past it
+func sgrAt(str string, i int) (params []int, end int, ok bool) {
+	if !strings.HasPrefix(str[i:], "\x1b[") {
+		return nil, 0, false
+	}
+
+	j := i + 2
+	for j < len(str) && (str[j] >= '0' && str[j] <= '9' || str[j] == ';' || str[j] == ':') {
+		j++
+	}
+	if j >= len(str) || str[j] != 'm' {
+		return nil, 0, false
+	}
+
+	body := str[i+2 : j]
+	if body == "" {
+		return []int{0}, j + 1, true
+	}
+	for _, field := range strings.Split(body, ";") {
+		// Colon sub-parameters (38:2::r:g:b, 4:3) belong to their leading code
+		if k := strings.IndexByte(field, ':'); k >= 0 {
+			field = field[:k]
+		}
+		n, err := strconv.Atoi(field)
+		if err != nil {
+			n = 0 // an empty parameter means 0
+		}
+		params = append(params, n)
+	}
+	return params, j + 1, true
+}
+
+// clearedGroups returns the groups an SGR sequence leaves switched off, in
+// the order their codes are written: a group reset and not set again later
+// in the same sequence
+func clearedGroups(params []int) []sgrGroup {
+	var cleared [sgrGroupCount]bool
+	set := func(g sgrGroup, off bool) { cleared[g] = off }
+
+	for k := 0; k < len(params); k++ {
+		switch p := params[k]; {
+		case p == 0:
+			for g := range cleared {
+				cleared[g] = true
+			}
+		case p == 1 || p == 2:
+			set(sgrIntensity, false)
+		case p == 22:
+			set(sgrIntensity, true)
+		case p == 3:
+			set(sgrItalic, false)
+		case p == 23:
+			set(sgrItalic, true)
+		case p == 4:
+			set(sgrUnderline, false)
+		case p == 24:
+			set(sgrUnderline, true)
+		case p == 5 || p == 6:
+			set(sgrBlink, false)
+		case p == 25:
+			set(sgrBlink, true)
+		case p == 7:
+			set(sgrReverse, false)
+		case p == 27:
+			set(sgrReverse, true)
+		case p == 9:
+			set(sgrStrikethrough, false)
+		case p == 29:
+			set(sgrStrikethrough, true)
+		case p >= 30 && p <= 37, p >= 90 && p <= 97:
+			set(sgrForeground, false)
+		case p == 39:
+			set(sgrForeground, true)
+		case p >= 40 && p <= 47, p >= 100 && p <= 107:
+			set(sgrBackground, false)
+		case p == 49:
+			set(sgrBackground, true)
+		case p == 38 || p == 48:
+			if p == 38 {
+				set(sgrForeground, false)
+			} else {
+				set(sgrBackground, false)
+			}
+			k += extendedColorArgs(params[k+1:])
+		case p == 58:
+			k += extendedColorArgs(params[k+1:])
+		}
+	}
+
+	var groups []sgrGroup
+	for g, off := range cleared {
+		if off {
+			groups = append(groups, sgrGroup(g))
+		}
+	}
+	return groups
+}
+
+// extendedColorArgs returns how many parameters after 38, 48, or 58 belong
+// to the color (5;n for 256 colors, 2;r;g;b for true color)
+func extendedColorArgs(rest []int) int {
+	if len(rest) == 0 {
+		return 0
+	}
+	switch rest[0] {
+	case 5:
+		return min(2, len(rest))
+	case 2:
+		return min(4, len(rest))
+	default:
+		return 1
+	}
+}
@@ -0,0 +1,55 @@
+package tuistyles
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStyle_ScopeResets(t *testing.T) {
+	blueBg := Color("blue").ToANSIBackground()
+	redFg := Color("red").ToANSI()
+	outer := NewStyle().Background(Color("blue")).Foreground(Color("red")).Bold(true)
+
+	tests := []struct {
+		name  string
+		style Style
+		input string
+		want  string
+	}{
+		{"no escapes", outer, "plain", "plain"},
+		{"unstyled outer", NewStyle(), "a\x1b[0mb", "a\x1b[0mb"},
+		{"full reset restores everything", outer, "a\x1b[0mb", "a\x1b[0m\x1b[1m" + redFg + blueBg + "b"},
+		{"empty reset", outer, "a\x1b[mb", "a\x1b[m\x1b[1m" + redFg + blueBg + "b"},
+		{"default foreground", outer, "a\x1b[39mb", "a\x1b[39m" + redFg + "b"},
+		{"default background", outer, "a\x1b[49mb", "a\x1b[49m" + blueBg + "b"},
+		{"intensity", outer, "a\x1b[22mb", "a\x1b[22m\x1b[1mb"},
+		{"reset then inner color", outer, "\x1b[0;32mx", "\x1b[0;32m\x1b[1m" + blueBg + "x"},
+		{"true color is not a reset", outer, "\x1b[38;2;0;0;0mx", "\x1b[38;2;0;0;0mx"},
+		{"256 color zero is not a reset", outer, "\x1b[48;5;0mx", "\x1b[48;5;0mx"},
+		{"colon sub-parameters", outer, "\x1b[4:0mx", "\x1b[4:0mx"},
+		{"non-SGR escapes untouched", outer, "\x1b[2Kx\x1b]8;;u\x1b\\", "\x1b[2Kx\x1b]8;;u\x1b\\"},
+		{"unset groups not restored", NewStyle().Italic(true), "a\x1b[39mb", "a\x1b[39mb"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, tt.style.scopeResets(tt.input))
+		})
+	}
+}
+
+func TestRender_NestedKeepsOuterBackground(t *testing.T) {
+	bg := Color("#303030")
+	inner := NewStyle().Foreground(Color("yellow")).Render("warn")
+	box := NewStyle().Background(bg).Render("[" + inner + "] rest")
+
+	// The text after the inner reset is drawn on the outer background again
+	require.Contains(t, box, "\x1b[0m"+bg.ToANSIBackground()+"] rest")
+}
+
+func TestStyledString_NestedKeepsSpanStyle(t *testing.T) {
+	inner := NewStyle().Bold(true).Render("b")
+	got := Styled("a"+inner+"c", NewStyle().Underline(true)).Render()
+	require.Equal(t, "\x1b[4ma\x1b[1mb\x1b[0m\x1b[4mc\x1b[0m", got)
+}
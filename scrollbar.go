@@ -0,0 +1,73 @@
+package tuistyles
+
+import "strings"
+
+// Scrollbar glyphs: the track and the thumb that marks the visible part
+const (
+	scrollTrack = "░"
+	scrollThumb = "█"
+)
+
+// Scrollbar returns a vertical scrollbar height lines tall, one cell per
+// line, for a view showing visible of total lines starting at line offset:
+// a "░" track with a "█" thumb sized and placed to match. Join it beside
+// a viewport or table with JoinHorizontal.
+//
+// The thumb is at least one cell and touches the top or bottom of the
+// track only when the view reaches that end of the content. When everything is
+// visible the thumb fills the track. A height of 0 or less returns "".
+//
+// Example:
+//
+//	view := strings.Join(lines[offset:offset+24], "\n")
+//	fmt.Println(JoinHorizontal(Top, view, Scrollbar(24, len(lines), offset, 24)))
+func Scrollbar(height, total, offset, visible int) string {
+	return strings.Join(scrollCells(height, total, offset, visible), "\n")
+}
+
+// HorizontalScrollbar returns a scrollbar width cells wide for a view
+// showing visible of total columns starting at column offset, laid out
+// like Scrollbar along one line.
+//
+// Example:
+//
+//	fmt.Println(HorizontalScrollbar(80, longestLine, scrollX, 80))
+func HorizontalScrollbar(width, total, offset, visible int) string {
+	return strings.Join(scrollCells(width, total, offset, visible), "")
+}
+
+// scrollCells returns the glyph of each of the length cells of a
+// scrollbar
+func scrollCells(length, total, offset, visible int) []string {
+	if length <= 0 {
+		return nil
+	}
+	cells := make([]string, length)
+	visible = max(visible, 0)
+	if total <= visible {
+		for i := range cells {
+			cells[i] = scrollThumb
+		}
+		return cells
+	}
+
+	thumb := min(max((length*visible+total/2)/total, 1), length)
+	scrollable := total - visible
+	offset = min(max(offset, 0), scrollable)
+	// The thumb only touches either end of the track when the view does
+	start := (length - thumb) * offset / scrollable
+	if offset > 0 {
+		start = max(start, 1)
+	}
+	if offset < scrollable {
+		start = min(start, length-thumb-1)
+	}
+	start = max(start, 0)
+	for i := range cells {
+		cells[i] = scrollTrack
+		if i >= start && i < start+thumb {
+			cells[i] = scrollThumb
+		}
+	}
+	return cells
+}
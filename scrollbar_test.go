@@ -0,0 +1,39 @@
+package tuistyles
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHorizontalScrollbar(t *testing.T) {
+	tests := []struct {
+		name                          string
+		width, total, offset, visible int
+		want                          string
+	}{
+		{"top", 10, 100, 0, 20, "██░░░░░░░░"},
+		{"middle", 10, 100, 40, 20, "░░░░██░░░░"},
+		{"end", 10, 100, 80, 20, "░░░░░░░░██"},
+		{"just past the top", 10, 100, 1, 20, "░██░░░░░░░"},
+		{"nearly the end", 10, 100, 79, 20, "░░░░░░░██░"},
+		{"offset clamped", 10, 100, 500, 20, "░░░░░░░░██"},
+		{"negative offset", 10, 100, -5, 20, "██░░░░░░░░"},
+		{"thumb at least one cell", 10, 10000, 0, 1, "█░░░░░░░░░"},
+		{"everything visible", 4, 3, 0, 10, "████"},
+		{"no width", 0, 100, 0, 10, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, HorizontalScrollbar(tt.width, tt.total, tt.offset, tt.visible))
+		})
+	}
+}
+
+func TestScrollbar(t *testing.T) {
+	require.Equal(t, "░\n█\n█\n░", Scrollbar(4, 8, 2, 4))
+	require.Equal(t, 5, Height(Scrollbar(5, 100, 50, 10)))
+	require.Equal(t, 1, Width(Scrollbar(5, 100, 50, 10)))
+	require.Empty(t, Scrollbar(0, 100, 0, 10))
+}
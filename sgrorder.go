@@ -0,0 +1,183 @@
+package tuistyles
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// SGROrder is the order a Renderer writes the parameters of a combined SGR
+// sequence in. Terminals should not care, but some only render bold with a
+// 256 color correctly when the color comes first.
+type SGROrder int
+
+const (
+	// SGRAttributesFirst writes text attributes before colors, as in
+	// ESC[1;31m (the default)
+	SGRAttributesFirst SGROrder = iota
+	// SGRColorsFirst writes colors before text attributes, as in ESC[31;1m
+	SGRColorsFirst
+)
+
+// String returns human-readable SGR order name
+func (o SGROrder) String() string {
+	switch o {
+	case SGRAttributesFirst:
+		return "AttributesFirst"
+	case SGRColorsFirst:
+		return "ColorsFirst"
+	default:
+		return "Unknown"
+	}
+}
+
+// MarshalText encodes the order as its lowercase name ("attributesfirst", "colorsfirst").
+func (o SGROrder) MarshalText() ([]byte, error) {
+	if o < SGRAttributesFirst || o > SGRColorsFirst {
+		return nil, fmt.Errorf("invalid SGR order: %d", int(o))
+	}
+	return []byte(strings.ToLower(o.String())), nil
+}
+
+// UnmarshalText decodes an SGR order name (case-insensitive).
+func (o *SGROrder) UnmarshalText(text []byte) error {
+	name := strings.ToLower(string(text))
+	for candidate := SGRAttributesFirst; candidate <= SGRColorsFirst; candidate++ {
+		if strings.ToLower(candidate.String()) == name {
+			*o = candidate
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid SGR order: %q", string(text))
+}
+
+// SGROrder sets the order the renderer writes SGR parameters in. Whatever
+// the order, consecutive SGR sequences in the output are combined into one,
+// so ESC[1m ESC[38;5;208m becomes ESC[1;38;5;208m.
+//
+// Returns a new Renderer, leaving the original unchanged.
+//
+// Example:
+//
+//	r := NewRenderer().SGROrder(SGRColorsFirst)
+func (r Renderer) SGROrder(o SGROrder) Renderer {
+	r2 := r
+	r2.sgrOrder = o
+	return r2
+}
+
+// CombineSGR merges every run of consecutive SGR sequences in s into a
+// single sequence with its parameters in order, which is shorter and reads
+// the same to the terminal. Resets keep their place: parameters are only
+// reordered between them. Other escape sequences and text are unchanged.
+//
+// Example:
+//
+//	CombineSGR("\x1b[1m\x1b[31mhi\x1b[0m", SGRColorsFirst) // "\x1b[31;1mhi\x1b[0m"
+func CombineSGR(s string, order SGROrder) string {
+	if !strings.Contains(s, "\x1b[") {
+		return s
+	}
+
+	var b strings.Builder
+	b.Grow(len(s))
+	for i := 0; i < len(s); {
+		body, end, ok := sgrBodyAt(s, i)
+		if !ok {
+			b.WriteByte(s[i])
+			i++
+			continue
+		}
+
+		// Gather the whole run of adjacent sequences
+		start, count := i, 0
+		var fields []string
+		for ok {
+			if body == "" {
+				body = "0"
+			}
+			fields = append(fields, strings.Split(body, ";")...)
+			count++
+			i = end
+			body, end, ok = sgrBodyAt(s, i)
+		}
+
+		ordered := orderSGRFields(fields, order)
+		if count == 1 && slices.Equal(ordered, fields) {
+			b.WriteString(s[start:i]) // already as short and ordered as it gets
+			continue
+		}
+		b.WriteString("\x1b[")
+		b.WriteString(strings.Join(ordered, ";"))
+		b.WriteByte('m')
+	}
+	return b.String()
+}
+
+// sgrBodyAt returns the parameters of the SGR sequence starting at s[i] as
+// written, and the index just past it
+func sgrBodyAt(s string, i int) (body string, end int, ok bool) {
+	if !strings.HasPrefix(s[i:], "\x1b[") {
+		return "", 0, false
+	}
+	j := i + 2
+	for j < len(s) && (s[j] >= '0' && s[j] <= '9' || s[j] == ';' || s[j] == ':') {
+		j++
+	}
+	if j >= len(s) || s[j] != 'm' {
+		return "", 0, false
+	}
+	return s[i+2 : j], j + 1, true
+}
+
+// orderSGRFields reorders SGR parameters so attributes and colors come in
+// order between resets. Extended colors (38;5;n, 48;2;r;g;b) move as a unit.
+func orderSGRFields(fields []string, order SGROrder) []string {
+	out := make([]string, 0, len(fields))
+	var attrs, colors []string
+	flush := func() {
+		if order == SGRColorsFirst {
+			out = append(append(out, colors...), attrs...)
+		} else {
+			out = append(append(out, attrs...), colors...)
+		}
+		attrs, colors = attrs[:0], colors[:0]
+	}
+
+	for k := 0; k < len(fields); k++ {
+		lead := fields[k]
+		if c := strings.IndexByte(lead, ':'); c >= 0 {
+			lead = lead[:c]
+		}
+		n, err := strconv.Atoi(lead)
+		if err != nil {
+			n = 0 // an empty parameter means 0
+		}
+
+		switch {
+		case n == 0:
+			flush()
+			out = append(out, fields[k])
+		case n == 38 || n == 48 || n == 58:
+			unit := fields[k : k+1]
+			if !strings.Contains(fields[k], ":") {
+				rest := make([]int, 0, 4)
+				for _, f := range fields[k+1 : min(k+5, len(fields))] {
+					v, _ := strconv.Atoi(f) //nolint:errcheck // a malformed argument reads as 0
+					rest = append(rest, v)
+				}
+				args := extendedColorArgs(rest)
+				unit = fields[k : k+1+args]
+				k += args
+			}
+			colors = append(colors, unit...)
+		case n >= 30 && n <= 49, n == 59, n >= 90 && n <= 97, n >= 100 && n <= 107:
+			colors = append(colors, fields[k])
+		default:
+			attrs = append(attrs, fields[k])
+		}
+	}
+	flush()
+	return out
+}
@@ -0,0 +1,58 @@
+package tuistyles
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCombineSGR(t *testing.T) {
+	tests := []struct {
+		name  string
+		in    string
+		order SGROrder
+		want  string
+	}{
+		{"no escapes", "plain", SGRAttributesFirst, "plain"},
+		{"single sequence kept", "\x1b[1mx\x1b[m", SGRAttributesFirst, "\x1b[1mx\x1b[m"},
+		{"adjacent combined", "\x1b[1m\x1b[3m\x1b[31mx\x1b[0m", SGRAttributesFirst, "\x1b[1;3;31mx\x1b[0m"},
+		{"colors first", "\x1b[1m\x1b[31mx\x1b[0m", SGRColorsFirst, "\x1b[31;1mx\x1b[0m"},
+		{"single sequence reordered", "\x1b[31;1mx", SGRAttributesFirst, "\x1b[1;31mx"},
+		{"extended colors move whole", "\x1b[38;2;1;2;3m\x1b[1m\x1b[48;5;236mx", SGRAttributesFirst, "\x1b[1;38;2;1;2;3;48;5;236mx"},
+		{"colon colors move whole", "\x1b[58:2::1:2:3;4mx", SGRAttributesFirst, "\x1b[4;58:2::1:2:3mx"},
+		{"resets keep their place", "\x1b[0m\x1b[31m\x1b[1mx", SGRAttributesFirst, "\x1b[0;1;31mx"},
+		{"empty reset", "\x1b[m\x1b[1mx", SGRAttributesFirst, "\x1b[0;1mx"},
+		{"text separates runs", "\x1b[1ma\x1b[31mb", SGRColorsFirst, "\x1b[1ma\x1b[31mb"},
+		{"other escapes untouched", "\x1b[2K\x1b]8;;url\x1b\\\x1b[1m\x1b[4mx", SGRAttributesFirst, "\x1b[2K\x1b]8;;url\x1b\\\x1b[1;4mx"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, CombineSGR(tt.in, tt.order))
+		})
+	}
+}
+
+func TestSGROrder_Text(t *testing.T) {
+	for _, o := range []SGROrder{SGRAttributesFirst, SGRColorsFirst} {
+		text, err := o.MarshalText()
+		require.NoError(t, err)
+		var got SGROrder
+		require.NoError(t, got.UnmarshalText(text))
+		require.Equal(t, o, got)
+	}
+
+	_, err := SGROrder(5).MarshalText()
+	require.Error(t, err)
+	var o SGROrder
+	require.Error(t, o.UnmarshalText([]byte("random")))
+	require.Equal(t, "Unknown", SGROrder(5).String())
+}
+
+func TestRenderer_SGROrder(t *testing.T) {
+	s := NewStyle().Bold(true).Foreground(Color("208"))
+	r := Renderer{}.ColorProfile(ProfileTrueColor)
+
+	require.Equal(t, "\x1b[1;38;5;208mx\x1b[0m", r.Render(s, "x"))
+	require.Equal(t, "\x1b[38;5;208;1mx\x1b[0m", r.SGROrder(SGRColorsFirst).Render(s, "x"))
+}
@@ -0,0 +1,78 @@
+package tuistyles
+
+import (
+	"strings"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+// defaultShadowColor is used when Shadow is enabled without ShadowColor
+const defaultShadowColor = Color("bright-black")
+
+// Shadow sets whether a drop shadow is drawn to the right of and below the box.
+//
+// The shadow is offset by one cell and drawn with half-block characters
+// (▄ █ ▀) so it appears to float half a cell behind the box, which works well
+// for modals and dialogs. It is drawn outside the border, adding one column
+// and one line to the rendered size.
+//
+// Returns a new Style with shadow set to v, leaving the original unchanged.
+//
+// Example:
+//
+//	modal := NewStyle().Border(RoundedBorder()).Padding(1, 2).Shadow(true)
+//	fmt.Println(modal.Render("Save changes?"))
+func (s Style) Shadow(v bool) Style {
+	s2 := s
+	s2.shadow = &v
+	return s2
+}
+
+// ShadowColor sets the drop shadow color (default "bright-black").
+//
+// Returns a new Style with shadowColor set, leaving the original unchanged.
+//
+// Example:
+//
+//	s := NewStyle().Border(NormalBorder()).Shadow(true).ShadowColor(Color("#303030"))
+func (s Style) ShadowColor(c Color) Style {
+	s2 := s
+	s2.shadowColor = &c
+	return s2
+}
+
+// hasShadow returns true if the drop shadow is enabled
+func (s Style) hasShadow() bool {
+	return s.shadow != nil && *s.shadow
+}
+
+// applyShadow draws a one-cell drop shadow to the right of and below content
+func (s Style) applyShadow(content string) string {
+	lines := strings.Split(content, "\n")
+	width := measure.MaxWidth(content)
+
+	shadowStyle := NewStyle().Foreground(defaultShadowColor)
+	if s.shadowColor != nil {
+		shadowStyle = NewStyle().Foreground(*s.shadowColor)
+	}
+
+	var b strings.Builder
+	for i, line := range lines {
+		b.WriteString(line)
+		if lineWidth := measure.Width(line); lineWidth < width {
+			b.WriteString(strings.Repeat(" ", width-lineWidth))
+		}
+		if i == 0 {
+			b.WriteString(shadowStyle.renderInline("▄"))
+		} else {
+			b.WriteString(shadowStyle.renderInline("█"))
+		}
+		b.WriteString("\n")
+	}
+
+	// Bottom edge starts one cell in, under the box
+	b.WriteString(" ")
+	b.WriteString(shadowStyle.renderInline(strings.Repeat("▀", width)))
+
+	return b.String()
+}
@@ -0,0 +1,58 @@
+package tuistyles
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+func TestStyle_Shadow(t *testing.T) {
+	s := NewStyle().Border(NormalBorder()).Shadow(true)
+	got := measure.StripANSI(s.Render("Hi"))
+
+	want := strings.Join([]string{
+		"┌──┐▄",
+		"│Hi│█",
+		"└──┘█",
+		" ▀▀▀▀",
+	}, "\n")
+	if got != want {
+		t.Errorf("Render() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestStyle_ShadowDimensions(t *testing.T) {
+	base := NewStyle().Border(RoundedBorder()).Padding(1, 2)
+	plain := base.Render("Dialog\nbody")
+	shadowed := base.Shadow(true).Render("Dialog\nbody")
+
+	if got, want := measure.MaxWidth(shadowed), measure.MaxWidth(plain)+1; got != want {
+		t.Errorf("shadow width = %d, want %d", got, want)
+	}
+	if got, want := measure.LineCount(shadowed), measure.LineCount(plain)+1; got != want {
+		t.Errorf("shadow height = %d, want %d", got, want)
+	}
+	for i, w := range measure.WidthPerLine(shadowed) {
+		if w != measure.MaxWidth(shadowed) {
+			t.Errorf("line %d width = %d, want uniform %d", i, w, measure.MaxWidth(shadowed))
+		}
+	}
+}
+
+func TestStyle_ShadowColor(t *testing.T) {
+	def := NewStyle().Shadow(true).Render("x")
+	if !strings.Contains(def, defaultShadowColor.ToANSI()) {
+		t.Errorf("default shadow should use %s", defaultShadowColor)
+	}
+
+	custom := NewStyle().Shadow(true).ShadowColor(Color("#303030")).Render("x")
+	if !strings.Contains(custom, Color("#303030").ToANSI()) {
+		t.Error("custom shadow color not applied")
+	}
+
+	off := NewStyle().Shadow(false).Render("x")
+	if off != "x" {
+		t.Errorf("Shadow(false) = %q, want %q", off, "x")
+	}
+}
@@ -0,0 +1,225 @@
+// Package shellprompt builds shell prompts from styled segments, such as
+// the working directory, git branch, and last exit status, joined with
+// powerline separators and escaped for bash, zsh, or fish.
+//
+// A prompt program prints Build's result, and the shell runs it every time
+// it draws the prompt:
+//
+//	# bash (.bashrc)
+//	PS1='$(myprompt bash $?)'
+//
+//	# zsh (.zshrc)
+//	setopt PROMPT_SUBST
+//	PROMPT='$(myprompt zsh $?)'
+//
+//	# fish (config.fish)
+//	function fish_prompt; myprompt fish $status; end
+//
+// Example:
+//
+//	cwd, _ := os.Getwd()
+//	fmt.Print(shellprompt.New().ExitStatus(status).Cwd(cwd).GitBranch(branch).Build(shellprompt.Bash))
+package shellprompt
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	tuistyles "github.com/orchard9/tui-styles"
+)
+
+// PowerlineSeparator is the solid right-pointing arrow powerline fonts draw
+// between segments. Use Separator to pick another, such as "" to butt
+// plain blocks together on terminals without a patched font.
+const PowerlineSeparator = tuistyles.PowerlineArrow
+
+// Shell is the shell a prompt is built for, which decides how its escape
+// sequences and text are escaped
+type Shell int
+
+const (
+	// Bash wraps escape sequences in readline's \001 and \002 markers
+	Bash Shell = iota
+	// Zsh wraps escape sequences in %{ %} and doubles literal % signs
+	Zsh
+	// Fish needs no escaping: fish measures escape sequences itself
+	Fish
+)
+
+// String returns human-readable shell name
+func (sh Shell) String() string {
+	switch sh {
+	case Bash:
+		return "Bash"
+	case Zsh:
+		return "Zsh"
+	case Fish:
+		return "Fish"
+	default:
+		return "Unknown"
+	}
+}
+
+// MarshalText encodes the shell as its lowercase name ("bash", "zsh", "fish").
+func (sh Shell) MarshalText() ([]byte, error) {
+	if sh < Bash || sh > Fish {
+		return nil, fmt.Errorf("invalid shell: %d", int(sh))
+	}
+	return []byte(strings.ToLower(sh.String())), nil
+}
+
+// UnmarshalText decodes a shell name (case-insensitive), so a prompt
+// program can take the shell as an argument.
+func (sh *Shell) UnmarshalText(text []byte) error {
+	name := strings.ToLower(string(text))
+	for candidate := Bash; candidate <= Fish; candidate++ {
+		if strings.ToLower(candidate.String()) == name {
+			*sh = candidate
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid shell: %q", string(text))
+}
+
+// escapeText escapes characters the shell would otherwise expand in text
+func (sh Shell) escapeText(text string) string {
+	if sh == Zsh {
+		return strings.ReplaceAll(text, "%", "%%")
+	}
+	return text
+}
+
+// markers returns the markers the shell needs around escape sequences
+func (sh Shell) markers() tuistyles.NonPrintingMarkers {
+	switch sh {
+	case Bash:
+		return tuistyles.ReadlineMarkers
+	case Zsh:
+		return tuistyles.ZshMarkers
+	default:
+		return tuistyles.NonPrintingMarkers{}
+	}
+}
+
+// Segment is one colored block of a prompt.
+type Segment struct {
+	Text       string
+	Foreground tuistyles.Color // Picked for contrast with Background when empty
+	Background tuistyles.Color // Taken from the theme when empty
+	Bold       bool
+}
+
+// part is a segment along with the theme color it falls back to
+type part struct {
+	Segment
+	themeColor func(tuistyles.Theme) tuistyles.Color
+}
+
+// PromptBuilder assembles a prompt from segments, left to right.
+//
+// Like tuistyles.Style, PromptBuilder is immutable: every method returns a
+// new PromptBuilder.
+type PromptBuilder struct {
+	parts     []part
+	separator string
+	theme     tuistyles.Theme
+}
+
+// New returns an empty PromptBuilder with PowerlineSeparator and the
+// default theme.
+func New() PromptBuilder {
+	return PromptBuilder{separator: PowerlineSeparator}
+}
+
+// Separator sets the glyph drawn between segments and after the last one.
+// It is colored to bridge the segments: its foreground is the background
+// of the segment before it and its background that of the segment after.
+func (b PromptBuilder) Separator(sep string) PromptBuilder {
+	b.separator = sep
+	return b
+}
+
+// Theme sets the colors used for segments without a Background: Info for
+// the working directory, Primary for the git branch, Error for a failed
+// exit status, and Muted for custom segments. Empty colors fall back to
+// the default theme.
+func (b PromptBuilder) Theme(theme tuistyles.Theme) PromptBuilder {
+	b.theme = theme
+	return b
+}
+
+// Segment appends a custom segment. Segments with empty text are skipped.
+func (b PromptBuilder) Segment(seg Segment) PromptBuilder {
+	return b.add(seg, func(t tuistyles.Theme) tuistyles.Color { return t.Muted })
+}
+
+// Cwd appends the working directory, with the home directory shown as ~.
+func (b PromptBuilder) Cwd(dir string) PromptBuilder {
+	if home, err := os.UserHomeDir(); err == nil && home != "" {
+		if dir == home {
+			dir = "~"
+		} else if rel, ok := strings.CutPrefix(dir, home+string(filepath.Separator)); ok {
+			dir = "~" + string(filepath.Separator) + rel
+		}
+	}
+	return b.add(Segment{Text: dir}, func(t tuistyles.Theme) tuistyles.Color { return t.Info })
+}
+
+// GitBranch appends the current git branch. An empty branch (outside a
+// repository) adds nothing.
+func (b PromptBuilder) GitBranch(branch string) PromptBuilder {
+	return b.add(Segment{Text: branch}, func(t tuistyles.Theme) tuistyles.Color { return t.Primary })
+}
+
+// ExitStatus appends the last command's exit status when it failed, as
+// "✘ 1". A status of 0 adds nothing.
+func (b PromptBuilder) ExitStatus(code int) PromptBuilder {
+	if code == 0 {
+		return b
+	}
+	seg := Segment{Text: fmt.Sprintf("✘ %d", code), Bold: true}
+	return b.add(seg, func(t tuistyles.Theme) tuistyles.Color { return t.Error })
+}
+
+// add appends seg, falling back to themeColor for its background
+func (b PromptBuilder) add(seg Segment, themeColor func(tuistyles.Theme) tuistyles.Color) PromptBuilder {
+	if seg.Text == "" {
+		return b
+	}
+	b.parts = append(slices.Clip(b.parts), part{Segment: seg, themeColor: themeColor})
+	return b
+}
+
+// Build renders the prompt escaped for shell: each segment padded with a
+// space on both sides, the separators between them, and a trailing space
+// to type after. A prompt with no segments is empty.
+func (b PromptBuilder) Build(shell Shell) string {
+	if len(b.parts) == 0 {
+		return ""
+	}
+
+	theme := b.theme.WithDefaults()
+	backgrounds := make([]tuistyles.Color, len(b.parts))
+	for i, p := range b.parts {
+		backgrounds[i] = p.Background
+		if backgrounds[i] == "" {
+			backgrounds[i] = p.themeColor(theme)
+		}
+	}
+
+	segments := make([]tuistyles.PowerlineSegment, len(b.parts))
+	for i, p := range b.parts {
+		style := tuistyles.NewStyle().Background(backgrounds[i]).Bold(p.Bold)
+		if p.Foreground != "" {
+			style = style.Foreground(p.Foreground)
+		} else {
+			style = style.AutoForeground(true)
+		}
+		segments[i] = tuistyles.PowerlineSegment{Content: " " + shell.escapeText(p.Text) + " ", Style: style}
+	}
+	out := tuistyles.JoinPowerline(b.separator, segments...) + " "
+	return tuistyles.MarkNonPrinting(out, shell.markers())
+}
@@ -0,0 +1,86 @@
+package shellprompt
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tuistyles "github.com/orchard9/tui-styles"
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+func TestPromptBuilder_Build(t *testing.T) {
+	p := New().
+		Segment(Segment{Text: "a", Foreground: "white", Background: "blue"}).
+		Segment(Segment{Text: "b", Foreground: "black", Background: "green"})
+
+	got := p.Build(Fish)
+	want := tuistyles.NewStyle().Foreground("white").Background("blue").Bold(false).Render(" a ") +
+		tuistyles.NewStyle().Foreground("blue").Background("green").Render(PowerlineSeparator) +
+		tuistyles.NewStyle().Foreground("black").Background("green").Bold(false).Render(" b ") +
+		tuistyles.NewStyle().Foreground("green").Render(PowerlineSeparator) + " "
+	require.Equal(t, want, got)
+	require.Equal(t, " a "+PowerlineSeparator+" b "+PowerlineSeparator+" ", measure.StripANSI(got))
+}
+
+func TestPromptBuilder_Shells(t *testing.T) {
+	p := New().Segment(Segment{Text: "100%", Background: "blue"})
+
+	bash := p.Build(Bash)
+	require.Equal(t, tuistyles.MarkNonPrinting(p.Build(Fish), tuistyles.ReadlineMarkers), bash)
+	require.Contains(t, bash, "\x01\x1b[")
+
+	zsh := p.Build(Zsh)
+	require.Contains(t, zsh, "%{\x1b[")
+	require.Contains(t, zsh, " 100%% ", "literal % is doubled")
+	require.NotContains(t, p.Build(Fish), "%%")
+}
+
+func TestPromptBuilder_Segments(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	p := New().Separator(">").
+		ExitStatus(0).
+		ExitStatus(2).
+		Cwd(filepath.Join(home, "src")).
+		GitBranch("").
+		GitBranch("main")
+	require.Equal(t, " ✘ 2 > ~"+string(filepath.Separator)+"src > main > ",
+		measure.StripANSI(p.Build(Fish)))
+
+	require.Equal(t, " ~ > ", measure.StripANSI(New().Separator(">").Cwd(home).Build(Fish)))
+	require.Empty(t, New().Build(Bash))
+}
+
+func TestPromptBuilder_Theme(t *testing.T) {
+	theme := tuistyles.Theme{Info: "#112233"}
+	got := New().Theme(theme).Cwd("/tmp").Build(Fish)
+	require.True(t, strings.HasPrefix(got, "\x1b["))
+	require.Contains(t, got, "48;2;17;34;51", "directory background comes from the theme")
+}
+
+func TestPromptBuilder_Immutable(t *testing.T) {
+	base := New().Cwd("/tmp")
+	_ = base.GitBranch("main")
+	require.Equal(t, " /tmp "+PowerlineSeparator+" ", measure.StripANSI(base.Build(Fish)))
+}
+
+func TestShell_Text(t *testing.T) {
+	for _, sh := range []Shell{Bash, Zsh, Fish} {
+		text, err := sh.MarshalText()
+		require.NoError(t, err)
+
+		var decoded Shell
+		require.NoError(t, decoded.UnmarshalText(text))
+		require.Equal(t, sh, decoded)
+	}
+
+	_, err := Shell(9).MarshalText()
+	require.Error(t, err)
+
+	var sh Shell
+	require.Error(t, sh.UnmarshalText([]byte("csh")))
+}
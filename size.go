@@ -0,0 +1,79 @@
+package tuistyles
+
+import (
+	"strings"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+// Width returns the width in cells of the widest line of s.
+//
+// Escape sequences (colors, hyperlinks) take no space, and wide characters
+// such as CJK and most emoji count as two cells, so the result matches what
+// the terminal shows for rendered output.
+//
+// Example:
+//
+//	box := NewStyle().Border(RoundedBorder()).Render("Hi")
+//	Width(box) // 4
+func Width(s string) int {
+	return measure.MaxWidth(s)
+}
+
+// Height returns the number of lines in s. Every string, including the
+// empty string, is at least one line high.
+//
+// Example:
+//
+//	Height("one\ntwo") // 2
+func Height(s string) int {
+	return measure.LineCount(s)
+}
+
+// Size returns the width and height of s as a block of cells (see Width and
+// Height).
+//
+// Example:
+//
+//	w, h := Size(NewStyle().Padding(1, 2).Render("Hi")) // 6, 3
+func Size(s string) (width, height int) {
+	return Width(s), Height(s)
+}
+
+// CursorAfter returns where the cursor is left after printing s from the
+// start of a line, relative to where s began: Y counts the line breaks and
+// X is the width of the last line, so a prompt printed next starts there
+// without recounting the block.
+//
+// The result depends only on what the terminal shows: escape sequences
+// (including a trailing reset) take no space, wide characters count as two
+// cells, and a carriage return moves back to column 0. Lines are assumed
+// to fit the terminal; a line that fills it exactly leaves the cursor at
+// the right edge until something else is printed.
+//
+// Example:
+//
+//	block := NewStyle().Border(RoundedBorder()).Render("Name")
+//	fmt.Print(block)
+//	pos := CursorAfter(block) // {X: 6, Y: 2}
+func CursorAfter(s string) Point {
+	y := strings.Count(s, "\n")
+	last := s[strings.LastIndexByte(s, '\n')+1:]
+	last = last[strings.LastIndexByte(last, '\r')+1:]
+	return Point{X: measure.Width(last), Y: y}
+}
+
+// Measure returns the width and height of each block, in order, so layout
+// code can plan a row or column before joining it.
+//
+// Example:
+//
+//	widths, heights := Measure(sidebar, body)
+func Measure(blocks ...string) (widths, heights []int) {
+	widths = make([]int, len(blocks))
+	heights = make([]int, len(blocks))
+	for i, b := range blocks {
+		widths[i], heights[i] = Size(b)
+	}
+	return widths, heights
+}
@@ -0,0 +1,68 @@
+package tuistyles
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSize(t *testing.T) {
+	tests := []struct {
+		name          string
+		input         string
+		width, height int
+	}{
+		{"empty", "", 0, 1},
+		{"single line", "hello", 5, 1},
+		{"widest line wins", "a\nlonger\nmid", 6, 3},
+		{"ansi ignored", "\x1b[1;31mred\x1b[0m", 3, 1},
+		{"wide characters", "日本", 4, 1},
+		{"trailing newline", "a\n", 1, 2},
+		{"hyperlink", Hyperlink("https://example.com", "link"), 4, 1},
+		{"bordered box", NewStyle().Border(RoundedBorder()).Render("Hi"), 4, 3},
+		{"padded box", NewStyle().Padding(1, 2).Render("Hi"), 6, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w, h := Size(tt.input)
+			require.Equal(t, tt.width, w)
+			require.Equal(t, tt.height, h)
+			require.Equal(t, tt.width, Width(tt.input))
+			require.Equal(t, tt.height, Height(tt.input))
+		})
+	}
+}
+
+func TestMeasure(t *testing.T) {
+	widths, heights := Measure("ab", "c\ndef", "")
+	require.Equal(t, []int{2, 3, 0}, widths)
+	require.Equal(t, []int{1, 2, 1}, heights)
+
+	widths, heights = Measure()
+	require.Empty(t, widths)
+	require.Empty(t, heights)
+}
+
+func TestCursorAfter(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  Point
+	}{
+		{"empty", "", Point{}},
+		{"single line", "hello", Point{X: 5}},
+		{"last line counts", "a long line\nab", Point{X: 2, Y: 1}},
+		{"trailing newline", "block\n", Point{Y: 1}},
+		{"trailing reset", "\x1b[1mhi\x1b[0m", Point{X: 2}},
+		{"wide characters", "日本", Point{X: 4}},
+		{"carriage return", "loading\rok", Point{X: 2}},
+		{"bordered box", NewStyle().Border(RoundedBorder()).Render("Name"), Point{X: 6, Y: 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, CursorAfter(tt.input))
+		})
+	}
+}
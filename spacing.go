@@ -187,3 +187,52 @@ func (s Style) MarginLeft(v int) Style {
 	s2.marginLeft = &v
 	return s2
 }
+
+// PaddingTopBackground sets the background color of the top padding rows,
+// overriding Background there.
+//
+// Returns a new Style with paddingTopBackground set, leaving the original unchanged.
+func (s Style) PaddingTopBackground(c Color) Style {
+	s2 := s
+	s2.paddingTopBackground = &c
+	return s2
+}
+
+// PaddingRightBackground sets the background color of the right padding
+// columns, overriding Background there. The columns run the full height of
+// the box, including the top and bottom padding rows.
+//
+// Returns a new Style with paddingRightBackground set, leaving the original unchanged.
+func (s Style) PaddingRightBackground(c Color) Style {
+	s2 := s
+	s2.paddingRightBackground = &c
+	return s2
+}
+
+// PaddingBottomBackground sets the background color of the bottom padding
+// rows, overriding Background there.
+//
+// Returns a new Style with paddingBottomBackground set, leaving the original unchanged.
+func (s Style) PaddingBottomBackground(c Color) Style {
+	s2 := s
+	s2.paddingBottomBackground = &c
+	return s2
+}
+
+// PaddingLeftBackground sets the background color of the left padding
+// columns, overriding Background there. The columns run the full height of
+// the box, which makes a colored gutter strip for blockquotes and diff views.
+//
+// Returns a new Style with paddingLeftBackground set, leaving the original unchanged.
+//
+// Example:
+//
+//	// Blockquote: one-cell blue gutter strip beside the text
+//	blue, _ := NewColor("blue")
+//	quote := NewStyle().PaddingLeft(1).PaddingLeftBackground(blue)
+//	fmt.Println(quote.Render(" Quoted text\n spanning lines"))
+func (s Style) PaddingLeftBackground(c Color) Style {
+	s2 := s
+	s2.paddingLeftBackground = &c
+	return s2
+}
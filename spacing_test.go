@@ -1,8 +1,12 @@
 package tuistyles
 
 import (
+	"strings"
 	"testing"
 
+	"github.com/orchard9/tui-styles/internal/ansi"
+	"github.com/orchard9/tui-styles/internal/measure"
+
 	"github.com/stretchr/testify/require"
 )
 
@@ -290,3 +294,49 @@ func TestSpacing_WithOtherMethods(t *testing.T) {
 
 	require.Equal(t, 80, *s.width)
 }
+
+// TestPadding_SideBackgrounds tests per-side padding backgrounds (gutter styling).
+func TestPadding_SideBackgrounds(t *testing.T) {
+	blue := Color("blue")
+	gray := Color("gray")
+
+	s := NewStyle().Background(gray).Padding(1).PaddingLeftBackground(blue)
+	lines := strings.Split(s.Render("x"), "\n")
+	require.Len(t, lines, 3)
+
+	gutter := cellsOf(blue, 1)
+
+	// The gutter runs the full height, including top and bottom padding rows
+	require.Equal(t, gutter+cellsOf(gray, 1)+cellsOf(gray, 1), lines[0])
+	require.True(t, strings.HasPrefix(lines[1], gutter))
+	require.Equal(t, lines[0], lines[2])
+
+	// Widths are unaffected
+	for _, line := range lines {
+		require.Equal(t, 3, measure.Width(line))
+	}
+}
+
+// TestPadding_SideBackgroundsTopBottom tests top/bottom padding colors.
+func TestPadding_SideBackgroundsTopBottom(t *testing.T) {
+	red := Color("red")
+	s := NewStyle().Padding(1, 0).PaddingTopBackground(red)
+	lines := strings.Split(s.Render("ab"), "\n")
+	require.Len(t, lines, 3)
+	require.Equal(t, red.ToANSIBackground()+"  "+ansi.Reset(), lines[0])
+	require.Equal(t, "  ", lines[2])
+}
+
+// TestPadding_SideBackgroundsUnchangedWhenUnset tests that output is identical without per-side colors.
+func TestPadding_SideBackgroundsUnchangedWhenUnset(t *testing.T) {
+	gray := Color("gray")
+	s := NewStyle().Background(gray).Padding(1, 2)
+	want := cellsOf(gray, 6) + "\n" + strings.Repeat(gray.ToANSIBackground()+" "+ansi.Reset(), 2) +
+		gray.ToANSIBackground() + "ab" + ansi.Reset() +
+		strings.Repeat(gray.ToANSIBackground()+" "+ansi.Reset(), 2) + "\n" + cellsOf(gray, 6)
+	require.Equal(t, want, s.Render("ab"))
+}
+
+func cellsOf(bg Color, n int) string {
+	return bg.ToANSIBackground() + strings.Repeat(" ", n) + ansi.Reset()
+}
@@ -0,0 +1,112 @@
+package tuistyles
+
+import (
+	"strings"
+	"time"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+// Spinner is an animation made of text frames.
+//
+// Spinner does not run timers or write to the terminal; a render loop asks
+// for the frame to draw with Frame (by tick count) or FrameAt (by elapsed
+// time). Frames are padded to a common width, so a spinner never makes the
+// surrounding layout jitter.
+//
+// Example:
+//
+//	sp := SpinnerDots()
+//	sp.Style = NewStyle().Foreground(Color("magenta"))
+//	start := time.Now()
+//	for range time.Tick(sp.Interval) {
+//	    fmt.Printf("\r%s Loading", sp.FrameAt(time.Since(start)))
+//	}
+type Spinner struct {
+	Frames   []string      // Frames in display order
+	Interval time.Duration // Time each frame is shown
+	Style    Style         // Style applied to every frame
+}
+
+// SpinnerDots returns a braille dots spinner.
+func SpinnerDots() Spinner {
+	return Spinner{
+		Frames:   []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"},
+		Interval: 80 * time.Millisecond,
+	}
+}
+
+// SpinnerLine returns an ASCII spinning line, safe for any terminal.
+func SpinnerLine() Spinner {
+	return Spinner{
+		Frames:   []string{"|", "/", "-", "\\"},
+		Interval: 100 * time.Millisecond,
+	}
+}
+
+// SpinnerBounce returns a ball bouncing between brackets.
+func SpinnerBounce() Spinner {
+	return Spinner{
+		Frames:   []string{"[●    ]", "[ ●   ]", "[  ●  ]", "[   ● ]", "[    ●]", "[   ● ]", "[  ●  ]", "[ ●   ]"},
+		Interval: 120 * time.Millisecond,
+	}
+}
+
+// SpinnerClock returns a clock face advancing one hour per frame.
+func SpinnerClock() Spinner {
+	return Spinner{
+		Frames:   []string{"🕛", "🕐", "🕑", "🕒", "🕓", "🕔", "🕕", "🕖", "🕗", "🕘", "🕙", "🕚"},
+		Interval: 100 * time.Millisecond,
+	}
+}
+
+// Len returns the number of frames.
+func (sp Spinner) Len() int {
+	return len(sp.Frames)
+}
+
+// Width returns the width of the widest frame in cells; every frame is
+// rendered at this width.
+func (sp Spinner) Width() int {
+	w := 0
+	for _, f := range sp.Frames {
+		w = max(w, measure.Width(f))
+	}
+	return w
+}
+
+// Frame returns styled frame i, wrapping around so a render loop can pass
+// an ever-increasing tick count. Negative i counts back from the end.
+// Returns "" for a spinner without frames.
+func (sp Spinner) Frame(i int) string {
+	n := len(sp.Frames)
+	if n == 0 {
+		return ""
+	}
+
+	i %= n
+	if i < 0 {
+		i += n
+	}
+
+	frame := sp.Frames[i]
+	if pad := sp.Width() - measure.Width(frame); pad > 0 {
+		frame += strings.Repeat(" ", pad)
+	}
+	return sp.Style.Render(frame)
+}
+
+// FrameAt returns the styled frame to show after elapsed time, based on
+// Interval. A spinner without an interval always shows its first frame.
+func (sp Spinner) FrameAt(elapsed time.Duration) string {
+	return sp.Frame(sp.Index(elapsed))
+}
+
+// Index returns the frame index to show after elapsed time, for loops that
+// track frames themselves.
+func (sp Spinner) Index(elapsed time.Duration) int {
+	if sp.Interval <= 0 || len(sp.Frames) == 0 || elapsed < 0 {
+		return 0
+	}
+	return int(elapsed/sp.Interval) % len(sp.Frames)
+}
@@ -0,0 +1,69 @@
+package tuistyles
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+func TestSpinner_Frame(t *testing.T) {
+	sp := SpinnerLine()
+
+	require.Equal(t, "|", sp.Frame(0))
+	require.Equal(t, "/", sp.Frame(1))
+	require.Equal(t, "|", sp.Frame(4), "frames wrap around")
+	require.Equal(t, "\\", sp.Frame(-1), "negative indexes count from the end")
+}
+
+func TestSpinner_StableWidth(t *testing.T) {
+	sp := Spinner{Frames: []string{".", "..", "..."}}
+
+	for i := 0; i < sp.Len(); i++ {
+		require.Equal(t, 3, measure.Width(sp.Frame(i)), "frame %d", i)
+	}
+}
+
+func TestSpinner_Predefined(t *testing.T) {
+	for name, sp := range map[string]Spinner{
+		"dots":   SpinnerDots(),
+		"line":   SpinnerLine(),
+		"bounce": SpinnerBounce(),
+		"clock":  SpinnerClock(),
+	} {
+		t.Run(name, func(t *testing.T) {
+			require.NotZero(t, sp.Len())
+			require.Positive(t, sp.Interval)
+			for _, f := range sp.Frames {
+				require.Equal(t, sp.Width(), measure.Width(f), "predefined frames should share a width")
+			}
+		})
+	}
+}
+
+func TestSpinner_FrameAt(t *testing.T) {
+	sp := SpinnerLine() // 100ms per frame
+
+	require.Equal(t, 0, sp.Index(0))
+	require.Equal(t, 0, sp.Index(99*time.Millisecond))
+	require.Equal(t, 1, sp.Index(100*time.Millisecond))
+	require.Equal(t, 1, sp.Index(500*time.Millisecond))
+	require.Equal(t, "-", sp.FrameAt(250*time.Millisecond))
+
+	sp.Interval = 0
+	require.Equal(t, 0, sp.Index(time.Hour))
+}
+
+func TestSpinner_Styled(t *testing.T) {
+	sp := SpinnerLine()
+	sp.Style = NewStyle().Bold(true)
+
+	require.Equal(t, NewStyle().Bold(true).Render("|"), sp.Frame(0))
+}
+
+func TestSpinner_Empty(t *testing.T) {
+	require.Empty(t, Spinner{}.Frame(3))
+	require.Zero(t, Spinner{}.Index(time.Second))
+}
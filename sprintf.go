@@ -0,0 +1,149 @@
+package tuistyles
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Markers delimiting styled arguments while fmt formats the line. They are
+// private-use code points, which ordinary text does not use.
+const (
+	sprintfOpen  = "\ue000" // Precedes the argument index
+	sprintfSplit = "\ue001" // Separates the index from the formatted text
+	sprintfClose = "\ue002" // Ends the formatted text
+)
+
+// Sprintf formats like fmt.Sprintf and renders the result inline with s,
+// letting StyledString and Span arguments carry their own styles.
+//
+// Each styled argument is drawn with its own style layered over s: its
+// attributes and colors win, and anything it leaves unset (such as the
+// background) comes from s, so the surrounding style continues unbroken on
+// both sides of the segment. Width and alignment flags such as %-8s pad with
+// the surrounding style. Like StyledString, only inline properties of the
+// styles apply.
+//
+// Example:
+//
+//	warn := NewStyle().Foreground(Color("yellow")).Bold(true)
+//	bar := NewStyle().Background(Color("#303030"))
+//	fmt.Println(bar.Sprintf("CPU: %s  MEM: %s", Styled("93%", warn), "41%"))
+func (s Style) Sprintf(format string, args ...any) string {
+	return s.sprintfStyled(format, args...).Render()
+}
+
+// Stylef formats like fmt.Sprintf, rendering StyledString and Span arguments
+// with their own styles. It is Sprintf on an empty Style.
+//
+// Example:
+//
+//	fmt.Println(Stylef("CPU: %s", Styled("45%", warnStyle)))
+func Stylef(format string, args ...any) string {
+	return NewStyle().Sprintf(format, args...)
+}
+
+// sprintfStyled formats the arguments into a StyledString whose plain text
+// is drawn in s
+func (s Style) sprintfStyled(format string, args ...any) StyledString {
+	styled := make(map[int]StyledString)
+	wrapped := make([]any, len(args))
+	for i, arg := range args {
+		switch v := arg.(type) {
+		case StyledString:
+			styled[i] = v
+			wrapped[i] = sprintfArg{index: i, text: v.String()}
+		case Span:
+			styled[i] = NewStyledString(v)
+			wrapped[i] = sprintfArg{index: i, text: v.Text}
+		default:
+			wrapped[i] = arg
+		}
+	}
+
+	formatted := fmt.Sprintf(format, wrapped...)
+
+	var result StyledString
+	for {
+		start := strings.Index(formatted, sprintfOpen)
+		if start < 0 {
+			break
+		}
+		split := strings.Index(formatted[start:], sprintfSplit)
+		end := strings.Index(formatted[start:], sprintfClose)
+		if split < 0 || end < split {
+			break
+		}
+		split += start
+		end += start
+
+		index, _ := strconv.Atoi(formatted[start+len(sprintfOpen) : split]) //nolint:errcheck // written by sprintfArg
+		text := formatted[split+len(sprintfSplit) : end]
+
+		result = result.Append(formatted[:start], s)
+		result = result.Concat(layerSegment(styled[index], text, s))
+		formatted = formatted[end+len(sprintfClose):]
+	}
+	return result.Append(formatted, s)
+}
+
+// layerSegment returns the formatted text of a styled argument with the
+// argument's spans layered over outer. Padding added by width flags is drawn
+// in outer; text cut by a precision flag keeps the first span's style.
+func layerSegment(arg StyledString, text string, outer Style) StyledString {
+	plain := arg.String()
+	at := strings.Index(text, plain)
+	if at < 0 {
+		style := outer
+		if spans := arg.Spans(); len(spans) > 0 {
+			style = spans[0].Style.inheritInline(outer)
+		}
+		return Styled(text, style)
+	}
+
+	result := Styled(text[:at], outer)
+	for _, span := range arg.Spans() {
+		result = result.Append(span.Text, span.Style.inheritInline(outer))
+	}
+	return result.Append(text[at+len(plain):], outer)
+}
+
+// sprintfArg stands in for a styled argument while fmt formats the line,
+// applying the verb's flags to the plain text and marking where it went
+type sprintfArg struct {
+	index int
+	text  string
+}
+
+// Format implements fmt.Formatter
+func (a sprintfArg) Format(f fmt.State, verb rune) {
+	_, _ = fmt.Fprint(f, sprintfOpen, strconv.Itoa(a.index), sprintfSplit)
+	_, _ = fmt.Fprintf(f, fmt.FormatString(f, verb), a.text)
+	_, _ = fmt.Fprint(f, sprintfClose)
+}
+
+// inheritInline returns s with its unset text attributes and colors taken
+// from parent, for drawing a nested segment inside parent's text
+func (s Style) inheritInline(parent Style) Style {
+	for _, attr := range []struct{ dst, src **bool }{
+		{&s.bold, &parent.bold},
+		{&s.italic, &parent.italic},
+		{&s.underline, &parent.underline},
+		{&s.strikethrough, &parent.strikethrough},
+		{&s.faint, &parent.faint},
+		{&s.blink, &parent.blink},
+		{&s.reverse, &parent.reverse},
+		{&s.autoForeground, &parent.autoForeground},
+	} {
+		if *attr.dst == nil {
+			*attr.dst = *attr.src
+		}
+	}
+	if s.foreground == nil {
+		s.foreground = parent.foreground
+	}
+	if s.background == nil {
+		s.background = parent.background
+	}
+	return s
+}
@@ -0,0 +1,57 @@
+package tuistyles
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+func TestStylef(t *testing.T) {
+	warn := NewStyle().Foreground(Color("yellow"))
+
+	tests := []struct {
+		name   string
+		format string
+		args   []any
+		want   string
+	}{
+		{"styled argument", "CPU: %s", []any{Styled("45%", warn)}, "CPU: " + warn.renderInline("45%")},
+		{"plain arguments", "%s=%d", []any{"n", 3}, "n=3"},
+		{"span argument", "[%s]", []any{Span{Text: "ok", Style: warn}}, "[" + warn.renderInline("ok") + "]"},
+		{"width pads outside the segment", "%-5s|", []any{Styled("ab", warn)}, warn.renderInline("ab") + "   |"},
+		{"right aligned", "%5s|", []any{Styled("ab", warn)}, "   " + warn.renderInline("ab") + "|"},
+		{"explicit argument index", "%[2]s %[1]s", []any{Styled("a", warn), "b"}, "b " + warn.renderInline("a")},
+		{"precision keeps style", "%.2s", []any{Styled("abcd", warn)}, warn.renderInline("ab")},
+		{"multi-span argument", "%s", []any{Styled("a", warn).Append("b", NewStyle())}, warn.renderInline("a") + "b"},
+		{"quoted", "%q", []any{Styled("x", warn)}, `"` + warn.renderInline("x") + `"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, Stylef(tt.format, tt.args...))
+		})
+	}
+}
+
+func TestStyle_Sprintf_ReappliesOuterStyle(t *testing.T) {
+	bg := Color("#303030")
+	outer := NewStyle().Background(bg).Bold(true)
+	warn := NewStyle().Foreground(Color("yellow"))
+
+	got := outer.Sprintf("CPU: %s done", Styled("93%", warn))
+
+	inner := warn.Background(bg).Bold(true)
+	want := outer.renderInline("CPU: ") + inner.renderInline("93%") + outer.renderInline(" done")
+	require.Equal(t, want, got)
+	require.Equal(t, "CPU: 93% done", measure.StripANSI(got))
+}
+
+func TestStyle_InheritInline(t *testing.T) {
+	parent := NewStyle().Bold(true).Italic(true).Foreground(Color("red")).Background(Color("blue")).Padding(2)
+	child := NewStyle().Italic(false).Foreground(Color("green"))
+
+	got := child.inheritInline(parent)
+	require.True(t, got.Equal(NewStyle().Bold(true).Italic(false).Foreground(Color("green")).Background(Color("blue"))))
+}
@@ -0,0 +1,115 @@
+package tuistyles
+
+import (
+	"fmt"
+	"strings"
+)
+
+// State is the interaction state of a widget: whether it has focus, has
+// lost it, or cannot be used
+type State int
+
+const (
+	// StateFocused is the widget receiving input
+	StateFocused State = iota
+	// StateBlurred is an enabled widget without focus
+	StateBlurred
+	// StateDisabled is a widget that cannot be used
+	StateDisabled
+)
+
+// String returns human-readable state name
+func (st State) String() string {
+	switch st {
+	case StateFocused:
+		return "Focused"
+	case StateBlurred:
+		return "Blurred"
+	case StateDisabled:
+		return "Disabled"
+	default:
+		return "Unknown"
+	}
+}
+
+// MarshalText encodes the state as its lowercase name ("focused", "blurred", "disabled").
+func (st State) MarshalText() ([]byte, error) {
+	if st < StateFocused || st > StateDisabled {
+		return nil, fmt.Errorf("invalid state: %d", int(st))
+	}
+	return []byte(strings.ToLower(st.String())), nil
+}
+
+// UnmarshalText decodes a state name (case-insensitive).
+func (st *State) UnmarshalText(text []byte) error {
+	name := strings.ToLower(string(text))
+	for candidate := StateFocused; candidate <= StateDisabled; candidate++ {
+		if strings.ToLower(candidate.String()) == name {
+			*st = candidate
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid state: %q", string(text))
+}
+
+// StatefulStyle holds one style per interaction State, so every widget in
+// an app switches between focused, blurred, and disabled looks the same
+// way. A state left as the zero Style uses Focused.
+//
+// Build one by hand, or derive the other states from a focused style with
+// Theme.StatefulStyle.
+//
+// Example:
+//
+//	input := StatefulStyle{
+//	    Focused:  NewStyle().Border(RoundedBorder()).BorderForeground(Color("magenta")),
+//	    Blurred:  NewStyle().Border(RoundedBorder()).BorderForeground(Color("bright-black")),
+//	    Disabled: NewStyle().Border(RoundedBorder()).Faint(true),
+//	}
+//	fmt.Println(input.Render(StateBlurred, value))
+type StatefulStyle struct {
+	Focused  Style
+	Blurred  Style
+	Disabled Style
+}
+
+// Style returns the style for state. Unknown states use Focused.
+func (s StatefulStyle) Style(state State) Style {
+	switch state {
+	case StateBlurred:
+		return orDefault(s.Blurred, s.Focused)
+	case StateDisabled:
+		return orDefault(s.Disabled, s.Focused)
+	default:
+		return s.Focused
+	}
+}
+
+// Render renders text in the style for state.
+func (s StatefulStyle) Render(state State, text string) string {
+	return s.Style(state).Render(text)
+}
+
+// StatefulStyle derives the blurred and disabled looks from a focused
+// style: blurred dims the text and draws any border in the theme's Border
+// color, and disabled additionally turns the text Muted. Empty theme colors
+// come from DefaultTheme.
+//
+// Example:
+//
+//	button := DefaultTheme().StatefulStyle(NewStyle().Bold(true).Foreground(Color("magenta")))
+//	fmt.Println(button.Render(StateDisabled, "Submit"))
+func (t Theme) StatefulStyle(focused Style) StatefulStyle {
+	t = t.WithDefaults()
+	blurred := focused.Faint(true)
+	if focused.hasBorder() {
+		blurred = blurred.BorderForeground(t.Border)
+		blurred.borderTopForeground, blurred.borderRightForeground = nil, nil
+		blurred.borderBottomForeground, blurred.borderLeftForeground = nil, nil
+	}
+	return StatefulStyle{
+		Focused:  focused,
+		Blurred:  blurred,
+		Disabled: blurred.Foreground(t.Muted),
+	}
+}
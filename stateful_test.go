@@ -0,0 +1,57 @@
+package tuistyles
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestState_Text(t *testing.T) {
+	for _, st := range []State{StateFocused, StateBlurred, StateDisabled} {
+		text, err := st.MarshalText()
+		require.NoError(t, err)
+
+		var decoded State
+		require.NoError(t, decoded.UnmarshalText(text))
+		require.Equal(t, st, decoded)
+	}
+
+	_, err := State(7).MarshalText()
+	require.Error(t, err)
+
+	var st State
+	require.Error(t, st.UnmarshalText([]byte("hovered")))
+	require.Equal(t, "Unknown", State(7).String())
+}
+
+func TestStatefulStyle_Style(t *testing.T) {
+	focused := NewStyle().Bold(true)
+	blurred := NewStyle().Faint(true)
+
+	s := StatefulStyle{Focused: focused, Blurred: blurred}
+	require.Equal(t, focused, s.Style(StateFocused))
+	require.Equal(t, blurred, s.Style(StateBlurred))
+	require.Equal(t, focused, s.Style(StateDisabled), "unset state falls back to Focused")
+	require.Equal(t, focused, s.Style(State(7)))
+	require.Equal(t, blurred.Render("x"), s.Render(StateBlurred, "x"))
+}
+
+func TestTheme_StatefulStyle(t *testing.T) {
+	theme := DefaultTheme()
+	focused := NewStyle().Bold(true).Border(RoundedBorder()).
+		BorderForeground(theme.Primary).BorderTopForeground(theme.Primary)
+
+	s := Theme{}.StatefulStyle(focused)
+	require.Equal(t, focused, s.Focused)
+
+	require.True(t, *s.Blurred.faint)
+	require.True(t, *s.Blurred.bold)
+	require.Equal(t, theme.Border, *s.Blurred.borderForeground)
+	require.Nil(t, s.Blurred.borderTopForeground)
+
+	require.True(t, *s.Disabled.faint)
+	require.Equal(t, theme.Muted, *s.Disabled.foreground)
+
+	plain := Theme{}.StatefulStyle(NewStyle())
+	require.Nil(t, plain.Blurred.borderForeground, "no border color without a border")
+}
@@ -0,0 +1,120 @@
+package tuistyles
+
+import (
+	"strings"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+// StatusZone is one zone of a StatusBar
+type StatusZone struct {
+	Content  string // One line; may already be styled
+	Style    Style  // Layered over the bar's Style
+	Priority int    // Zones with a lower priority are truncated first
+}
+
+// StatusBar lays out a one-line footer with left, center, and right zones:
+//
+//	NORMAL  main.go          Ln 12, Col 4
+//
+// The left zone starts at the left edge and the right zone ends at the
+// right edge; the center zone is centered on the bar, moving aside when
+// its neighbors are too wide. When the zones do not fit, they are
+// truncated with "…" one at a time, lowest Priority first (among equal
+// priorities: center, then right, then left), and a zone truncated to
+// nothing is dropped.
+//
+// Example:
+//
+//	bar := StatusBar{
+//	    Left:   StatusZone{Content: "NORMAL", Priority: 2},
+//	    Center: StatusZone{Content: filename},
+//	    Right:  StatusZone{Content: fmt.Sprintf("Ln %d, Col %d", line, col), Priority: 1},
+//	    Style:  NewStyle().Background(Color("#303030")),
+//	}
+//	fmt.Println(bar.Render(termWidth))
+type StatusBar struct {
+	Left, Center, Right StatusZone
+
+	Style Style // The whole bar, such as a background; unstyled by default
+}
+
+// Render draws the bar exactly width cells wide. A width of 0 or less
+// gives each zone its natural width, one space apart.
+func (b StatusBar) Render(width int) string {
+	zones := [3]StatusZone{b.Left, b.Center, b.Right}
+	var texts [3]string
+	var widths [3]int
+	for i, zone := range zones {
+		texts[i], _, _ = strings.Cut(zone.Content, "\n")
+		widths[i] = measure.Width(texts[i])
+	}
+
+	gap := func() int {
+		shown := 0
+		for _, w := range widths {
+			if w > 0 {
+				shown++
+			}
+		}
+		return max(shown-1, 0)
+	}
+	if width <= 0 {
+		width = widths[0] + widths[1] + widths[2] + gap()
+	}
+
+	// Take cells from the zone that gives them up first until the bar fits
+	order := []int{1, 2, 0}
+	for {
+		excess := widths[0] + widths[1] + widths[2] + gap() - width
+		if excess <= 0 {
+			break
+		}
+		pick := -1
+		for _, i := range order {
+			if widths[i] > 0 && (pick < 0 || zones[i].Priority < zones[pick].Priority) {
+				pick = i
+			}
+		}
+		if pick < 0 {
+			break
+		}
+		// A cut inside a wide character leaves the zone a cell narrower
+		// than asked for
+		target := max(widths[pick]-excess, 0)
+		texts[pick] = truncateKeeping(texts[pick], target, "…", target, false)
+		widths[pick] = measure.Width(texts[pick])
+	}
+
+	// The center zone sits mid-bar when it can, else between its neighbors
+	left, center, right := widths[0], widths[1], widths[2]
+	start := (width - center) / 2
+	if left > 0 {
+		start = max(start, left+1)
+	}
+	if right > 0 {
+		start = min(start, width-right-1-center)
+	}
+	start = max(start, left)
+
+	fill := func(n int) string {
+		return b.Style.Render(strings.Repeat(" ", max(n, 0)))
+	}
+	var out strings.Builder
+	render := func(i int) string {
+		if widths[i] == 0 {
+			return ""
+		}
+		return zones[i].Style.over(b.Style).Render(texts[i])
+	}
+	out.WriteString(render(0))
+	if center > 0 {
+		out.WriteString(fill(start - left))
+		out.WriteString(render(1))
+		out.WriteString(fill(width - start - center - right))
+	} else {
+		out.WriteString(fill(width - left - right))
+	}
+	out.WriteString(render(2))
+	return out.String()
+}
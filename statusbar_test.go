@@ -0,0 +1,59 @@
+package tuistyles
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+func TestStatusBar_Render(t *testing.T) {
+	bar := StatusBar{
+		Left:   StatusZone{Content: "NORMAL", Priority: 2},
+		Center: StatusZone{Content: "main.go"},
+		Right:  StatusZone{Content: "Ln 12", Priority: 1},
+	}
+
+	tests := []struct {
+		name  string
+		bar   StatusBar
+		width int
+		want  string
+	}{
+		{"natural", bar, 0, "NORMAL main.go Ln 12"},
+		{"centered", bar, 31, "NORMAL      main.go       Ln 12"},
+		{"center moves aside", StatusBar{Left: StatusZone{Content: "a long left zone"}, Center: StatusZone{Content: "mid"}}, 24, "a long left zone mid    "},
+		{"center truncated first", bar, 18, "NORMAL main… Ln 12"},
+		{"center dropped", bar, 13, "NORMAL  Ln 12"},
+		{"then right", bar, 9, "NORMAL L…"},
+		{"then left", bar, 4, "NOR…"},
+		{"no center", StatusBar{Left: StatusZone{Content: "a"}, Right: StatusZone{Content: "b"}}, 5, "a   b"},
+		{"empty", StatusBar{}, 3, "   "},
+		{"cut inside a wide character", StatusBar{
+			Left:  StatusZone{Content: "日本語のファイル名"},
+			Right: StatusZone{Content: "Ln 1", Priority: 1},
+		}, 19, "日本語のファ…  Ln 1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := measure.StripANSI(tt.bar.Render(tt.width))
+			require.Equal(t, tt.want, got)
+			if tt.width > 0 {
+				require.Equal(t, tt.width, measure.Width(got))
+			}
+		})
+	}
+}
+
+func TestStatusBar_Styles(t *testing.T) {
+	background := NewStyle().Background(Color("#303030"))
+	mode := NewStyle().Bold(true)
+	bar := StatusBar{Left: StatusZone{Content: "NORMAL", Style: mode}, Right: StatusZone{Content: "x"}, Style: background}
+
+	got := bar.Render(10)
+	require.Contains(t, got, mode.over(background).Render("NORMAL"))
+	require.Contains(t, got, background.Render("   "))
+	require.Contains(t, got, background.Render("x"))
+}
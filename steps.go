@@ -0,0 +1,100 @@
+package tuistyles
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+// Steps renders the progress of a multi-step flow such as a setup wizard,
+// marking which steps are done, which is current, and which are pending:
+//
+//	① Setup ─ ② Configure ─ ③ Done
+//
+// When the trail does not fit, pending and done steps lose their labels
+// first ("① ─ ② Configure ─ ③"), then the current step does ("① ─ ② ─ ③").
+//
+// Example:
+//
+//	steps := Steps{Labels: []string{"Setup", "Configure", "Done"}, Current: 1}
+//	fmt.Println(steps.Render(40))
+type Steps struct {
+	Labels    []string
+	Current   int    // Index of the step in progress; earlier steps are done, and len(Labels) means all are
+	Connector string // Between steps with a space each side; "─" by default
+	Theme     Theme
+
+	DoneStyle      Style // Success by default
+	CurrentStyle   Style // Bold primary by default
+	PendingStyle   Style // Muted by default
+	ConnectorStyle Style // Border color by default
+}
+
+// Render draws the steps within width cells, dropping labels as described
+// on Steps when they do not fit and truncating the result if even the
+// numbers alone are too wide. A width of 0 or less shows every label.
+func (s Steps) Render(width int) string {
+	if len(s.Labels) == 0 {
+		return ""
+	}
+
+	theme := s.Theme.WithDefaults()
+	styles := [...]Style{
+		orDefault(s.DoneStyle, NewStyle().Foreground(theme.Success)),
+		orDefault(s.CurrentStyle, NewStyle().Bold(true).Foreground(theme.Primary)),
+		orDefault(s.PendingStyle, NewStyle().Foreground(theme.Muted)),
+	}
+	connector := s.Connector
+	if connector == "" {
+		connector = "─"
+	}
+	sep := " " + orDefault(s.ConnectorStyle, NewStyle().Foreground(theme.Border)).Render(connector) + " "
+
+	// Each pass shows fewer labels: all, the current step's, none
+	var line string
+	for pass := range 3 {
+		steps := make([]string, len(s.Labels))
+		for i, label := range s.Labels {
+			state := s.state(i)
+			text := stepNumber(i + 1)
+			if pass == 0 || pass == 1 && state == stepCurrent {
+				text += " " + label
+			}
+			steps[i] = styles[state].Render(text)
+		}
+		line = strings.Join(steps, sep)
+		if width <= 0 || measure.Width(line) <= width {
+			return line
+		}
+	}
+	return measure.TruncateStyled(line, width)
+}
+
+// Step states, indexing the styles in Steps.Render
+const (
+	stepDone = iota
+	stepCurrent
+	stepPending
+)
+
+// state returns whether step i is done, current, or pending
+func (s Steps) state(i int) int {
+	switch {
+	case i < s.Current:
+		return stepDone
+	case i == s.Current:
+		return stepCurrent
+	default:
+		return stepPending
+	}
+}
+
+// stepNumber returns n as a circled digit for 1 through 20, and in
+// parentheses beyond
+func stepNumber(n int) string {
+	if n >= 1 && n <= 20 {
+		return string(rune('①' + n - 1))
+	}
+	return "(" + strconv.Itoa(n) + ")"
+}
@@ -0,0 +1,60 @@
+package tuistyles
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+func TestSteps_Render(t *testing.T) {
+	labels := []string{"Setup", "Configure", "Done"}
+	tests := []struct {
+		name  string
+		steps Steps
+		width int
+		want  string
+	}{
+		{"empty", Steps{}, 0, ""},
+		{"full", Steps{Labels: labels, Current: 1}, 0, "① Setup ─ ② Configure ─ ③ Done"},
+		{"fits", Steps{Labels: labels, Current: 1}, 30, "① Setup ─ ② Configure ─ ③ Done"},
+		{"current label only", Steps{Labels: labels, Current: 1}, 29, "① ─ ② Configure ─ ③"},
+		{"numbers only", Steps{Labels: labels, Current: 1}, 18, "① ─ ② ─ ③"},
+		{"truncated", Steps{Labels: labels, Current: 1}, 5, "① ─ ②"},
+		{"custom connector", Steps{Labels: []string{"a", "b"}, Connector: "→"}, 0, "① a → ② b"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, measure.StripANSI(tt.steps.Render(tt.width)))
+		})
+	}
+}
+
+func TestSteps_States(t *testing.T) {
+	done := NewStyle().Foreground(Color("#00ff00"))
+	current := NewStyle().Reverse(true)
+	pending := NewStyle().Faint(true)
+	steps := Steps{
+		Labels:       []string{"a", "b", "c"},
+		Current:      1,
+		DoneStyle:    done,
+		CurrentStyle: current,
+		PendingStyle: pending,
+	}
+
+	got := steps.Render(0)
+	require.Contains(t, got, done.Render("① a"))
+	require.Contains(t, got, current.Render("② b"))
+	require.Contains(t, got, pending.Render("③ c"))
+
+	steps.Current = 3
+	require.Contains(t, steps.Render(0), done.Render("③ c"), "every step is done past the last")
+}
+
+func TestStepNumber(t *testing.T) {
+	require.Equal(t, "①", stepNumber(1))
+	require.Equal(t, "⑳", stepNumber(20))
+	require.Equal(t, "(21)", stepNumber(21))
+}
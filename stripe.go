@@ -0,0 +1,33 @@
+package tuistyles
+
+import "strings"
+
+// Stripe returns a LineStyleFunc alternating between two styles: even for
+// the first line and every other one after it, odd for the rest. Use it as
+// the RowStyle of a Table or List, or with Style.RenderLines.
+//
+// Example:
+//
+//	table := Table{
+//	    Headers:  []string{"Name", "Status"},
+//	    Rows:     rows,
+//	    RowStyle: Stripe(NewStyle(), NewStyle().Background(Color("#262626"))),
+//	}
+func Stripe(even, odd Style) LineStyleFunc {
+	return func(i int, _ string) Style {
+		if i%2 == 0 {
+			return even
+		}
+		return odd
+	}
+}
+
+// StripeLines renders the lines of s with alternating styles, padding each
+// to the widest line so a background runs the full width of the block.
+//
+// Example:
+//
+//	fmt.Println(StripeLines(logOutput, NewStyle(), NewStyle().Faint(true)))
+func StripeLines(s string, even, odd Style) string {
+	return NewStyle().RenderLines(strings.Split(s, "\n"), Stripe(even, odd))
+}
@@ -0,0 +1,30 @@
+package tuistyles
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+func TestStripe(t *testing.T) {
+	even := NewStyle().Bold(true)
+	odd := NewStyle().Italic(true)
+	stripe := Stripe(even, odd)
+
+	for i, want := range []Style{even, odd, even, odd} {
+		require.Equal(t, want, stripe(i, ""))
+	}
+}
+
+func TestStripeLines(t *testing.T) {
+	odd := NewStyle().Background(Color("#303030"))
+	got := StripeLines("first\nsecond\nthird", NewStyle(), odd)
+
+	lines := strings.Split(got, "\n")
+	require.Equal(t, "first \nsecond\nthird ", measure.StripANSI(got))
+	require.Equal(t, odd.Width(6).Align(Left).Render("second"), lines[1])
+	require.NotContains(t, lines[0], "\x1b[48;")
+}
@@ -74,7 +74,14 @@
 // # Thread Safety
 //
 // All Style methods are immutable and return new instances, making them
-// safe to use concurrently from multiple goroutines.
+// safe to use concurrently from multiple goroutines. Renderer and Theme are
+// immutable values too, so a TUI can render from its tick and event loops
+// at once without locking.
+//
+// The package-wide defaults (SetHasDarkBackground, SetDefaultTheme, and
+// SetDefaultRenderer) are the only shared mutable state. They are stored
+// atomically and may be read or changed from any goroutine; renders that
+// start after a change see it.
 //
 // # Performance
 //
@@ -85,6 +92,12 @@
 //   - Layout composition: <5ms for multi-panel layouts
 package tuistyles
 
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
 // Style represents an immutable text styling configuration.
 //
 // All fields are pointers to enable optionality - nil indicates "not set"
@@ -107,15 +120,35 @@ type Style struct {
 	foreground *Color // Text color
 	background *Color // Background color
 
+	// autoForeground picks a readable foreground from the background luminance
+	autoForeground *bool
+
 	// Layout defines dimensions and constraints
 	width     *int // Fixed width in cells
 	height    *int // Fixed height in lines
 	maxWidth  *int // Maximum width in cells
 	maxHeight *int // Maximum height in lines
 
+	// Percent sizes resolve against a container (see Resolve)
+	widthPercent  *int // Width as a percentage of the container width
+	heightPercent *int // Height as a percentage of the container height
+
+	// truncateMode selects which part of a line MaxWidth cuts away
+	truncateMode  *TruncateMode
+	truncateExact *bool // Pad truncated lines to exactly MaxWidth cells
+
 	// Alignment controls text positioning
-	align         *Position // Horizontal alignment (Left, Center, Right)
-	alignVertical *Position // Vertical alignment (Top, Center, Bottom)
+	align            *Position // Horizontal alignment (Left, Center, Right, Decimal)
+	alignVertical    *Position // Vertical alignment (Top, Center, Bottom)
+	decimalSeparator *string   // Separator Decimal alignment lines up ("." if unset)
+
+	// Direction controls bidirectional text layout
+	direction   *TextDirection // Base writing direction (LTR, RTL, Auto)
+	bidiReorder *bool          // Reorder right-to-left runs into visual order
+
+	// Wrapping controls how text is broken across lines
+	hyphenate *bool           // Wrap to width, hyphenating long words
+	longWords *LongWordPolicy // Wrap to width, handling words wider than it
 
 	// Spacing controls padding and margins
 	paddingTop    *int // Padding above content (cells)
@@ -127,6 +160,12 @@ type Style struct {
 	marginBottom  *int // Margin below element (lines)
 	marginLeft    *int // Margin left of element (cells)
 
+	// Per-side padding backgrounds override background within the padding
+	paddingTopBackground    *Color // Top padding rows background
+	paddingRightBackground  *Color // Right padding columns background (full height)
+	paddingBottomBackground *Color // Bottom padding rows background
+	paddingLeftBackground   *Color // Left padding columns background (full height), e.g. a gutter strip
+
 	// Borders control border rendering
 	borderType       *Border // Border style (Rounded, Thick, etc.)
 	borderTop        *bool   // Render top border edge
@@ -135,6 +174,21 @@ type Style struct {
 	borderLeft       *bool   // Render left border edge
 	borderForeground *Color  // Border line color
 	borderBackground *Color  // Border background color
+
+	// Per-side border colors override borderForeground/borderBackground
+	borderTopForeground    *Color // Top edge (and top corners) line color
+	borderRightForeground  *Color // Right edge line color
+	borderBottomForeground *Color // Bottom edge (and bottom corners) line color
+	borderLeftForeground   *Color // Left edge line color
+	borderTopBackground    *Color // Top edge (and top corners) background color
+	borderRightBackground  *Color // Right edge background color
+	borderBottomBackground *Color // Bottom edge (and bottom corners) background color
+	borderLeftBackground   *Color // Left edge background color
+
+	// Effects decorate the finished box
+	shadow            *bool   // Drop shadow to the right and below
+	shadowColor       *Color  // Shadow color
+	backgroundPattern *string // Repeating fill for alignment space and padding
 }
 
 // NewStyle returns a new Style with all fields unset (nil).
@@ -148,3 +202,42 @@ type Style struct {
 func NewStyle() Style {
 	return Style{}
 }
+
+// boxSide identifies one edge of a box (used for per-side borders and padding)
+type boxSide int
+
+const (
+	sideTop boxSide = iota
+	sideRight
+	sideBottom
+	sideLeft
+)
+
+// Err returns the problems found in the style's colors, or nil.
+//
+// Builder methods accept any Color without failing, so a typo such as
+// Color("#GGG") silently renders without that color. Err checks every color
+// property and joins one error per invalid color, each naming the property
+// (as Style.Diff does) and wrapping the error from Color.Validate.
+//
+// Example:
+//
+//	s := NewStyle().Foreground(Color("#GGG"))
+//	err := s.Err() // Foreground: invalid hex color: #GGG
+//	errors.Is(err, ErrInvalidHex) // true
+func (s Style) Err() error {
+	v := reflect.ValueOf(s.toJSON())
+	colorType := reflect.TypeOf((*Color)(nil))
+
+	var errs []error
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Field(i)
+		if field.Type() != colorType || field.IsNil() {
+			continue
+		}
+		if err := field.Interface().(*Color).Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", v.Type().Field(i).Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -29,7 +29,7 @@ func TestStyle_FieldCount(t *testing.T) {
 	s := NewStyle()
 	v := reflect.ValueOf(s)
 
-	expectedFields := 30 // 7 text attrs + 2 colors + 4 layout + 2 align + 8 spacing + 7 border (incl 2 border colors)
+	expectedFields := 55 // 7 text attrs + 2 colors + 1 auto foreground + 4 layout + 2 percent sizes + 2 truncation + 3 align (incl decimal separator) + 2 direction + 2 wrapping + 8 spacing + 4 padding colors + 7 border (incl 2 border colors) + 8 per-side border colors + 2 shadow + 1 background pattern
 	actualFields := v.NumField()
 
 	if actualFields != expectedFields {
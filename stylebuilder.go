@@ -0,0 +1,73 @@
+package tuistyles
+
+import "reflect"
+
+//go:generate go run gen_builder.go
+
+// StyleBuilder builds a Style step by step in place, for code that sets
+// properties across several statements or branches instead of one chain.
+// It has a method for every Style builder method, taking the same
+// arguments.
+//
+// Build returns a deep copy, so the Style it returns shares nothing with
+// the builder or with any Style the builder started from; keep building
+// afterwards and earlier results stay as they were. A StyleBuilder is not
+// safe for concurrent use, but the Styles it builds are.
+//
+// Migrating is optional: chains on Style keep working, and each step of a
+// chain becomes a builder call with the same name.
+//
+//	// Before
+//	s := NewStyle().Bold(true)
+//	if focused {
+//		s = s.Border(RoundedBorder())
+//	}
+//
+//	// After
+//	b := NewStyleBuilder().Bold(true)
+//	if focused {
+//		b.Border(RoundedBorder())
+//	}
+//	s := b.Build()
+type StyleBuilder struct {
+	style Style
+}
+
+// NewStyleBuilder returns a builder for an empty Style.
+func NewStyleBuilder() *StyleBuilder {
+	return &StyleBuilder{}
+}
+
+// Builder returns a builder starting from a deep copy of s.
+//
+// Example:
+//
+//	b := base.Builder()
+//	b.Foreground(Color("red"))
+//	alert := b.Build()
+func (s Style) Builder() *StyleBuilder {
+	return &StyleBuilder{style: s.DeepCopy()}
+}
+
+// Build returns the Style built so far, as a deep copy.
+func (b *StyleBuilder) Build() Style {
+	return b.style.DeepCopy()
+}
+
+// DeepCopy returns a copy of s that shares no memory with it: every set
+// property is copied into a newly allocated value. Style builder methods
+// never modify shared values, so a plain copy is already safe; use
+// DeepCopy when a Style crosses into code that must not share memory with
+// the caller at all.
+func (s Style) DeepCopy() Style {
+	raw := s.toJSON()
+	v := reflect.ValueOf(&raw).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		if f := v.Field(i); f.Kind() == reflect.Pointer && !f.IsNil() {
+			fresh := reflect.New(f.Elem().Type())
+			fresh.Elem().Set(f.Elem())
+			f.Set(fresh)
+		}
+	}
+	return fromJSON(raw)
+}
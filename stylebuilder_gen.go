@@ -0,0 +1,345 @@
+// Code generated by go run gen_builder.go; DO NOT EDIT.
+
+package tuistyles
+
+// Align sets the style being built like Style.Align.
+func (b *StyleBuilder) Align(p Position) *StyleBuilder {
+	b.style = b.style.Align(p)
+	return b
+}
+
+// AlignVertical sets the style being built like Style.AlignVertical.
+func (b *StyleBuilder) AlignVertical(p Position) *StyleBuilder {
+	b.style = b.style.AlignVertical(p)
+	return b
+}
+
+// AutoForeground sets the style being built like Style.AutoForeground.
+func (b *StyleBuilder) AutoForeground(v bool) *StyleBuilder {
+	b.style = b.style.AutoForeground(v)
+	return b
+}
+
+// Background sets the style being built like Style.Background.
+func (b *StyleBuilder) Background(c Color) *StyleBuilder {
+	b.style = b.style.Background(c)
+	return b
+}
+
+// BackgroundPattern sets the style being built like Style.BackgroundPattern.
+func (b *StyleBuilder) BackgroundPattern(pattern string) *StyleBuilder {
+	b.style = b.style.BackgroundPattern(pattern)
+	return b
+}
+
+// BidiReorder sets the style being built like Style.BidiReorder.
+func (b *StyleBuilder) BidiReorder(v bool) *StyleBuilder {
+	b.style = b.style.BidiReorder(v)
+	return b
+}
+
+// Blink sets the style being built like Style.Blink.
+func (b *StyleBuilder) Blink(v bool) *StyleBuilder {
+	b.style = b.style.Blink(v)
+	return b
+}
+
+// Bold sets the style being built like Style.Bold.
+func (b *StyleBuilder) Bold(v bool) *StyleBuilder {
+	b.style = b.style.Bold(v)
+	return b
+}
+
+// Border sets the style being built like Style.Border.
+func (b *StyleBuilder) Border(borderType Border, edges ...bool) *StyleBuilder {
+	b.style = b.style.Border(borderType, edges...)
+	return b
+}
+
+// BorderBackground sets the style being built like Style.BorderBackground.
+func (b *StyleBuilder) BorderBackground(c Color) *StyleBuilder {
+	b.style = b.style.BorderBackground(c)
+	return b
+}
+
+// BorderBottom sets the style being built like Style.BorderBottom.
+func (b *StyleBuilder) BorderBottom(v bool) *StyleBuilder {
+	b.style = b.style.BorderBottom(v)
+	return b
+}
+
+// BorderBottomBackground sets the style being built like Style.BorderBottomBackground.
+func (b *StyleBuilder) BorderBottomBackground(c Color) *StyleBuilder {
+	b.style = b.style.BorderBottomBackground(c)
+	return b
+}
+
+// BorderBottomForeground sets the style being built like Style.BorderBottomForeground.
+func (b *StyleBuilder) BorderBottomForeground(c Color) *StyleBuilder {
+	b.style = b.style.BorderBottomForeground(c)
+	return b
+}
+
+// BorderForeground sets the style being built like Style.BorderForeground.
+func (b *StyleBuilder) BorderForeground(c Color) *StyleBuilder {
+	b.style = b.style.BorderForeground(c)
+	return b
+}
+
+// BorderLeft sets the style being built like Style.BorderLeft.
+func (b *StyleBuilder) BorderLeft(v bool) *StyleBuilder {
+	b.style = b.style.BorderLeft(v)
+	return b
+}
+
+// BorderLeftBackground sets the style being built like Style.BorderLeftBackground.
+func (b *StyleBuilder) BorderLeftBackground(c Color) *StyleBuilder {
+	b.style = b.style.BorderLeftBackground(c)
+	return b
+}
+
+// BorderLeftForeground sets the style being built like Style.BorderLeftForeground.
+func (b *StyleBuilder) BorderLeftForeground(c Color) *StyleBuilder {
+	b.style = b.style.BorderLeftForeground(c)
+	return b
+}
+
+// BorderRight sets the style being built like Style.BorderRight.
+func (b *StyleBuilder) BorderRight(v bool) *StyleBuilder {
+	b.style = b.style.BorderRight(v)
+	return b
+}
+
+// BorderRightBackground sets the style being built like Style.BorderRightBackground.
+func (b *StyleBuilder) BorderRightBackground(c Color) *StyleBuilder {
+	b.style = b.style.BorderRightBackground(c)
+	return b
+}
+
+// BorderRightForeground sets the style being built like Style.BorderRightForeground.
+func (b *StyleBuilder) BorderRightForeground(c Color) *StyleBuilder {
+	b.style = b.style.BorderRightForeground(c)
+	return b
+}
+
+// BorderTop sets the style being built like Style.BorderTop.
+func (b *StyleBuilder) BorderTop(v bool) *StyleBuilder {
+	b.style = b.style.BorderTop(v)
+	return b
+}
+
+// BorderTopBackground sets the style being built like Style.BorderTopBackground.
+func (b *StyleBuilder) BorderTopBackground(c Color) *StyleBuilder {
+	b.style = b.style.BorderTopBackground(c)
+	return b
+}
+
+// BorderTopForeground sets the style being built like Style.BorderTopForeground.
+func (b *StyleBuilder) BorderTopForeground(c Color) *StyleBuilder {
+	b.style = b.style.BorderTopForeground(c)
+	return b
+}
+
+// DecimalSeparator sets the style being built like Style.DecimalSeparator.
+func (b *StyleBuilder) DecimalSeparator(sep string) *StyleBuilder {
+	b.style = b.style.DecimalSeparator(sep)
+	return b
+}
+
+// Direction sets the style being built like Style.Direction.
+func (b *StyleBuilder) Direction(d TextDirection) *StyleBuilder {
+	b.style = b.style.Direction(d)
+	return b
+}
+
+// Faint sets the style being built like Style.Faint.
+func (b *StyleBuilder) Faint(v bool) *StyleBuilder {
+	b.style = b.style.Faint(v)
+	return b
+}
+
+// Foreground sets the style being built like Style.Foreground.
+func (b *StyleBuilder) Foreground(c Color) *StyleBuilder {
+	b.style = b.style.Foreground(c)
+	return b
+}
+
+// Height sets the style being built like Style.Height.
+func (b *StyleBuilder) Height(h int) *StyleBuilder {
+	b.style = b.style.Height(h)
+	return b
+}
+
+// HeightPercent sets the style being built like Style.HeightPercent.
+func (b *StyleBuilder) HeightPercent(p int) *StyleBuilder {
+	b.style = b.style.HeightPercent(p)
+	return b
+}
+
+// Hyphenate sets the style being built like Style.Hyphenate.
+func (b *StyleBuilder) Hyphenate(v bool) *StyleBuilder {
+	b.style = b.style.Hyphenate(v)
+	return b
+}
+
+// Italic sets the style being built like Style.Italic.
+func (b *StyleBuilder) Italic(v bool) *StyleBuilder {
+	b.style = b.style.Italic(v)
+	return b
+}
+
+// LongWords sets the style being built like Style.LongWords.
+func (b *StyleBuilder) LongWords(policy LongWordPolicy) *StyleBuilder {
+	b.style = b.style.LongWords(policy)
+	return b
+}
+
+// Margin sets the style being built like Style.Margin.
+func (b *StyleBuilder) Margin(values ...int) *StyleBuilder {
+	b.style = b.style.Margin(values...)
+	return b
+}
+
+// MarginBottom sets the style being built like Style.MarginBottom.
+func (b *StyleBuilder) MarginBottom(v int) *StyleBuilder {
+	b.style = b.style.MarginBottom(v)
+	return b
+}
+
+// MarginLeft sets the style being built like Style.MarginLeft.
+func (b *StyleBuilder) MarginLeft(v int) *StyleBuilder {
+	b.style = b.style.MarginLeft(v)
+	return b
+}
+
+// MarginRight sets the style being built like Style.MarginRight.
+func (b *StyleBuilder) MarginRight(v int) *StyleBuilder {
+	b.style = b.style.MarginRight(v)
+	return b
+}
+
+// MarginTop sets the style being built like Style.MarginTop.
+func (b *StyleBuilder) MarginTop(v int) *StyleBuilder {
+	b.style = b.style.MarginTop(v)
+	return b
+}
+
+// MaxHeight sets the style being built like Style.MaxHeight.
+func (b *StyleBuilder) MaxHeight(h int) *StyleBuilder {
+	b.style = b.style.MaxHeight(h)
+	return b
+}
+
+// MaxWidth sets the style being built like Style.MaxWidth.
+func (b *StyleBuilder) MaxWidth(w int) *StyleBuilder {
+	b.style = b.style.MaxWidth(w)
+	return b
+}
+
+// Padding sets the style being built like Style.Padding.
+func (b *StyleBuilder) Padding(values ...int) *StyleBuilder {
+	b.style = b.style.Padding(values...)
+	return b
+}
+
+// PaddingBottom sets the style being built like Style.PaddingBottom.
+func (b *StyleBuilder) PaddingBottom(v int) *StyleBuilder {
+	b.style = b.style.PaddingBottom(v)
+	return b
+}
+
+// PaddingBottomBackground sets the style being built like Style.PaddingBottomBackground.
+func (b *StyleBuilder) PaddingBottomBackground(c Color) *StyleBuilder {
+	b.style = b.style.PaddingBottomBackground(c)
+	return b
+}
+
+// PaddingLeft sets the style being built like Style.PaddingLeft.
+func (b *StyleBuilder) PaddingLeft(v int) *StyleBuilder {
+	b.style = b.style.PaddingLeft(v)
+	return b
+}
+
+// PaddingLeftBackground sets the style being built like Style.PaddingLeftBackground.
+func (b *StyleBuilder) PaddingLeftBackground(c Color) *StyleBuilder {
+	b.style = b.style.PaddingLeftBackground(c)
+	return b
+}
+
+// PaddingRight sets the style being built like Style.PaddingRight.
+func (b *StyleBuilder) PaddingRight(v int) *StyleBuilder {
+	b.style = b.style.PaddingRight(v)
+	return b
+}
+
+// PaddingRightBackground sets the style being built like Style.PaddingRightBackground.
+func (b *StyleBuilder) PaddingRightBackground(c Color) *StyleBuilder {
+	b.style = b.style.PaddingRightBackground(c)
+	return b
+}
+
+// PaddingTop sets the style being built like Style.PaddingTop.
+func (b *StyleBuilder) PaddingTop(v int) *StyleBuilder {
+	b.style = b.style.PaddingTop(v)
+	return b
+}
+
+// PaddingTopBackground sets the style being built like Style.PaddingTopBackground.
+func (b *StyleBuilder) PaddingTopBackground(c Color) *StyleBuilder {
+	b.style = b.style.PaddingTopBackground(c)
+	return b
+}
+
+// Reverse sets the style being built like Style.Reverse.
+func (b *StyleBuilder) Reverse(v bool) *StyleBuilder {
+	b.style = b.style.Reverse(v)
+	return b
+}
+
+// Shadow sets the style being built like Style.Shadow.
+func (b *StyleBuilder) Shadow(v bool) *StyleBuilder {
+	b.style = b.style.Shadow(v)
+	return b
+}
+
+// ShadowColor sets the style being built like Style.ShadowColor.
+func (b *StyleBuilder) ShadowColor(c Color) *StyleBuilder {
+	b.style = b.style.ShadowColor(c)
+	return b
+}
+
+// Strikethrough sets the style being built like Style.Strikethrough.
+func (b *StyleBuilder) Strikethrough(v bool) *StyleBuilder {
+	b.style = b.style.Strikethrough(v)
+	return b
+}
+
+// TruncateExact sets the style being built like Style.TruncateExact.
+func (b *StyleBuilder) TruncateExact(v bool) *StyleBuilder {
+	b.style = b.style.TruncateExact(v)
+	return b
+}
+
+// TruncateMode sets the style being built like Style.TruncateMode.
+func (b *StyleBuilder) TruncateMode(m TruncateMode) *StyleBuilder {
+	b.style = b.style.TruncateMode(m)
+	return b
+}
+
+// Underline sets the style being built like Style.Underline.
+func (b *StyleBuilder) Underline(v bool) *StyleBuilder {
+	b.style = b.style.Underline(v)
+	return b
+}
+
+// Width sets the style being built like Style.Width.
+func (b *StyleBuilder) Width(w int) *StyleBuilder {
+	b.style = b.style.Width(w)
+	return b
+}
+
+// WidthPercent sets the style being built like Style.WidthPercent.
+func (b *StyleBuilder) WidthPercent(p int) *StyleBuilder {
+	b.style = b.style.WidthPercent(p)
+	return b
+}
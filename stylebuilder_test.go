@@ -0,0 +1,66 @@
+package tuistyles
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStyle_DeepCopy(t *testing.T) {
+	s := NewStyle().Bold(true).Foreground(Color("red")).Padding(1, 2).Border(RoundedBorder()).Width(10)
+	c := s.DeepCopy()
+
+	require.True(t, c.Equal(s))
+	require.Equal(t, s.Render("x"), c.Render("x"))
+
+	// No property shares memory with the original
+	require.NotSame(t, s.bold, c.bold)
+	require.NotSame(t, s.foreground, c.foreground)
+	require.NotSame(t, s.borderType, c.borderType)
+	*c.width = 99
+	require.Equal(t, 10, *s.width)
+
+	require.Equal(t, NewStyle(), NewStyle().DeepCopy(), "unset properties stay unset")
+}
+
+func TestStyleBuilder(t *testing.T) {
+	b := NewStyleBuilder().Bold(true).Padding(0, 1)
+	b.Border(NormalBorder(), true, false, true, false)
+	first := b.Build()
+
+	want := NewStyle().Bold(true).Padding(0, 1).Border(NormalBorder(), true, false, true, false)
+	require.True(t, first.Equal(want))
+
+	// Building more leaves earlier results unchanged
+	b.Bold(false).Foreground(Color("red"))
+	require.True(t, first.Equal(want))
+	require.True(t, b.Build().Equal(want.Bold(false).Foreground(Color("red"))))
+}
+
+func TestStyle_Builder(t *testing.T) {
+	base := NewStyle().Italic(true).Width(8)
+	b := base.Builder()
+	b.Width(4)
+
+	require.Equal(t, 8, *base.width, "the builder starts from a copy")
+	require.True(t, b.Build().Equal(NewStyle().Italic(true).Width(4)))
+}
+
+func TestStyleBuilder_CoversEveryStyleMethod(t *testing.T) {
+	// Regenerate with go generate when this fails
+	styleType := reflect.TypeOf(Style{})
+	builderType := reflect.TypeOf(&StyleBuilder{})
+	for i := 0; i < styleType.NumMethod(); i++ {
+		m := styleType.Method(i)
+		if m.Type.NumOut() != 1 || m.Type.Out(0) != styleType || m.Name == "DeepCopy" || m.Name == "Resolve" {
+			continue
+		}
+		bm, ok := builderType.MethodByName(m.Name)
+		require.True(t, ok, "StyleBuilder is missing %s", m.Name)
+		require.Equal(t, m.Type.NumIn(), bm.Type.NumIn(), "%s takes different arguments", m.Name)
+		for in := 1; in < m.Type.NumIn(); in++ {
+			require.Equal(t, m.Type.In(in), bm.Type.In(in), "%s argument %d", m.Name, in)
+		}
+	}
+}
@@ -0,0 +1,189 @@
+package tuistyles
+
+import (
+	"strings"
+
+	"github.com/orchard9/tui-styles/internal/ansi"
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+// Span is a run of text drawn with a single Style.
+//
+// Only inline properties of the style (text attributes and colors) apply to
+// spans; box properties such as padding, borders, and width are ignored.
+type Span struct {
+	Text  string
+	Style Style
+}
+
+// StyledString is a sequence of styled spans that keeps text and styling
+// separate until Render is called.
+//
+// Because the text is never mixed with escape sequences, operations such as
+// Width, Slice, and Truncate work on plain text and stay exact even after
+// many pieces have been composed together. Like Style, StyledString is
+// immutable: every method returns a new value.
+//
+// Example:
+//
+//	status := Styled("CPU ", NewStyle().Bold(true)).
+//	    Append("93%", NewStyle().Foreground(Color("red")))
+//	fmt.Println(status.Truncate(6, "…").Render()) // "CPU 9…"
+type StyledString struct {
+	spans []Span
+}
+
+// NewStyledString creates a StyledString from spans. Empty spans are dropped.
+func NewStyledString(spans ...Span) StyledString {
+	return StyledString{}.appendSpans(spans...)
+}
+
+// Styled creates a StyledString holding a single span of text in style.
+func Styled(text string, style Style) StyledString {
+	return NewStyledString(Span{Text: text, Style: style})
+}
+
+// Append returns a new StyledString with text in style added at the end.
+func (s StyledString) Append(text string, style Style) StyledString {
+	return s.appendSpans(Span{Text: text, Style: style})
+}
+
+// Concat returns a new StyledString with the spans of others added at the end.
+func (s StyledString) Concat(others ...StyledString) StyledString {
+	result := s
+	for _, other := range others {
+		result = result.appendSpans(other.spans...)
+	}
+	return result
+}
+
+// appendSpans copies the receiver's spans and appends the non-empty ones given
+func (s StyledString) appendSpans(spans ...Span) StyledString {
+	combined := make([]Span, 0, len(s.spans)+len(spans))
+	combined = append(combined, s.spans...)
+	for _, span := range spans {
+		if span.Text != "" {
+			combined = append(combined, span)
+		}
+	}
+	return StyledString{spans: combined}
+}
+
+// Spans returns a copy of the spans making up the string.
+func (s StyledString) Spans() []Span {
+	spans := make([]Span, len(s.spans))
+	copy(spans, s.spans)
+	return spans
+}
+
+// Width returns the width of the widest line in terminal cells.
+func (s StyledString) Width() int {
+	return measure.MaxWidth(s.String())
+}
+
+// IsEmpty reports whether the string contains no text.
+func (s StyledString) IsEmpty() bool {
+	return len(s.spans) == 0
+}
+
+// String returns the plain text without any styling.
+func (s StyledString) String() string {
+	var b strings.Builder
+	for _, span := range s.spans {
+		b.WriteString(span.Text)
+	}
+	return b.String()
+}
+
+// Slice returns the cells [from, to) of a single-line StyledString, keeping
+// each piece's style. A wide character cut by either boundary is replaced
+// by spaces so the result stays exactly to-from cells wide.
+//
+// Example:
+//
+//	Styled("hello world", bold).Slice(6, 11).String() // "world"
+func (s StyledString) Slice(from, to int) StyledString {
+	if from < 0 {
+		from = 0
+	}
+
+	var result []Span
+	col := 0
+	for _, span := range s.spans {
+		w := measure.Width(span.Text)
+		start, end := col, col+w
+		col = end
+
+		if end <= from || w == 0 {
+			continue
+		}
+		if start >= to {
+			break
+		}
+
+		text := measure.SliceCells(span.Text, from-start, to-start)
+		if text != "" {
+			result = append(result, Span{Text: text, Style: span.Style})
+		}
+	}
+
+	return StyledString{spans: result}
+}
+
+// Truncate shortens a single-line StyledString to at most width cells,
+// appending tail (drawn in the style of the last visible span) when text
+// was removed. Wide characters are never split.
+//
+// Example:
+//
+//	Styled("a long label", s).Truncate(6, "…").String() // "a lon…"
+func (s StyledString) Truncate(width int, tail string) StyledString {
+	if width <= 0 {
+		return StyledString{}
+	}
+	if s.Width() <= width {
+		return s
+	}
+
+	tailWidth := measure.Width(tail)
+	if tailWidth >= width {
+		return s.Slice(0, width)
+	}
+
+	// Cut on a character boundary so the tail sits flush against the text
+	kept := s.Slice(0, measure.PrefixWidth(s.String(), width-tailWidth))
+
+	tailStyle := NewStyle()
+	if n := len(kept.spans); n > 0 {
+		tailStyle = kept.spans[n-1].Style
+	} else if len(s.spans) > 0 {
+		tailStyle = s.spans[0].Style
+	}
+	return kept.Append(tail, tailStyle)
+}
+
+// Render returns the string with each span's inline styling applied.
+func (s StyledString) Render() string {
+	var b strings.Builder
+	for _, span := range s.spans {
+		b.WriteString(span.Style.renderInline(span.Text))
+	}
+	return b.String()
+}
+
+// renderInline applies only text attributes and colors to str, styling each
+// line separately so newlines never carry escape sequences across
+func (s Style) renderInline(str string) string {
+	if !s.hasAnyStyle() {
+		return str
+	}
+
+	prefix := s.stylePrefix()
+	lines := strings.Split(str, "\n")
+	for i, line := range lines {
+		if line != "" {
+			lines[i] = prefix + s.scopeResets(line) + ansi.Reset()
+		}
+	}
+	return strings.Join(lines, "\n")
+}
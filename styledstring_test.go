@@ -0,0 +1,147 @@
+package tuistyles
+
+import (
+	"testing"
+
+	"github.com/orchard9/tui-styles/internal/ansi"
+)
+
+func TestStyledString_AppendAndConcat(t *testing.T) {
+	bold := NewStyle().Bold(true)
+	red := NewStyle().Foreground(Color("red"))
+
+	base := Styled("CPU ", bold)
+	full := base.Append("93%", red).Append("", bold)
+
+	if got := full.String(); got != "CPU 93%" {
+		t.Errorf("String() = %q, want %q", got, "CPU 93%")
+	}
+	if len(full.Spans()) != 2 {
+		t.Errorf("empty spans should be dropped, got %d spans", len(full.Spans()))
+	}
+	if base.String() != "CPU " {
+		t.Errorf("Append mutated the receiver: %q", base.String())
+	}
+
+	joined := base.Concat(Styled("a", red), Styled("b", bold))
+	if got := joined.String(); got != "CPU ab" {
+		t.Errorf("Concat() = %q, want %q", got, "CPU ab")
+	}
+}
+
+func TestStyledString_Width(t *testing.T) {
+	tests := []struct {
+		name string
+		s    StyledString
+		want int
+	}{
+		{"empty", StyledString{}, 0},
+		{"ASCII", Styled("hello", NewStyle().Bold(true)), 5},
+		{"CJK", Styled("你好", NewStyle()), 4},
+		{"multi span", Styled("ab", NewStyle()).Append("cd", NewStyle().Italic(true)), 4},
+		{"multi line uses widest", Styled("ab\nabcd", NewStyle()), 4},
+	}
+
+	for _, tt := range tests {
+		if got := tt.s.Width(); got != tt.want {
+			t.Errorf("%s: Width() = %d, want %d", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestStyledString_Slice(t *testing.T) {
+	bold := NewStyle().Bold(true)
+	red := NewStyle().Foreground(Color("red"))
+	s := Styled("hello ", bold).Append("world", red)
+
+	tests := []struct {
+		name     string
+		from, to int
+		want     string
+		spans    int
+	}{
+		{"first span only", 0, 5, "hello", 1},
+		{"across spans", 3, 8, "lo wo", 2},
+		{"second span only", 6, 11, "world", 1},
+		{"past end", 8, 20, "rld", 1},
+		{"empty", 4, 4, "", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := s.Slice(tt.from, tt.to)
+			if got.String() != tt.want || len(got.Spans()) != tt.spans {
+				t.Errorf("Slice(%d, %d) = %q (%d spans), want %q (%d spans)",
+					tt.from, tt.to, got.String(), len(got.Spans()), tt.want, tt.spans)
+			}
+		})
+	}
+
+	// Styles follow the text they belong to
+	got := s.Slice(3, 8).Render()
+	want := ansi.Bold() + "lo " + ansi.Reset() + Color("red").ToANSI() + "wo" + ansi.Reset()
+	if got != want {
+		t.Errorf("Slice().Render() = %q, want %q", got, want)
+	}
+}
+
+func TestStyledString_SliceWideRunes(t *testing.T) {
+	s := Styled("你好", NewStyle()).Append("世界", NewStyle().Bold(true))
+	got := s.Slice(1, 7)
+	if got.String() != " 好世 " {
+		t.Errorf("Slice(1, 7) = %q, want %q", got.String(), " 好世 ")
+	}
+	if got.Width() != 6 {
+		t.Errorf("Slice(1, 7).Width() = %d, want 6", got.Width())
+	}
+}
+
+func TestStyledString_Truncate(t *testing.T) {
+	red := NewStyle().Foreground(Color("red"))
+
+	tests := []struct {
+		name  string
+		s     StyledString
+		width int
+		tail  string
+		want  string
+	}{
+		{"fits", Styled("short", red), 10, "…", "short"},
+		{"ASCII", Styled("a long label", red), 6, "…", "a lon…"},
+		{"across spans", Styled("CPU ", NewStyle()).Append("93%", red), 6, "…", "CPU 9…"},
+		{"wide rune not split", Styled("你好世界", red), 6, "…", "你好…"},
+		{"tail too long", Styled("abcdef", red), 2, "...", "ab"},
+		{"zero width", Styled("abc", red), 0, "…", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.s.Truncate(tt.width, tt.tail)
+			if got.String() != tt.want {
+				t.Errorf("Truncate(%d) = %q, want %q", tt.width, got.String(), tt.want)
+			}
+			if got.Width() > tt.width {
+				t.Errorf("Truncate(%d) width = %d", tt.width, got.Width())
+			}
+		})
+	}
+}
+
+func TestStyledString_Render(t *testing.T) {
+	plain := Styled("plain", NewStyle())
+	if got := plain.Render(); got != "plain" {
+		t.Errorf("unstyled Render() = %q", got)
+	}
+
+	// Box properties are ignored for inline spans
+	boxed := Styled("x", NewStyle().Bold(true).Padding(2).Border(NormalBorder()))
+	if got, want := boxed.Render(), ansi.Bold()+"x"+ansi.Reset(); got != want {
+		t.Errorf("Render() = %q, want %q", got, want)
+	}
+
+	multi := Styled("a\nb", NewStyle().Bold(true))
+	want := ansi.Bold() + "a" + ansi.Reset() + "\n" + ansi.Bold() + "b" + ansi.Reset()
+	if got := multi.Render(); got != want {
+		t.Errorf("multi-line Render() = %q, want %q", got, want)
+	}
+}
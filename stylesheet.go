@@ -0,0 +1,134 @@
+package tuistyles
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// StyleSpec defines one style of a StyleSheet.
+type StyleSpec struct {
+	// Extends names another spec of the same sheet to start from; its
+	// style, including whatever it extends, is the base for this one.
+	// "" starts from an empty style.
+	Extends string
+
+	// Style holds the properties that differ from the base. Properties it
+	// sets override the base; everything else is inherited.
+	Style Style
+}
+
+// StyleSheet is an immutable set of named styles, built once by
+// DefineStyles and shared freely between goroutines. Looking a style up
+// copies it without building anything, so a sheet can replace long
+// NewStyle() chains evaluated on every frame.
+type StyleSheet struct {
+	styles map[string]Style
+}
+
+// ErrStyleSpec is wrapped by the errors DefineStyles returns.
+var ErrStyleSpec = errors.New("invalid style spec")
+
+// DefineStyles builds a StyleSheet from specs, resolving each spec's
+// Extends chain so related styles share their common properties. It
+// fails when a spec extends a name that is not defined or when specs
+// extend each other in a cycle.
+//
+// Example:
+//
+//	sheet, err := DefineStyles(map[string]StyleSpec{
+//		"base":    {Style: NewStyle().Padding(0, 1).Foreground(Color("252"))},
+//		"title":   {Extends: "base", Style: NewStyle().Bold(true)},
+//		"error":   {Extends: "base", Style: NewStyle().Foreground(Color("red"))},
+//		"heading": {Extends: "title", Style: NewStyle().Underline(true)},
+//	})
+//	fmt.Println(sheet.Get("heading").Render("Status"))
+func DefineStyles(specs map[string]StyleSpec) (StyleSheet, error) {
+	sheet := StyleSheet{styles: make(map[string]Style, len(specs))}
+
+	var resolve func(name string, chain []string) (Style, error)
+	resolve = func(name string, chain []string) (Style, error) {
+		if s, ok := sheet.styles[name]; ok {
+			return s, nil
+		}
+		if slices.Contains(chain, name) {
+			return Style{}, fmt.Errorf("%w: cycle %s", ErrStyleSpec, strings.Join(append(chain, name), " -> "))
+		}
+		spec, ok := specs[name]
+		if !ok {
+			return Style{}, fmt.Errorf("%w: %q extends undefined %q", ErrStyleSpec, chain[len(chain)-1], name)
+		}
+
+		s := spec.Style
+		if spec.Extends != "" {
+			base, err := resolve(spec.Extends, append(chain, name))
+			if err != nil {
+				return Style{}, err
+			}
+			s = s.over(base)
+		}
+		sheet.styles[name] = s
+		return s, nil
+	}
+
+	names := make([]string, 0, len(specs))
+	for name := range specs {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		if _, err := resolve(name, nil); err != nil {
+			return StyleSheet{}, err
+		}
+	}
+	return sheet, nil
+}
+
+// MustDefineStyles is like DefineStyles but panics on error, for sheets
+// declared as package variables.
+func MustDefineStyles(specs map[string]StyleSpec) StyleSheet {
+	sheet, err := DefineStyles(specs)
+	if err != nil {
+		panic(err)
+	}
+	return sheet
+}
+
+// Get returns the named style, or an empty style when the sheet has none
+// by that name.
+func (sh StyleSheet) Get(name string) Style {
+	return sh.styles[name]
+}
+
+// Lookup returns the named style and whether the sheet defines it.
+func (sh StyleSheet) Lookup(name string) (Style, bool) {
+	s, ok := sh.styles[name]
+	return s, ok
+}
+
+// Names returns the names of the sheet's styles in sorted order.
+func (sh StyleSheet) Names() []string {
+	names := make([]string, 0, len(sh.styles))
+	for name := range sh.styles {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// over returns base with every property set on s overriding base's
+func (s Style) over(base Style) Style {
+	merged := base.toJSON()
+	top := s.toJSON()
+
+	dst := reflect.ValueOf(&merged).Elem()
+	src := reflect.ValueOf(top)
+	for i := 0; i < src.NumField(); i++ {
+		if f := src.Field(i); f.Kind() == reflect.Pointer && !f.IsNil() {
+			dst.Field(i).Set(f)
+		}
+	}
+	return fromJSON(merged)
+}
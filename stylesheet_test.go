@@ -0,0 +1,83 @@
+package tuistyles
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefineStyles(t *testing.T) {
+	sheet, err := DefineStyles(map[string]StyleSpec{
+		"base":    {Style: NewStyle().Padding(0, 1).Foreground(Color("252"))},
+		"title":   {Extends: "base", Style: NewStyle().Bold(true)},
+		"error":   {Extends: "base", Style: NewStyle().Foreground(Color("red"))},
+		"heading": {Extends: "title", Style: NewStyle().Underline(true)},
+		"plain":   {Style: NewStyle().Italic(true)},
+	})
+	require.NoError(t, err)
+
+	base := NewStyle().Padding(0, 1).Foreground(Color("252"))
+	require.True(t, sheet.Get("base").Equal(base))
+	require.True(t, sheet.Get("title").Equal(base.Bold(true)))
+	require.True(t, sheet.Get("error").Equal(base.Foreground(Color("red"))))
+	require.True(t, sheet.Get("heading").Equal(base.Bold(true).Underline(true)))
+	require.True(t, sheet.Get("plain").Equal(NewStyle().Italic(true)))
+
+	require.Equal(t, []string{"base", "error", "heading", "plain", "title"}, sheet.Names())
+}
+
+func TestDefineStyles_Lookup(t *testing.T) {
+	sheet := MustDefineStyles(map[string]StyleSpec{"a": {Style: NewStyle().Bold(true)}})
+
+	s, ok := sheet.Lookup("a")
+	require.True(t, ok)
+	require.True(t, s.Equal(NewStyle().Bold(true)))
+
+	s, ok = sheet.Lookup("missing")
+	require.False(t, ok)
+	require.True(t, s.Equal(NewStyle()))
+	require.Equal(t, "x", sheet.Get("missing").Render("x"))
+}
+
+func TestDefineStyles_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		specs   map[string]StyleSpec
+		message string
+	}{
+		{
+			name:    "undefined base",
+			specs:   map[string]StyleSpec{"a": {Extends: "nope"}},
+			message: `invalid style spec: "a" extends undefined "nope"`,
+		},
+		{
+			name:    "cycle",
+			specs:   map[string]StyleSpec{"a": {Extends: "b"}, "b": {Extends: "a"}},
+			message: "invalid style spec: cycle a -> b -> a",
+		},
+		{
+			name:    "self",
+			specs:   map[string]StyleSpec{"a": {Extends: "a"}},
+			message: "invalid style spec: cycle a -> a",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := DefineStyles(tt.specs)
+			require.ErrorIs(t, err, ErrStyleSpec)
+			require.EqualError(t, err, tt.message)
+		})
+	}
+
+	require.Panics(t, func() { MustDefineStyles(map[string]StyleSpec{"a": {Extends: "b"}}) })
+}
+
+func TestStyle_Over(t *testing.T) {
+	base := NewStyle().Bold(true).Border(RoundedBorder()).Width(10)
+	top := NewStyle().Bold(false).Foreground(Color("red"))
+
+	got := top.over(base)
+	require.True(t, got.Equal(NewStyle().Bold(false).Border(RoundedBorder()).Width(10).Foreground(Color("red"))))
+	require.True(t, base.Equal(NewStyle().Bold(true).Border(RoundedBorder()).Width(10)), "base unchanged")
+}
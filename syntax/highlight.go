@@ -0,0 +1,121 @@
+package syntax
+
+import (
+	"strings"
+
+	tuistyles "github.com/orchard9/tui-styles"
+	"github.com/orchard9/tui-styles/internal/ansi"
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+// Theme maps token types to styles. Types without an entry are drawn
+// unstyled. Styles should only set text attributes and colors.
+type Theme map[TokenType]tuistyles.Style
+
+// DefaultTheme returns the highlighting theme built from tuistyles.DefaultTheme.
+func DefaultTheme() Theme {
+	return ThemeFrom(tuistyles.DefaultTheme())
+}
+
+// ThemeFrom builds a highlighting theme from a palette of semantic colors.
+// Empty palette colors fall back to tuistyles.DefaultTheme.
+func ThemeFrom(palette tuistyles.Theme) Theme {
+	p := palette.WithDefaults()
+	return Theme{
+		Keyword:  tuistyles.NewStyle().Bold(true).Foreground(p.Primary),
+		Type:     tuistyles.NewStyle().Foreground(p.Info),
+		Function: tuistyles.NewStyle().Foreground(p.Info),
+		String:   tuistyles.NewStyle().Foreground(p.Success),
+		Number:   tuistyles.NewStyle().Foreground(p.Warning),
+		Comment:  tuistyles.NewStyle().Italic(true).Foreground(p.Muted),
+	}
+}
+
+// Highlight renders tokens with theme. Each line is styled independently,
+// so the result can be split on newlines, wrapped, or framed safely.
+func Highlight(tokens []Token, theme Theme) string {
+	var b strings.Builder
+	for _, tok := range tokens {
+		style, ok := theme[tok.Type]
+		if !ok {
+			b.WriteString(tok.Value)
+			continue
+		}
+		b.WriteString(style.Render(tok.Value))
+	}
+	return b.String()
+}
+
+// HighlightCode tokenizes code with the built-in lexer for lang and renders
+// it with theme. Code in unknown languages is returned unchanged.
+//
+// Example:
+//
+//	fmt.Println(syntax.HighlightCode(`fmt.Println("hi")`, "go", syntax.DefaultTheme()))
+func HighlightCode(code, lang string, theme Theme) string {
+	lexer, ok := LexerFor(lang)
+	if !ok {
+		return code
+	}
+	return Highlight(lexer.Tokenize(code), theme)
+}
+
+// Block is a highlighted code listing drawn inside a box.
+//
+// Tabs are expanded to four spaces. Lines are never wrapped: with a width
+// set, every line is padded or truncated (with "…") so the box is exactly
+// that wide.
+//
+// Example:
+//
+//	fmt.Println(syntax.Block{Code: src, Language: "go"}.Render(60))
+type Block struct {
+	Code     string
+	Language string           // Built-in lexer name or alias; unknown languages are not highlighted
+	Theme    Theme            // Token styles; nil for DefaultTheme
+	Frame    *tuistyles.Style // Box around the code; nil for a rounded border with one cell of horizontal padding
+}
+
+// Render draws the block at width cells including the frame. A width of 0
+// or less sizes the box to the longest line.
+func (b Block) Render(width int) string {
+	theme := b.Theme
+	if theme == nil {
+		theme = DefaultTheme()
+	}
+	frame := tuistyles.NewStyle().Border(tuistyles.RoundedBorder()).Padding(0, 1)
+	if b.Frame != nil {
+		frame = *b.Frame
+	}
+
+	code := strings.ReplaceAll(strings.TrimSuffix(b.Code, "\n"), "\t", "    ")
+	lines := strings.Split(HighlightCode(code, b.Language, theme), "\n")
+
+	inner := measure.MaxWidth(strings.Join(lines, "\n"))
+	if width > 0 {
+		inner = max(width-frameWidth(frame), 1)
+	}
+
+	for i, line := range lines {
+		lines[i] = fitLine(line, inner)
+	}
+	return frame.Render(strings.Join(lines, "\n"))
+}
+
+// fitLine pads or truncates a styled line to exactly width cells, keeping
+// its escape sequences intact (truncated lines end in "…")
+func fitLine(line string, width int) string {
+	w := measure.Width(line)
+	if w > width {
+		head, _ := measure.SplitAt(line, width-1)
+		line = head + ansi.Reset() + "…"
+		w = measure.Width(line)
+	}
+	return line + strings.Repeat(" ", max(width-w, 0))
+}
+
+// frameWidth returns the horizontal cells a style adds around its content
+// through padding and borders
+func frameWidth(s tuistyles.Style) int {
+	return measure.MaxWidth(s.Render(" ")) - 1
+}
@@ -0,0 +1,64 @@
+package syntax
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tuistyles "github.com/orchard9/tui-styles"
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+func TestHighlight(t *testing.T) {
+	theme := Theme{Keyword: tuistyles.NewStyle().Bold(true)}
+	tokens := []Token{{Keyword, "func"}, {Text, " "}, {Name, "f"}}
+
+	got := Highlight(tokens, theme)
+	require.Equal(t, theme[Keyword].Render("func")+" f", got)
+}
+
+func TestHighlight_MultiLineTokensStyledPerLine(t *testing.T) {
+	theme := Theme{Comment: tuistyles.NewStyle().Italic(true)}
+	got := Highlight([]Token{{Comment, "/* a\nb */"}}, theme)
+
+	for _, line := range strings.Split(got, "\n") {
+		require.True(t, strings.HasPrefix(line, "\x1b[3m"), "line %q should carry its own style", line)
+		require.True(t, strings.HasSuffix(line, "\x1b[0m"), "line %q should be reset", line)
+	}
+}
+
+func TestHighlightCode_UnknownLanguage(t *testing.T) {
+	require.Equal(t, "plain text", HighlightCode("plain text", "nope", DefaultTheme()))
+}
+
+func TestBlock_Render(t *testing.T) {
+	code := "func main() {\n\tfmt.Println(\"a line that is too long for the box\")\n}\n"
+
+	t.Run("exact width", func(t *testing.T) {
+		got := Block{Code: code, Language: "go"}.Render(30)
+		lines := strings.Split(got, "\n")
+
+		require.Len(t, lines, 5, "3 code lines plus top and bottom border")
+		for _, line := range lines {
+			require.Equal(t, 30, measure.Width(line), "line %q", line)
+		}
+		require.Contains(t, measure.StripANSI(lines[2]), "…")
+	})
+
+	t.Run("natural width", func(t *testing.T) {
+		got := Block{Code: "ab\nabcd", Language: "go"}.Render(0)
+		require.Equal(t, []int{8, 8, 8, 8}, measure.WidthPerLine(got))
+	})
+
+	t.Run("highlighted", func(t *testing.T) {
+		got := Block{Code: "return", Language: "go"}.Render(0)
+		require.Contains(t, got, DefaultTheme()[Keyword].Render("return"))
+	})
+
+	t.Run("custom frame", func(t *testing.T) {
+		frame := tuistyles.NewStyle().PaddingLeft(2)
+		got := Block{Code: "x", Frame: &frame}.Render(5)
+		require.Equal(t, "  x  ", got)
+	})
+}
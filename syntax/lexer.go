@@ -0,0 +1,315 @@
+package syntax
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Lexer is a simple rule-based tokenizer for C-like and scripting languages.
+//
+// It recognizes comments, strings, numbers, identifiers, operators, and
+// punctuation; identifiers are classified with the keyword and type lists.
+// That is enough for readable highlighting, not for parsing.
+type Lexer struct {
+	Name         string    // Canonical language name ("go")
+	Aliases      []string  // Other names and file extensions ("golang")
+	Keywords     []string  // Reserved words and constants
+	Types        []string  // Built-in type names
+	LineComments []string  // Prefixes that start a comment running to end of line
+	BlockComment [2]string // Block comment open and close ("/*", "*/"); empty for none
+	Strings      []string  // String delimiters, longest first; triple quotes and ` may span lines
+}
+
+// Tokenize splits code into tokens. Concatenating the token values always
+// reproduces code exactly.
+func (l Lexer) Tokenize(code string) []Token {
+	keywords := toSet(l.Keywords)
+	types := toSet(l.Types)
+
+	var tokens []Token
+	emit := func(t TokenType, value string) {
+		// Merge with the previous token of the same type to keep streams short
+		if n := len(tokens); n > 0 && tokens[n-1].Type == t && t != Function {
+			tokens[n-1].Value += value
+			return
+		}
+		tokens = append(tokens, Token{Type: t, Value: value})
+	}
+
+	for i := 0; i < len(code); {
+		rest := code[i:]
+		r, size := utf8.DecodeRuneInString(rest)
+
+		if n := l.commentLen(rest); n > 0 {
+			emit(Comment, rest[:n])
+			i += n
+			continue
+		}
+		if n := l.stringLen(rest); n > 0 {
+			emit(String, rest[:n])
+			i += n
+			continue
+		}
+
+		switch {
+		case unicode.IsSpace(r):
+			n := spanLen(rest, unicode.IsSpace)
+			emit(Text, rest[:n])
+			i += n
+
+		case unicode.IsDigit(r) || r == '.' && len(rest) > 1 && isDigit(rest[1]):
+			n := spanLen(rest, func(r rune) bool {
+				return isIdentRune(r) || r == '.'
+			})
+			emit(Number, rest[:n])
+			i += n
+
+		case unicode.IsLetter(r) || r == '_' || r == '$' || r == '@':
+			n := size + spanLen(rest[size:], isIdentRune)
+			word := rest[:n]
+			switch {
+			case keywords[word]:
+				emit(Keyword, word)
+			case types[word]:
+				emit(Type, word)
+			case strings.HasPrefix(strings.TrimLeft(rest[n:], " "), "("):
+				emit(Function, word)
+			default:
+				emit(Name, word)
+			}
+			i += n
+
+		case strings.ContainsRune("+-*/%=<>!&|^~?:", r):
+			n := spanLen(rest, func(r rune) bool {
+				return strings.ContainsRune("+-*/%=<>!&|^~?:", r)
+			})
+			emit(Operator, rest[:n])
+			i += n
+
+		case strings.ContainsRune("(){}[],;.", r):
+			emit(Punctuation, rest[:size])
+			i += size
+
+		default:
+			emit(Text, rest[:size])
+			i += size
+		}
+	}
+
+	return tokens
+}
+
+// commentLen returns the length of a comment at the start of s, or 0
+func (l Lexer) commentLen(s string) int {
+	for _, prefix := range l.LineComments {
+		if strings.HasPrefix(s, prefix) {
+			if end := strings.IndexByte(s, '\n'); end >= 0 {
+				return end
+			}
+			return len(s)
+		}
+	}
+
+	open, closing := l.BlockComment[0], l.BlockComment[1]
+	if open != "" && strings.HasPrefix(s, open) {
+		if end := strings.Index(s[len(open):], closing); end >= 0 {
+			return len(open) + end + len(closing)
+		}
+		return len(s)
+	}
+	return 0
+}
+
+// stringLen returns the length of a string literal at the start of s, or 0.
+// Unterminated strings run to the end of the line (or input, for
+// delimiters that may span lines).
+func (l Lexer) stringLen(s string) int {
+	for _, delim := range l.Strings {
+		if !strings.HasPrefix(s, delim) {
+			continue
+		}
+
+		multiLine := len(delim) == 3 || delim == "`"
+		for i := len(delim); i < len(s); i++ {
+			switch {
+			case s[i] == '\\' && delim != "`":
+				i++ // skip the escaped character
+			case s[i] == '\n' && !multiLine:
+				return i
+			case strings.HasPrefix(s[i:], delim):
+				return i + len(delim)
+			}
+		}
+		return len(s)
+	}
+	return 0
+}
+
+// spanLen returns the byte length of the leading runes of s matching fn
+func spanLen(s string, fn func(rune) bool) int {
+	for i, r := range s {
+		if !fn(r) {
+			return i
+		}
+	}
+	return len(s)
+}
+
+// isIdentRune reports whether r can continue an identifier
+func isIdentRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_'
+}
+
+// isDigit reports whether b is an ASCII digit
+func isDigit(b byte) bool {
+	return b >= '0' && b <= '9'
+}
+
+// toSet builds a lookup set from words
+func toSet(words []string) map[string]bool {
+	set := make(map[string]bool, len(words))
+	for _, w := range words {
+		set[w] = true
+	}
+	return set
+}
+
+// builtinLexers is the built-in lexer set, looked up by LexerFor
+var builtinLexers = []Lexer{
+	{
+		Name:    "go",
+		Aliases: []string{"golang"},
+		Keywords: []string{
+			"break", "case", "chan", "const", "continue", "default", "defer", "else",
+			"fallthrough", "for", "func", "go", "goto", "if", "import", "interface",
+			"map", "package", "range", "return", "select", "struct", "switch", "type",
+			"var", "true", "false", "nil", "iota",
+		},
+		Types: []string{
+			"any", "bool", "byte", "complex64", "complex128", "error", "float32",
+			"float64", "int", "int8", "int16", "int32", "int64", "rune", "string",
+			"uint", "uint8", "uint16", "uint32", "uint64", "uintptr",
+		},
+		LineComments: []string{"//"},
+		BlockComment: [2]string{"/*", "*/"},
+		Strings:      []string{`"`, "'", "`"},
+	},
+	{
+		Name:    "python",
+		Aliases: []string{"py", "python3"},
+		Keywords: []string{
+			"and", "as", "assert", "async", "await", "break", "class", "continue",
+			"def", "del", "elif", "else", "except", "finally", "for", "from", "global",
+			"if", "import", "in", "is", "lambda", "nonlocal", "not", "or", "pass",
+			"raise", "return", "try", "while", "with", "yield", "True", "False", "None",
+		},
+		Types:        []string{"bool", "bytes", "dict", "float", "int", "list", "object", "set", "str", "tuple"},
+		LineComments: []string{"#"},
+		Strings:      []string{`"""`, "'''", `"`, "'"},
+	},
+	{
+		Name:    "javascript",
+		Aliases: []string{"js", "jsx", "typescript", "ts", "tsx"},
+		Keywords: []string{
+			"async", "await", "break", "case", "catch", "class", "const", "continue",
+			"default", "delete", "do", "else", "export", "extends", "finally", "for",
+			"from", "function", "if", "import", "in", "instanceof", "interface", "let",
+			"new", "of", "return", "switch", "this", "throw", "try", "type", "typeof",
+			"var", "void", "while", "yield", "true", "false", "null", "undefined",
+		},
+		Types:        []string{"any", "boolean", "never", "number", "object", "string", "unknown"},
+		LineComments: []string{"//"},
+		BlockComment: [2]string{"/*", "*/"},
+		Strings:      []string{`"`, "'", "`"},
+	},
+	{
+		Name:    "rust",
+		Aliases: []string{"rs"},
+		Keywords: []string{
+			"as", "async", "await", "break", "const", "continue", "crate", "dyn", "else",
+			"enum", "extern", "fn", "for", "if", "impl", "in", "let", "loop", "match",
+			"mod", "move", "mut", "pub", "ref", "return", "self", "Self", "static",
+			"struct", "super", "trait", "type", "unsafe", "use", "where", "while",
+			"true", "false", "None", "Some", "Ok", "Err",
+		},
+		Types: []string{
+			"bool", "char", "f32", "f64", "i8", "i16", "i32", "i64", "i128", "isize",
+			"str", "u8", "u16", "u32", "u64", "u128", "usize", "String", "Vec", "Option", "Result",
+		},
+		LineComments: []string{"//"},
+		BlockComment: [2]string{"/*", "*/"},
+		Strings:      []string{`"`},
+	},
+	{
+		Name:    "c",
+		Aliases: []string{"h", "cpp", "c++", "cc", "hpp", "java", "cs", "csharp"},
+		Keywords: []string{
+			"break", "case", "class", "const", "continue", "default", "do", "else",
+			"enum", "extern", "for", "goto", "if", "namespace", "new", "private",
+			"protected", "public", "return", "sizeof", "static", "struct", "switch",
+			"template", "this", "typedef", "union", "using", "virtual", "void",
+			"volatile", "while", "true", "false", "NULL", "nullptr", "null",
+		},
+		Types:        []string{"bool", "char", "double", "float", "int", "long", "short", "signed", "unsigned", "size_t", "String"},
+		LineComments: []string{"//"},
+		BlockComment: [2]string{"/*", "*/"},
+		Strings:      []string{`"`, "'"},
+	},
+	{
+		Name:     "json",
+		Keywords: []string{"true", "false", "null"},
+		Strings:  []string{`"`},
+	},
+	{
+		Name:    "shell",
+		Aliases: []string{"sh", "bash", "zsh", "console"},
+		Keywords: []string{
+			"case", "do", "done", "elif", "else", "esac", "export", "fi", "for",
+			"function", "if", "in", "local", "return", "then", "until", "while",
+		},
+		LineComments: []string{"#"},
+		Strings:      []string{`"`, "'"},
+	},
+	{
+		Name:         "yaml",
+		Aliases:      []string{"yml"},
+		Keywords:     []string{"true", "false", "null", "yes", "no", "on", "off"},
+		LineComments: []string{"#"},
+		Strings:      []string{`"`, "'"},
+	},
+}
+
+// LexerFor returns the built-in lexer for a language name or alias
+// (case-insensitive), as used after a markdown code fence.
+//
+// Example:
+//
+//	if lexer, ok := syntax.LexerFor("golang"); ok {
+//	    tokens := lexer.Tokenize(src)
+//	}
+func LexerFor(lang string) (Lexer, bool) {
+	lang = strings.ToLower(strings.TrimSpace(lang))
+	for _, l := range builtinLexers {
+		if l.Name == lang {
+			return l, true
+		}
+		for _, alias := range l.Aliases {
+			if alias == lang {
+				return l, true
+			}
+		}
+	}
+	return Lexer{}, false
+}
+
+// Languages returns the names of the built-in lexers, sorted.
+func Languages() []string {
+	names := make([]string, len(builtinLexers))
+	for i, l := range builtinLexers {
+		names[i] = l.Name
+	}
+	sort.Strings(names)
+	return names
+}
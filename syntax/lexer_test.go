@@ -0,0 +1,107 @@
+package syntax
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// typesOf returns the type of each token whose value matches one of words
+func typesOf(tokens []Token, words ...string) map[string]TokenType {
+	found := make(map[string]TokenType)
+	for _, tok := range tokens {
+		for _, w := range words {
+			if strings.TrimSpace(tok.Value) == w {
+				found[w] = tok.Type
+			}
+		}
+	}
+	return found
+}
+
+func TestLexer_Go(t *testing.T) {
+	lexer, ok := LexerFor("go")
+	require.True(t, ok)
+
+	code := "// Add sums\nfunc add(a int) int {\n\treturn a + 0x1F /* hex */ + len(`raw\nstr`)\n}\nvar s = \"q\\\"x\""
+	tokens := lexer.Tokenize(code)
+
+	got := typesOf(tokens, "// Add sums", "func", "add", "int", "return", "+", "0x1F", "/* hex */", "len", "`raw\nstr`", "{", "\"q\\\"x\"")
+	require.Equal(t, map[string]TokenType{
+		"// Add sums": Comment,
+		"func":        Keyword,
+		"add":         Function,
+		"int":         Type,
+		"return":      Keyword,
+		"+":           Operator,
+		"0x1F":        Number,
+		"/* hex */":   Comment,
+		"len":         Function,
+		"`raw\nstr`":  String,
+		"{":           Punctuation,
+		"\"q\\\"x\"":  String,
+	}, got)
+}
+
+func TestLexer_Python(t *testing.T) {
+	lexer, ok := LexerFor("py")
+	require.True(t, ok)
+
+	tokens := lexer.Tokenize("def f():\n    \"\"\"doc\nstring\"\"\"\n    return None  # done")
+	got := typesOf(tokens, "def", "f", "\"\"\"doc\nstring\"\"\"", "None", "# done")
+	require.Equal(t, map[string]TokenType{
+		"def":                     Keyword,
+		"f":                       Function,
+		"\"\"\"doc\nstring\"\"\"": String,
+		"None":                    Keyword,
+		"# done":                  Comment,
+	}, got)
+}
+
+func TestLexer_RoundTrip(t *testing.T) {
+	inputs := []string{
+		"",
+		"package main\n\nfunc main() { fmt.Println(\"héllo, 世界\") }\n",
+		"unterminated \"string\nnext line",
+		"/* unterminated comment",
+		"x := 1.5e3 + .5 - 0b101 // trailing",
+		"echo $HOME | grep -v '#' # comment",
+	}
+
+	for _, lang := range Languages() {
+		lexer, _ := LexerFor(lang)
+		for _, input := range inputs {
+			var b strings.Builder
+			for _, tok := range lexer.Tokenize(input) {
+				b.WriteString(tok.Value)
+			}
+			require.Equal(t, input, b.String(), "%s lexer must reproduce its input", lang)
+		}
+	}
+}
+
+func TestLexer_UnterminatedStringStopsAtLineEnd(t *testing.T) {
+	lexer, _ := LexerFor("go")
+	tokens := lexer.Tokenize("\"open\nreturn")
+
+	require.Equal(t, Token{Type: String, Value: "\"open"}, tokens[0])
+	require.Equal(t, Keyword, typesOf(tokens, "return")["return"])
+}
+
+func TestLexerFor(t *testing.T) {
+	for _, name := range []string{"go", "Golang", "JS", "typescript", "bash", "yml", "c++", " rust "} {
+		_, ok := LexerFor(name)
+		require.True(t, ok, "LexerFor(%q)", name)
+	}
+
+	_, ok := LexerFor("brainfuck")
+	require.False(t, ok)
+}
+
+func TestLanguages(t *testing.T) {
+	langs := Languages()
+	require.Contains(t, langs, "go")
+	require.Contains(t, langs, "python")
+	require.IsNonDecreasing(t, langs)
+}
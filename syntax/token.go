@@ -0,0 +1,110 @@
+// Package syntax highlights source code with tuistyles.
+//
+// Code is split into Tokens by a Lexer, either one of the small built-in
+// lexers (see LexerFor) or any external tokenizer. Highlight maps token types
+// to styles through a Theme, and Block draws highlighted code in a bordered
+// box sized to an exact width.
+//
+// To use a full-featured lexer such as chroma, convert its token stream and
+// let TokenTypeFromName map chroma's type names:
+//
+//	for _, t := range iterator.Tokens() {
+//	    tokens = append(tokens, syntax.Token{Type: syntax.TokenTypeFromName(t.Type.String()), Value: t.Value})
+//	}
+//	fmt.Println(syntax.Highlight(tokens, syntax.DefaultTheme()))
+package syntax
+
+import "strings"
+
+// TokenType classifies a token for styling
+type TokenType int
+
+const (
+	// Text is whitespace and anything not otherwise classified
+	Text TokenType = iota
+	// Keyword is a reserved word or built-in constant (func, if, true, nil)
+	Keyword
+	// Type is a built-in type name (int, string, bool)
+	Type
+	// Function is an identifier followed by a call or declaration paren
+	Function
+	// Name is any other identifier
+	Name
+	// String is a string or character literal
+	String
+	// Number is a numeric literal
+	Number
+	// Comment is a line or block comment
+	Comment
+	// Operator is an operator such as + or :=
+	Operator
+	// Punctuation is a bracket, comma, semicolon, or dot
+	Punctuation
+)
+
+// String returns human-readable token type name
+func (t TokenType) String() string {
+	switch t {
+	case Text:
+		return "Text"
+	case Keyword:
+		return "Keyword"
+	case Type:
+		return "Type"
+	case Function:
+		return "Function"
+	case Name:
+		return "Name"
+	case String:
+		return "String"
+	case Number:
+		return "Number"
+	case Comment:
+		return "Comment"
+	case Operator:
+		return "Operator"
+	case Punctuation:
+		return "Punctuation"
+	default:
+		return "Unknown"
+	}
+}
+
+// Token is a classified piece of source code
+type Token struct {
+	Type  TokenType
+	Value string
+}
+
+// TokenTypeFromName maps a hierarchical token type name, as used by chroma
+// and Pygments ("KeywordDeclaration", "LiteralStringDouble", "NameFunction",
+// "Comment.Single"), to the closest TokenType. Unknown names map to Text.
+func TokenTypeFromName(name string) TokenType {
+	name = strings.ReplaceAll(name, ".", "")
+
+	prefixes := []struct {
+		prefix string
+		t      TokenType
+	}{
+		// Most specific first
+		{"KeywordType", Type},
+		{"NameBuiltinPseudo", Keyword},
+		{"NameBuiltin", Type},
+		{"NameFunction", Function},
+		{"LiteralString", String},
+		{"LiteralNumber", Number},
+		{"String", String},
+		{"Number", Number},
+		{"Keyword", Keyword},
+		{"Comment", Comment},
+		{"Operator", Operator},
+		{"Punctuation", Punctuation},
+		{"Name", Name},
+	}
+	for _, p := range prefixes {
+		if strings.HasPrefix(name, p.prefix) {
+			return p.t
+		}
+	}
+	return Text
+}
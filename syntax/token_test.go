@@ -0,0 +1,42 @@
+package syntax
+
+import "testing"
+
+func TestTokenTypeFromName(t *testing.T) {
+	tests := []struct {
+		name string
+		want TokenType
+	}{
+		{"KeywordDeclaration", Keyword},
+		{"KeywordType", Type},
+		{"NameBuiltin", Type},
+		{"NameBuiltinPseudo", Keyword},
+		{"NameFunction", Function},
+		{"NameVariable", Name},
+		{"LiteralStringDouble", String},
+		{"Literal.String.Single", String},
+		{"LiteralNumberInteger", Number},
+		{"CommentSingle", Comment},
+		{"Operator", Operator},
+		{"Punctuation", Punctuation},
+		{"TextWhitespace", Text},
+		{"Bogus", Text},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := TokenTypeFromName(tt.name); got != tt.want {
+				t.Errorf("TokenTypeFromName(%q) = %v, want %v", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTokenType_String(t *testing.T) {
+	if Keyword.String() != "Keyword" || Punctuation.String() != "Punctuation" {
+		t.Error("unexpected token type names")
+	}
+	if TokenType(99).String() != "Unknown" {
+		t.Error("invalid token type should be Unknown")
+	}
+}
@@ -0,0 +1,519 @@
+package tuistyles
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+// Table renders rows of cells in bordered columns sized to their content:
+//
+//	╭──────┬────────╮
+//	│ Name │ Status │
+//	├──────┼────────┤
+//	│ api  │ up     │
+//	│ db   │ down   │
+//	╰──────┴────────╯
+//
+// Rows may have different lengths; missing cells are left blank. A cell
+// with newlines takes a line for each, making its row taller; Spans make a
+// cell taller still, running down over the rows below it.
+//
+// Example:
+//
+//	table := Table{
+//	    Headers:  []string{"Name", "CPU"},
+//	    Rows:     [][]string{{"api", "12.5"}, {"db", "3"}},
+//	    Align:    []Position{Left, Decimal},
+//	    RowStyle: Stripe(NewStyle(), NewStyle().Background(Color("#262626"))),
+//	}
+//	fmt.Println(table.Render(80))
+type Table struct {
+	Headers    []string
+	Rows       [][]string
+	Spans      []CellSpan     // Cells that extend down over the rows below them
+	Blocks     []CellBlock    // Components drawn in place of cells, sized to their columns
+	Tree       TableTree      // Depth of each row, for rows grouped under parent rows
+	Align      []Position     // Per column: Left (default), Center, Right, or Decimal
+	VAlign     []Position     // Per column: Top (default), Center, or Bottom, for cells shorter than their row
+	Columns    []Column       // Per column: how it shrinks and overflows when the table is too wide
+	Border     Border         // RoundedBorder by default
+	RowStyle   LineStyleFunc  // Style for each data row, layered over CellStyle; given the row's cells joined by spaces
+	StyleFunc  CellStyleFunc  // Style for each data cell, layered over RowStyle; given the cell's unformatted value
+	FormatFunc CellFormatFunc // Text shown for each data cell, in place of its value; applied before alignment
+	Theme      Theme
+
+	RowSeparators bool // Draw a rule between data rows
+
+	HeaderStyle Style // Bold primary by default
+	CellStyle   Style // Unstyled by default
+	BorderStyle Style // Border color by default
+	FooterStyle Style // Muted by default; used by RenderPage
+}
+
+// Render draws the table. Columns keep their natural widths when width is 0
+// or less or the table fits; otherwise columns shrink as their Columns
+// policies allow (by default the widest first, down to one cell each), and
+// cells that no longer fit are truncated with "…" or wrapped.
+func (t Table) Render(width int) string {
+	return t.render(width, 0, len(t.VisibleRows()))
+}
+
+// RenderPage draws limit rows starting at row offset (counting from 0),
+// followed by a footer such as "rows 21–40 of 310", so a large dataset can
+// be shown a page at a time in a fixed-height area. Column widths, decimal
+// alignment, and RowStyle indexes are those of the whole table, so columns
+// stay put from page to page, and a span begun on an earlier page shows
+// its cell again. Offsets outside the rows are clamped; a limit of 0 or
+// less shows every row from offset on.
+//
+// Example:
+//
+//	const pageSize = 20
+//	fmt.Println(results.RenderPage(80, page*pageSize, pageSize))
+func (t Table) RenderPage(width, offset, limit int) string {
+	t = t.withTree()
+	total := len(t.Rows)
+	start := min(max(offset, 0), total)
+	end := total
+	if limit > 0 {
+		end = min(start+limit, total)
+	}
+
+	table := t.render(width, start, end)
+	if table == "" {
+		return ""
+	}
+
+	footer := fmt.Sprintf("rows %d–%d of %d", start+1, end, total)
+	if start == end {
+		footer = fmt.Sprintf("0 of %d rows", total)
+	}
+	theme := t.Theme.WithDefaults()
+	footerStyle := orDefault(t.FooterStyle, NewStyle().Foreground(theme.Muted))
+	footer = footerStyle.Render(footer)
+	if gap := Width(table) - measure.Width(footer); gap > 0 {
+		footer = strings.Repeat(" ", gap) + footer
+	}
+	return table + "\n" + footer
+}
+
+// render draws the table with the data rows from start up to end
+func (t Table) render(width, start, end int) string {
+	t = t.withTree()
+	cols := t.columnCount()
+	if cols == 0 {
+		return ""
+	}
+
+	theme := t.Theme.WithDefaults()
+	headerStyle := orDefault(t.HeaderStyle, NewStyle().Bold(true).Foreground(theme.Primary))
+	borderStyle := orDefault(t.BorderStyle, NewStyle().Foreground(theme.Border))
+	border := t.border()
+	joints := tableJointsFor(border)
+
+	header := t.normalize(t.Headers, cols)
+	rows := t.bodyCells(cols)
+	widths := t.columnWidths(header, rows, width, border)
+
+	rule := func(left, fill, mid, right string) string {
+		parts := make([]string, cols)
+		for c, w := range widths {
+			parts[c] = strings.Repeat(fill, w+2)
+		}
+		return borderStyle.Render(left + strings.Join(parts, mid) + right)
+	}
+	// grid draws rows of cells, each row as tall as its tallest cell. A cell
+	// spanning rows is drawn over their combined height, including the
+	// separators between them, which stop at its edges.
+	grid := func(cells [][]tableCell, separators bool) []string {
+		sep := 0
+		if separators {
+			sep = 1
+		}
+		heights := make([]int, len(cells))
+		for i, row := range cells {
+			heights[i] = 1
+			for c := range row {
+				if row[c].block != nil && Width(row[c].text) > widths[c] {
+					row[c].text = row[c].block.Render(widths[c])
+				}
+				row[c].text = t.fitCell(row[c].text, c, widths[c])
+				if row[c].rows == 1 {
+					heights[i] = max(heights[i], Height(row[c].text))
+				}
+			}
+		}
+		// Spanning cells taller than their rows stretch the last of them
+		spanHeight := func(i, n int) int {
+			h := (n - 1) * sep
+			for _, rh := range heights[i : i+n] {
+				h += rh
+			}
+			return h
+		}
+		for i, row := range cells {
+			for _, cell := range row {
+				if cell.rows > 1 {
+					heights[i+cell.rows-1] += max(Height(cell.text)-spanHeight(i, cell.rows), 0)
+				}
+			}
+		}
+
+		var lines []string
+		pending := make([][]string, cols) // Lines of each column's cell still to draw
+		for i, row := range cells {
+			for c, cell := range row {
+				if cell.rows > 0 {
+					block := t.renderCell(cell.text, c, widths[c], spanHeight(i, cell.rows), cell.style, cell.header)
+					pending[c] = strings.Split(block, "\n")
+				}
+			}
+			for range heights[i] {
+				var b strings.Builder
+				b.WriteString(borderStyle.Render(border.Left))
+				for c := range pending {
+					if c > 0 {
+						b.WriteString(borderStyle.Render(joints.vertical))
+					}
+					b.WriteString(pending[c][0])
+					pending[c] = pending[c][1:]
+				}
+				b.WriteString(borderStyle.Render(border.Right))
+				lines = append(lines, b.String())
+			}
+			if sep == 0 || i == len(cells)-1 {
+				continue
+			}
+
+			// A separator, broken where spanning cells carry on across it
+			open := func(c int) bool { return len(pending[c]) > 0 }
+			var b strings.Builder
+			left, right := joints.left, joints.right
+			if open(0) {
+				left = border.Left
+			}
+			if open(cols - 1) {
+				right = border.Right
+			}
+			b.WriteString(borderStyle.Render(left))
+			for c := range pending {
+				if c > 0 {
+					var joint string
+					switch {
+					case open(c-1) && open(c):
+						joint = joints.vertical
+					case open(c - 1):
+						joint = joints.left
+					case open(c):
+						joint = joints.right
+					default:
+						joint = joints.cross
+					}
+					b.WriteString(borderStyle.Render(joint))
+				}
+				if open(c) {
+					b.WriteString(pending[c][0])
+					pending[c] = pending[c][1:]
+				} else {
+					b.WriteString(borderStyle.Render(strings.Repeat(border.Top, widths[c]+2)))
+				}
+			}
+			b.WriteString(borderStyle.Render(right))
+			lines = append(lines, b.String())
+		}
+		return lines
+	}
+
+	lines := []string{rule(border.TopLeft, border.Top, joints.top, border.TopRight)}
+	if len(t.Headers) > 0 {
+		cells := make([]tableCell, cols)
+		for c, text := range header {
+			cells[c] = tableCell{text: text, rows: 1, style: headerStyle, header: true}
+		}
+		lines = append(lines, grid([][]tableCell{cells}, false)...)
+		lines = append(lines, rule(joints.left, border.Top, joints.cross, joints.right))
+	}
+	lines = append(lines, grid(t.pageCells(rows, start, end), t.RowSeparators)...)
+	lines = append(lines, rule(border.BottomLeft, border.Bottom, joints.bottom, border.BottomRight))
+	return strings.Join(lines, "\n")
+}
+
+// tableCell is a cell laid out for drawing
+type tableCell struct {
+	text   string
+	rows   int // Rows the cell spans; 0 for a cell covered by a span above
+	style  Style
+	header bool
+	block  Component // Drawn again at the column width when its text is wider
+}
+
+// pageCells lays out the data rows from start up to end, given the
+// prepared cells of every row. A span that begins above start is drawn
+// from start, so each page shows its cell.
+func (t Table) pageCells(rows [][]string, start, end int) [][]tableCell {
+	cols := t.columnCount()
+	spans := t.rowSpans(cols)
+	rowStyles := make(map[int]Style)
+	style := func(i, c int) Style {
+		rowStyle, ok := rowStyles[i]
+		if !ok {
+			rowStyle = t.CellStyle
+			if t.RowStyle != nil {
+				line := strings.ReplaceAll(strings.Join(t.normalize(t.Rows[i], cols), " "), "\n", " ")
+				rowStyle = t.RowStyle(i, line).over(rowStyle)
+			}
+			rowStyles[i] = rowStyle
+		}
+		if t.StyleFunc == nil {
+			return rowStyle
+		}
+		return t.StyleFunc(i, c, t.normalize(t.Rows[i], cols)[c]).over(rowStyle)
+	}
+
+	cells := make([][]tableCell, end-start)
+	for i := start; i < end; i++ {
+		cells[i-start] = make([]tableCell, cols)
+		for c := range cols {
+			from, n := i, spans[i][c]
+			if n == 0 {
+				if i > start {
+					continue
+				}
+				for spans[from][c] == 0 {
+					from--
+				}
+				n = from + spans[from][c] - i
+			}
+			cells[i-start][c] = tableCell{text: rows[from][c], rows: min(n, end-i), style: style(from, c), block: t.block(from, c)}
+		}
+	}
+	return cells
+}
+
+// rowSpans returns the number of rows each data cell spans: 1 for most
+// cells, more for the first cell of a span, and 0 for the cells a span
+// covers. Spans that start outside the table or on a covered cell are
+// ignored, and spans stop short of the next span down their column.
+func (t Table) rowSpans(cols int) [][]int {
+	spans := make([][]int, len(t.Rows))
+	for i := range spans {
+		spans[i] = make([]int, cols)
+		for c := range spans[i] {
+			spans[i][c] = 1
+		}
+	}
+	for _, span := range t.Spans {
+		if span.Row < 0 || span.Row >= len(t.Rows) || span.Col < 0 || span.Col >= cols || spans[span.Row][span.Col] != 1 {
+			continue
+		}
+		n := 1
+		for n < span.Rows && span.Row+n < len(t.Rows) && spans[span.Row+n][span.Col] == 1 {
+			spans[span.Row+n][span.Col] = 0
+			n++
+		}
+		spans[span.Row][span.Col] = n
+	}
+	return spans
+}
+
+// MinWidth returns the width of the table with every column shrunk as far
+// as its policy allows: to one cell by default.
+func (t Table) MinWidth() int {
+	t = t.withTree()
+	cols := t.columnCount()
+	if cols == 0 {
+		return 0
+	}
+	border := t.border()
+	total := t.frameWidth(cols, border)
+	for c, w := range t.naturalWidths(t.normalize(t.Headers, cols), t.bodyCells(cols)) {
+		total += t.narrowest(c, w) + 2
+	}
+	return total
+}
+
+// border returns the table's border, RoundedBorder when none is set
+func (t Table) border() Border {
+	if t.Border == (Border{}) {
+		return RoundedBorder()
+	}
+	return t.Border
+}
+
+// columnCount returns the number of columns: the longest of the header and
+// the rows
+func (t Table) columnCount() int {
+	cols := len(t.Headers)
+	for _, row := range t.Rows {
+		cols = max(cols, len(row))
+	}
+	return cols
+}
+
+// normalize returns cells extended to cols entries
+func (t Table) normalize(cells []string, cols int) []string {
+	out := make([]string, cols)
+	copy(out, cells)
+	return out
+}
+
+// bodyCells returns the normalized and formatted data rows, with cells
+// covered by spans blanked and Decimal columns flattened onto one line and
+// padded so their separators line up
+func (t Table) bodyCells(cols int) [][]string {
+	spans := t.rowSpans(cols)
+	rows := make([][]string, len(t.Rows))
+	for i, row := range t.Rows {
+		rows[i] = t.normalize(row, cols)
+		for c, value := range rows[i] {
+			switch {
+			case spans[i][c] == 0:
+				rows[i][c] = ""
+			case t.block(i, c) != nil:
+				rows[i][c] = t.block(i, c).Render(0)
+			case t.FormatFunc != nil:
+				rows[i][c] = t.FormatFunc(i, c, value)
+			}
+		}
+	}
+	for c := 0; c < cols; c++ {
+		if t.align(c) != Decimal {
+			continue
+		}
+		values := make([]string, len(rows))
+		for i := range rows {
+			values[i] = strings.ReplaceAll(rows[i][c], "\n", " ")
+		}
+		for i, v := range AlignDecimal(values, "") {
+			rows[i][c] = v
+		}
+	}
+	return rows
+}
+
+// align returns the alignment of column c
+func (t Table) align(c int) Position {
+	if c < len(t.Align) {
+		return t.Align[c]
+	}
+	return Left
+}
+
+// valign returns the vertical alignment of column c
+func (t Table) valign(c int) Position {
+	if c < len(t.VAlign) {
+		return t.VAlign[c]
+	}
+	return Top
+}
+
+// naturalWidths returns the width of the widest cell in each column, at
+// least one
+func (t Table) naturalWidths(header []string, rows [][]string) []int {
+	widths := make([]int, len(header))
+	for c := range widths {
+		widths[c] = Width(header[c])
+		for _, row := range rows {
+			widths[c] = max(widths[c], Width(row[c]))
+		}
+		widths[c] = max(widths[c], 1)
+	}
+	return widths
+}
+
+// frameWidth returns the width of the table's borders and column
+// separators
+func (t Table) frameWidth(cols int, border Border) int {
+	return measure.Width(border.Left) + measure.Width(border.Right) + (cols-1)*measure.Width(tableJointsFor(border).vertical)
+}
+
+// columnWidths returns the content width of each column, shrinking columns
+// one cell at a time until the table fits within width. Each step takes a
+// cell from the column with the highest Shrink priority that can still
+// give one up, the widest among equals, and the leftmost among those, so
+// the same table and width always give the same widths.
+func (t Table) columnWidths(header []string, rows [][]string, width int, border Border) []int {
+	widths := t.naturalWidths(header, rows)
+	if width <= 0 {
+		return widths
+	}
+
+	// Each column adds a cell of padding on both sides
+	total := t.frameWidth(len(widths), border)
+	for _, w := range widths {
+		total += w + 2
+	}
+	natural := slices.Clone(widths)
+	for ; total > width; total-- {
+		pick := -1
+		for c, w := range widths {
+			col := t.column(c)
+			if w <= t.narrowest(c, natural[c]) {
+				continue
+			}
+			if pick < 0 || col.Shrink > t.column(pick).Shrink ||
+				(col.Shrink == t.column(pick).Shrink && w > widths[pick]) {
+				pick = c
+			}
+		}
+		if pick < 0 {
+			break
+		}
+		widths[pick]--
+	}
+	return widths
+}
+
+// fitCell returns cell fitted to width cells as its column's overflow
+// policy says: wrapped onto more lines or with each line truncated with "…"
+func (t Table) fitCell(cell string, c, width int) string {
+	if Width(cell) <= width {
+		return cell
+	}
+	if t.column(c).Overflow == ColumnWrap {
+		return Wrap(cell, width)
+	}
+	lines := strings.Split(cell, "\n")
+	for i, line := range lines {
+		lines[i] = truncateKeeping(line, width, "…", width, false)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// renderCell draws one cell height lines tall, padded by a space on each
+// side, in style
+func (t Table) renderCell(cell string, c, width, height int, style Style, header bool) string {
+	align := t.align(c)
+	if align == Decimal {
+		// The values are already lined up; the header sits over them
+		align = Right
+		if !header {
+			align = Left
+		}
+	}
+	return style.withoutFrame().Width(width).Height(height).Align(align).AlignVertical(t.valign(c)).Padding(0, 1).Render(cell)
+}
+
+// tableJoints are the glyphs where a table's inner lines meet each other
+// and its border
+type tableJoints struct {
+	top, bottom, left, right, cross, vertical string
+}
+
+// tableJointsFor returns junction glyphs matching the border's line weight.
+// Borders without box-drawing junctions reuse their own characters.
+func tableJointsFor(b Border) tableJoints {
+	switch b.Left + b.Top {
+	case "│─":
+		return tableJoints{"┬", "┴", "├", "┤", "┼", "│"}
+	case "┃━":
+		return tableJoints{"┳", "┻", "┣", "┫", "╋", "┃"}
+	case "║═":
+		return tableJoints{"╦", "╩", "╠", "╣", "╬", "║"}
+	default:
+		return tableJoints{b.Top, b.Bottom, b.Left, b.Right, b.Top, b.Left}
+	}
+}
@@ -0,0 +1,320 @@
+package tuistyles
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+func TestTable_Render(t *testing.T) {
+	tests := []struct {
+		name  string
+		table Table
+		width int
+		want  string
+	}{
+		{
+			name:  "empty",
+			table: Table{},
+			want:  "",
+		},
+		{
+			name: "headers and rows",
+			table: Table{
+				Headers: []string{"Name", "Status"},
+				Rows:    [][]string{{"api", "up"}, {"db", "down"}},
+			},
+			want: "╭──────┬────────╮\n" +
+				"│ Name │ Status │\n" +
+				"├──────┼────────┤\n" +
+				"│ api  │ up     │\n" +
+				"│ db   │ down   │\n" +
+				"╰──────┴────────╯",
+		},
+		{
+			name: "ragged rows without headers",
+			table: Table{
+				Rows:   [][]string{{"a", "b"}, {"c"}},
+				Border: NormalBorder(),
+			},
+			want: "┌───┬───┐\n" +
+				"│ a │ b │\n" +
+				"│ c │   │\n" +
+				"└───┴───┘",
+		},
+		{
+			name: "alignment",
+			table: Table{
+				Headers: []string{"Item", "Cost"},
+				Rows:    [][]string{{"tea", "2.5"}, {"cake", "12.25"}},
+				Align:   []Position{Right, Decimal},
+			},
+			want: "╭──────┬───────╮\n" +
+				"│ Item │  Cost │\n" +
+				"├──────┼───────┤\n" +
+				"│  tea │  2.5  │\n" +
+				"│ cake │ 12.25 │\n" +
+				"╰──────┴───────╯",
+		},
+		{
+			name: "shrinks to width",
+			table: Table{
+				Headers: []string{"Name", "Description"},
+				Rows:    [][]string{{"api", "serves requests"}},
+			},
+			width: 20,
+			want: "╭──────┬───────────╮\n" +
+				"│ Name │ Descript… │\n" +
+				"├──────┼───────────┤\n" +
+				"│ api  │ serves r… │\n" +
+				"╰──────┴───────────╯",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, measure.StripANSI(tt.table.Render(tt.width)))
+		})
+	}
+}
+
+func TestTable_RowStyle(t *testing.T) {
+	odd := NewStyle().Background(Color("#303030"))
+	table := Table{
+		Rows:     [][]string{{"a", "1"}, {"b", "2"}, {"c", "3"}},
+		RowStyle: Stripe(NewStyle(), odd),
+	}
+
+	lines := strings.Split(table.Render(0), "\n")
+	require.Len(t, lines, 5)
+	require.NotContains(t, lines[1], "\x1b[48;")
+	require.Contains(t, lines[2], odd.Width(1).Align(Left).Padding(0, 1).Render("b"))
+	require.NotContains(t, lines[3], "\x1b[48;")
+	for _, line := range lines {
+		require.Equal(t, measure.Width(lines[0]), measure.Width(line))
+	}
+}
+
+func TestTable_RenderPage(t *testing.T) {
+	table := Table{Headers: []string{"n"}}
+	for _, n := range []string{"1", "2", "3", "100"} {
+		table.Rows = append(table.Rows, []string{n})
+	}
+
+	tests := []struct {
+		name          string
+		offset, limit int
+		want          string
+	}{
+		{
+			name:   "first page",
+			offset: 0, limit: 2,
+			want: "╭─────╮\n│ n   │\n├─────┤\n│ 1   │\n│ 2   │\n╰─────╯\nrows 1–2 of 4",
+		},
+		{
+			name:   "short last page",
+			offset: 3, limit: 2,
+			want: "╭─────╮\n│ n   │\n├─────┤\n│ 100 │\n╰─────╯\nrows 4–4 of 4",
+		},
+		{
+			name:   "no limit",
+			offset: 2, limit: 0,
+			want: "╭─────╮\n│ n   │\n├─────┤\n│ 3   │\n│ 100 │\n╰─────╯\nrows 3–4 of 4",
+		},
+		{
+			name:   "past the end",
+			offset: 9, limit: 2,
+			want: "╭─────╮\n│ n   │\n├─────┤\n╰─────╯\n0 of 4 rows",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, measure.StripANSI(table.RenderPage(0, tt.offset, tt.limit)))
+		})
+	}
+
+	require.Empty(t, Table{}.RenderPage(80, 0, 10))
+}
+
+func TestTable_RenderPageFooterAlignment(t *testing.T) {
+	table := Table{Headers: []string{"service", "status"}, Rows: [][]string{{"api", "up"}, {"db", "down"}}}
+	lines := strings.Split(measure.StripANSI(table.RenderPage(0, 1, 1)), "\n")
+	require.Equal(t, "       rows 2–2 of 2", lines[len(lines)-1])
+	require.Equal(t, measure.Width(lines[0]), measure.Width(lines[len(lines)-1]))
+	require.Equal(t, "│ db      │ down   │", lines[3])
+}
+
+func TestTable_RenderPageKeepsRowStyleIndex(t *testing.T) {
+	odd := NewStyle().Background(Color("#303030"))
+	table := Table{Rows: [][]string{{"a"}, {"b"}, {"c"}}, RowStyle: Stripe(NewStyle(), odd)}
+	lines := strings.Split(table.RenderPage(0, 1, 1), "\n")
+	require.Contains(t, lines[1], odd.Width(1).Align(Left).Padding(0, 1).Render("b"))
+}
+
+func TestTable_Columns(t *testing.T) {
+	base := Table{
+		Headers: []string{"id", "description", "path"},
+		Rows:    [][]string{{"42", "a fairly long description", "/usr/local/bin"}},
+	}
+
+	tests := []struct {
+		name    string
+		columns []Column
+		width   int
+		want    string
+	}{
+		{
+			name:  "default shrinks the widest and truncates",
+			width: 30,
+			want: "╭────┬───────────┬───────────╮\n" +
+				"│ id │ descript… │ path      │\n" +
+				"├────┼───────────┼───────────┤\n" +
+				"│ 42 │ a fairly… │ /usr/loc… │\n" +
+				"╰────┴───────────┴───────────╯",
+		},
+		{
+			name:    "wrap",
+			columns: []Column{{}, {Overflow: ColumnWrap}},
+			width:   30,
+			want: "╭────┬───────────┬───────────╮\n" +
+				"│ id │ descripti │ path      │\n" +
+				"│    │ on        │           │\n" +
+				"├────┼───────────┼───────────┤\n" +
+				"│ 42 │ a fairly  │ /usr/loc… │\n" +
+				"│    │ long      │           │\n" +
+				"│    │ descripti │           │\n" +
+				"│    │ on        │           │\n" +
+				"╰────┴───────────┴───────────╯",
+		},
+		{
+			name:    "priority shrinks one column first",
+			columns: []Column{{}, {}, {Shrink: 1}},
+			width:   42,
+			want: "╭────┬───────────────────────────┬───────╮\n" +
+				"│ id │ description               │ path  │\n" +
+				"├────┼───────────────────────────┼───────┤\n" +
+				"│ 42 │ a fairly long description │ /usr… │\n" +
+				"╰────┴───────────────────────────┴───────╯",
+		},
+		{
+			name:    "fixed and minimum widths",
+			columns: []Column{{Shrink: -1}, {Shrink: -1}, {MinWidth: 4}},
+			width:   20,
+			want: "╭────┬───────────────────────────┬──────╮\n" +
+				"│ id │ description               │ path │\n" +
+				"├────┼───────────────────────────┼──────┤\n" +
+				"│ 42 │ a fairly long description │ /us… │\n" +
+				"╰────┴───────────────────────────┴──────╯",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			table := base
+			table.Columns = tt.columns
+			got := measure.StripANSI(table.Render(tt.width))
+			require.Equal(t, tt.want, got)
+			require.Equal(t, max(tt.width, table.MinWidth()), Width(got))
+		})
+	}
+}
+
+func TestTable_ColumnsMinWidth(t *testing.T) {
+	table := Table{Rows: [][]string{{"abcdef", "abcdef"}}}
+	require.Equal(t, 9, table.MinWidth())
+
+	table.Columns = []Column{{Shrink: -1}, {MinWidth: 3}}
+	require.Equal(t, 3+8+5, table.MinWidth())
+	require.Equal(t, table.MinWidth(), Width(table.Render(1)))
+}
+
+func TestColumnOverflow_Text(t *testing.T) {
+	for _, o := range []ColumnOverflow{ColumnTruncate, ColumnWrap} {
+		text, err := o.MarshalText()
+		require.NoError(t, err)
+
+		var decoded ColumnOverflow
+		require.NoError(t, decoded.UnmarshalText(text))
+		require.Equal(t, o, decoded)
+	}
+
+	_, err := ColumnOverflow(9).MarshalText()
+	require.Error(t, err)
+
+	var o ColumnOverflow
+	require.Error(t, o.UnmarshalText([]byte("shrink")))
+}
+
+func TestTable_MultilineCells(t *testing.T) {
+	table := Table{
+		Headers: []string{"Name", "Notes"},
+		Rows:    [][]string{{"api", "up\nsince 9:00"}, {"db", "down"}},
+		VAlign:  []Position{Bottom},
+	}
+
+	want := "╭──────┬────────────╮\n" +
+		"│ Name │ Notes      │\n" +
+		"├──────┼────────────┤\n" +
+		"│      │ up         │\n" +
+		"│ api  │ since 9:00 │\n" +
+		"│ db   │ down       │\n" +
+		"╰──────┴────────────╯"
+	require.Equal(t, want, measure.StripANSI(table.Render(0)))
+
+	require.Equal(t, "│ api  │ sin… │", strings.Split(measure.StripANSI(table.Render(15)), "\n")[4],
+		"each line is truncated on its own")
+}
+
+func TestTable_Spans(t *testing.T) {
+	table := Table{
+		Headers:       []string{"Region", "Host", "Note"},
+		Rows:          [][]string{{"eu", "eu-1", "a\nb"}, {"covered", "eu-2"}, {"us", "us-1", "c"}},
+		Spans:         []CellSpan{{Row: 0, Col: 0, Rows: 2}},
+		VAlign:        []Position{Center},
+		RowSeparators: true,
+	}
+
+	want := "╭────────┬──────┬──────╮\n" +
+		"│ Region │ Host │ Note │\n" +
+		"├────────┼──────┼──────┤\n" +
+		"│        │ eu-1 │ a    │\n" +
+		"│ eu     │      │ b    │\n" +
+		"│        ├──────┼──────┤\n" +
+		"│        │ eu-2 │      │\n" +
+		"├────────┼──────┼──────┤\n" +
+		"│ us     │ us-1 │ c    │\n" +
+		"╰────────┴──────┴──────╯"
+	require.Equal(t, want, measure.StripANSI(table.Render(0)))
+
+	page := strings.Split(measure.StripANSI(table.RenderPage(0, 1, 1)), "\n")
+	require.Equal(t, "│ eu     │ eu-2 │      │", page[3], "a span begun on an earlier page is shown")
+}
+
+func TestTable_SpanTallerThanRows(t *testing.T) {
+	table := Table{
+		Rows:          [][]string{{"one\ntwo\nthree", "a"}, {"", "b"}, {"c", "d"}},
+		Spans:         []CellSpan{{Row: 0, Col: 1, Rows: 9}, {Row: 1, Col: 1, Rows: 2}, {Row: 5, Col: 0, Rows: 2}},
+		RowSeparators: true,
+	}
+
+	want := "╭───────┬───╮\n" +
+		"│ one   │ a │\n" +
+		"│ two   │   │\n" +
+		"│ three │   │\n" +
+		"├───────┤   │\n" +
+		"│       │   │\n" +
+		"├───────┤   │\n" +
+		"│ c     │   │\n" +
+		"╰───────┴───╯"
+	require.Equal(t, want, measure.StripANSI(table.Render(0)))
+
+	table.Spans = []CellSpan{{Row: 0, Col: 1, Rows: 2}}
+	table.Rows[0] = []string{"x", "1\n2\n3\n4"}
+	lines := strings.Split(measure.StripANSI(table.Render(0)), "\n")
+	require.Len(t, lines, 8, "the last row of the span grows to fit it")
+	require.Equal(t, "│   │ 4 │", lines[4])
+}
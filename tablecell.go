@@ -0,0 +1,114 @@
+package tuistyles
+
+import "strings"
+
+// CellStyleFunc picks the style of the data cell at row and col (counting
+// from 0), given its value as it appears in Table.Rows.
+type CellStyleFunc func(row, col int, value string) Style
+
+// CellFormatFunc returns the text shown for the data cell at row and col
+// (counting from 0), given its value as it appears in Table.Rows.
+type CellFormatFunc func(row, col int, value string) string
+
+// NegativeStyle returns a CellStyleFunc that applies style to negative
+// numbers and leaves other cells unstyled.
+//
+// Example:
+//
+//	table.StyleFunc = NegativeStyle(NewStyle().Foreground(theme.Error))
+func NegativeStyle(style Style) CellStyleFunc {
+	return func(_, _ int, value string) Style {
+		if isNumeric(value) && strings.HasPrefix(strings.TrimSpace(value), "-") {
+			return style
+		}
+		return NewStyle()
+	}
+}
+
+// ThousandsFormat returns a CellFormatFunc that groups the digits before
+// the decimal point of numeric cells in threes, joined by sep. Values that
+// already have separators, and cells that are not numbers, are shown as
+// they are.
+//
+// Example:
+//
+//	table.FormatFunc = ThousandsFormat(",") // "-$1234567.5" → "-$1,234,567.5"
+func ThousandsFormat(sep string) CellFormatFunc {
+	return func(_, _ int, value string) string {
+		if !isNumeric(value) || strings.Contains(value, ",") {
+			return value
+		}
+		start := strings.IndexAny(value, "0123456789")
+		end := start
+		for end < len(value) && value[end] >= '0' && value[end] <= '9' {
+			end++
+		}
+		digits := value[start:end]
+		if len(digits) <= 3 {
+			return value
+		}
+
+		var b strings.Builder
+		b.WriteString(value[:start])
+		for i, d := range digits {
+			if i > 0 && (len(digits)-i)%3 == 0 {
+				b.WriteString(sep)
+			}
+			b.WriteRune(d)
+		}
+		b.WriteString(value[end:])
+		return b.String()
+	}
+}
+
+// CellSpan makes the data cell at Row and Col (counting from 0) extend
+// down over the Rows rows starting with its own, like rowspan in HTML. The
+// cells it covers are not drawn.
+//
+// Example:
+//
+//	table := Table{
+//	    Headers: []string{"Region", "Host"},
+//	    Rows:    [][]string{{"eu", "eu-1"}, {"", "eu-2"}, {"us", "us-1"}},
+//	    Spans:   []CellSpan{{Row: 0, Col: 0, Rows: 2}},
+//	    RowSeparators: true,
+//	}
+type CellSpan struct {
+	Row, Col int
+	Rows     int
+}
+
+// CellBlock draws a Component, such as a nested Table or a Panel, in the
+// data cell at Row and Col (counting from 0) in place of its value. The
+// cell's column is as wide as the component's natural width; when the
+// table must shrink, the component is drawn again at the column's width,
+// and the column shrinks no narrower than the component's MinWidth.
+//
+// A pre-rendered block can also go straight into Rows: the table sizes
+// around its widest line and its height, but can only truncate its lines.
+//
+// Example:
+//
+//	table := Table{
+//	    Headers: []string{"Host", "Stats"},
+//	    Rows:    [][]string{{"api"}, {"db"}},
+//	    Blocks: []CellBlock{
+//	        {Row: 0, Col: 1, Content: Table{Rows: apiStats, Border: NormalBorder()}},
+//	        {Row: 1, Col: 1, Content: Table{Rows: dbStats, Border: NormalBorder()}},
+//	    },
+//	}
+type CellBlock struct {
+	Row, Col int
+	Content  Component
+}
+
+// block returns the component drawn in the data cell at row and col, or
+// nil when there is none
+func (t Table) block(row, col int) Component {
+	for _, b := range t.Blocks {
+		if b.Row == row && b.Col == col {
+			return b.Content
+		}
+	}
+	return nil
+}
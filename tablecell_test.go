@@ -0,0 +1,115 @@
+package tuistyles
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+func TestTable_StyleFunc(t *testing.T) {
+	red := NewStyle().Foreground(Color("#ff0000"))
+	odd := NewStyle().Background(Color("#303030"))
+	table := Table{
+		Rows:      [][]string{{"a", "-1"}, {"b", "2"}},
+		RowStyle:  Stripe(NewStyle(), odd),
+		StyleFunc: NegativeStyle(red),
+	}
+
+	lines := strings.Split(table.Render(0), "\n")
+	require.Len(t, lines, 4)
+	require.Equal(t, "│ a │ -1 │", measure.StripANSI(lines[1]))
+	require.Contains(t, lines[1], red.Render("-1"))
+	require.Contains(t, lines[2], "48;2;48;48;48", "row style still applies")
+	require.NotContains(t, lines[2], "38;2;255;0;0")
+}
+
+func TestTable_FormatFunc(t *testing.T) {
+	var seen []string
+	table := Table{
+		Headers: []string{"item", "total"},
+		Rows:    [][]string{{"rent", "1200.5"}, {"fees", "-15"}},
+		Align:   []Position{Left, Decimal},
+		FormatFunc: func(row, col int, value string) string {
+			if col == 1 {
+				return "$" + value
+			}
+			return value
+		},
+		StyleFunc: func(row, col int, value string) Style {
+			seen = append(seen, value)
+			return NewStyle()
+		},
+	}
+
+	got := measure.StripANSI(table.Render(0))
+	require.Contains(t, got, "│ rent │ $1200.5 │")
+	require.Contains(t, got, "│ fees │  $-15   │")
+	require.Equal(t, []string{"rent", "1200.5", "fees", "-15"}, seen, "StyleFunc sees unformatted values")
+}
+
+func TestThousandsFormat(t *testing.T) {
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{"1234567", "1,234,567"},
+		{"-$1234567.891", "-$1,234,567.891"},
+		{"1000%", "1,000%"},
+		{"999", "999"},
+		{"12,345", "12,345"},
+		{"v12345", "v12345"},
+		{"", ""},
+	}
+
+	format := ThousandsFormat(",")
+	for _, tt := range tests {
+		require.Equal(t, tt.want, format(0, 0, tt.value), tt.value)
+	}
+	require.Equal(t, "1 234 567", ThousandsFormat(" ")(0, 0, "1234567"))
+}
+
+func TestNegativeStyle(t *testing.T) {
+	red := NewStyle().Foreground(Color("#ff0000"))
+	style := NegativeStyle(red)
+	require.True(t, style(0, 0, "-3.5").Equal(red))
+	require.True(t, style(0, 0, " -$12").Equal(red))
+	require.True(t, style(0, 0, "3").Equal(NewStyle()))
+	require.True(t, style(0, 0, "-n/a").Equal(NewStyle()))
+}
+
+func TestTable_PrerenderedBlock(t *testing.T) {
+	panel := Panel{Title: "KV", Body: "a: 1\nb: 2"}.Render(0)
+	table := Table{Headers: []string{"Host", "Stats"}, Rows: [][]string{{"api", panel}}}
+
+	lines := strings.Split(table.Render(0), "\n")
+	require.Len(t, lines, 8)
+	for _, line := range lines {
+		require.Equal(t, Width(panel)+11, measure.Width(line))
+	}
+	for i, line := range strings.Split(panel, "\n") {
+		require.Contains(t, lines[3+i], line)
+	}
+}
+
+func TestTable_Blocks(t *testing.T) {
+	inner := Table{Rows: [][]string{{"cpu", "12 percent"}, {"mem", "3 gigabytes"}}, Border: NormalBorder()}
+	table := Table{
+		Headers: []string{"Host", "Stats"},
+		Rows:    [][]string{{"api"}, {"db", "-"}},
+		Blocks:  []CellBlock{{Row: 0, Col: 1, Content: inner}},
+	}
+
+	natural := table.Render(0)
+	require.Equal(t, Table{Headers: table.Headers, Rows: [][]string{{"api", inner.Render(0)}, {"db", "-"}}}.Render(0), natural)
+
+	narrow := strings.Split(table.Render(Width(natural)-4), "\n")
+	for i, line := range strings.Split(inner.Render(Width(inner.Render(0))-4), "\n") {
+		require.Contains(t, narrow[3+i], line, "the block is drawn again at the column width")
+	}
+
+	require.Equal(t, 8+inner.MinWidth(), table.MinWidth(), "Host shrinks to one cell, Stats to the block's MinWidth")
+	require.Equal(t, table.MinWidth(), Width(table.Render(1)))
+}
@@ -0,0 +1,96 @@
+package tuistyles
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ColumnOverflow selects what a table does with cells wider than their
+// column
+type ColumnOverflow int
+
+const (
+	// ColumnTruncate cuts the cell to the column width, ending it with "…"
+	// (the default)
+	ColumnTruncate ColumnOverflow = iota
+	// ColumnWrap word-wraps the cell onto more lines, making its row taller
+	ColumnWrap
+)
+
+// String returns human-readable column overflow name
+func (o ColumnOverflow) String() string {
+	switch o {
+	case ColumnTruncate:
+		return "Truncate"
+	case ColumnWrap:
+		return "Wrap"
+	default:
+		return "Unknown"
+	}
+}
+
+// MarshalText encodes the overflow as its lowercase name ("truncate", "wrap").
+func (o ColumnOverflow) MarshalText() ([]byte, error) {
+	if o < ColumnTruncate || o > ColumnWrap {
+		return nil, fmt.Errorf("invalid column overflow: %d", int(o))
+	}
+	return []byte(strings.ToLower(o.String())), nil
+}
+
+// UnmarshalText decodes a column overflow name (case-insensitive).
+func (o *ColumnOverflow) UnmarshalText(text []byte) error {
+	name := strings.ToLower(string(text))
+	for candidate := ColumnTruncate; candidate <= ColumnWrap; candidate++ {
+		if strings.ToLower(candidate.String()) == name {
+			*o = candidate
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid column overflow: %q", string(text))
+}
+
+// Column is how a table column gives up width when the table is wider
+// than it is allowed to be. The zero Column truncates and shrinks like
+// every other column.
+//
+// Example:
+//
+//	table.Columns = []Column{
+//	    {Shrink: -1},                      // ID: never shrinks
+//	    {Overflow: ColumnWrap, Shrink: 1}, // Description: wraps, shrinks first
+//	    {MinWidth: 8},                     // Path: truncated, but keeps 8 cells
+//	}
+type Column struct {
+	Overflow ColumnOverflow // What happens to cells wider than the column
+	Shrink   int            // Columns with a higher priority shrink first; a negative one never shrinks
+	MinWidth int            // Narrowest the column shrinks to, in content cells; 1 when 0 or less
+}
+
+// column returns the policy of column c
+func (t Table) column(c int) Column {
+	if c < len(t.Columns) {
+		return t.Columns[c]
+	}
+	return Column{}
+}
+
+// narrowest returns the narrowest the column may shrink to from its
+// natural width
+func (col Column) narrowest(natural int) int {
+	if col.Shrink < 0 {
+		return natural
+	}
+	return min(natural, max(col.MinWidth, 1))
+}
+
+// narrowest returns the narrowest column c may shrink to from its natural
+// width: as its policy allows, but no narrower than any block in it
+func (t Table) narrowest(c, natural int) int {
+	w := t.column(c).narrowest(natural)
+	for _, b := range t.Blocks {
+		if b.Col == c && b.Content != nil {
+			w = max(w, min(b.Content.MinWidth(), natural))
+		}
+	}
+	return w
+}
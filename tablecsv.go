@@ -0,0 +1,128 @@
+package tuistyles
+
+import (
+	"encoding/csv"
+	"io"
+	"strings"
+)
+
+// TableFromCSV reads comma-separated values into a Table: the first record
+// is the header and the rest are rows. Rows may have different lengths.
+// Numeric columns are aligned as TableFromSlice describes.
+//
+// Example:
+//
+//	table, err := TableFromCSV(os.Stdin)
+//	if err != nil {
+//	    return err
+//	}
+//	fmt.Println(table.Render(80))
+func TableFromCSV(r io.Reader) (Table, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+
+	records, err := cr.ReadAll()
+	if err != nil {
+		return Table{}, err
+	}
+	return TableFromSlice(records), nil
+}
+
+// TableFromTSV reads tab-separated values into a Table, like TableFromCSV.
+// Fields are split at tabs and records at line breaks; there is no quoting,
+// so quotes are kept as written.
+func TableFromTSV(r io.Reader) (Table, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return Table{}, err
+	}
+
+	text := strings.TrimSuffix(strings.ReplaceAll(string(data), "\r\n", "\n"), "\n")
+	if text == "" {
+		return Table{}, nil
+	}
+	lines := strings.Split(text, "\n")
+	records := make([][]string, len(lines))
+	for i, line := range lines {
+		records[i] = strings.Split(line, "\t")
+	}
+	return TableFromSlice(records), nil
+}
+
+// TableFromSlice makes a Table from records whose first entry is the
+// header. Columns whose values are all numbers (ignoring blanks) are
+// aligned: on the decimal point when any value has one, and to the right
+// otherwise. Thousands separators, a leading sign or currency symbol, and
+// a trailing percent sign are allowed.
+//
+// Example:
+//
+//	fmt.Println(TableFromSlice([][]string{
+//	    {"service", "p99 ms", "errors"},
+//	    {"api", "12.5", "3"},
+//	    {"db", "104.25", "0"},
+//	}).Render(0))
+func TableFromSlice(records [][]string) Table {
+	if len(records) == 0 {
+		return Table{}
+	}
+
+	t := Table{Headers: records[0], Rows: records[1:]}
+	cols := t.columnCount()
+	align := make([]Position, cols)
+	aligned := false
+	for c := range align {
+		align[c] = sniffAlign(t.Rows, c)
+		aligned = aligned || align[c] != Left
+	}
+	if aligned {
+		t.Align = align
+	}
+	return t
+}
+
+// sniffAlign returns the alignment for column c of rows: Decimal or Right
+// for numeric columns, Left otherwise
+func sniffAlign(rows [][]string, c int) Position {
+	numbers, fractions := 0, false
+	for _, row := range rows {
+		if c >= len(row) || strings.TrimSpace(row[c]) == "" {
+			continue
+		}
+		if !isNumeric(row[c]) {
+			return Left
+		}
+		numbers++
+		fractions = fractions || strings.Contains(row[c], ".")
+	}
+	switch {
+	case numbers == 0:
+		return Left
+	case fractions:
+		return Decimal
+	default:
+		return Right
+	}
+}
+
+// isNumeric reports whether s reads as a number, allowing a sign,
+// currency symbol, thousands separators, and a percent sign
+func isNumeric(s string) bool {
+	s = strings.TrimSpace(s)
+	s = strings.TrimLeft(s, "+-")
+	s = strings.TrimLeft(s, "$€£¥")
+	s = strings.TrimSuffix(s, "%")
+	s = strings.ReplaceAll(s, ",", "")
+	digits, points := 0, 0
+	for _, r := range s {
+		switch {
+		case r >= '0' && r <= '9':
+			digits++
+		case r == '.':
+			points++
+		default:
+			return false
+		}
+	}
+	return digits > 0 && points <= 1
+}
@@ -0,0 +1,64 @@
+package tuistyles
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+func TestTableFromCSV(t *testing.T) {
+	input := "service,p99 ms,errors,owner\n" +
+		"api,12.5,\"1,204\",core\n" +
+		"db,104.25,0\n"
+
+	table, err := TableFromCSV(strings.NewReader(input))
+	require.NoError(t, err)
+	require.Equal(t, []string{"service", "p99 ms", "errors", "owner"}, table.Headers)
+	require.Equal(t, [][]string{{"api", "12.5", "1,204", "core"}, {"db", "104.25", "0"}}, table.Rows)
+	require.Equal(t, []Position{Left, Decimal, Right, Left}, table.Align)
+
+	_, err = TableFromCSV(strings.NewReader("a,\"b\n"))
+	require.Error(t, err)
+}
+
+func TestTableFromTSV(t *testing.T) {
+	table, err := TableFromTSV(strings.NewReader("name\tsize\r\n\"quoted\" file\t10\r\nempty\n"))
+	require.NoError(t, err)
+	require.Equal(t, []string{"name", "size"}, table.Headers)
+	require.Equal(t, [][]string{{"\"quoted\" file", "10"}, {"empty"}}, table.Rows)
+	require.Equal(t, []Position{Left, Right}, table.Align)
+
+	table, err = TableFromTSV(strings.NewReader(""))
+	require.NoError(t, err)
+	require.Equal(t, Table{}, table)
+}
+
+func TestTableFromSlice(t *testing.T) {
+	table := TableFromSlice([][]string{
+		{"name", "share", "price", "note"},
+		{"a", "50%", "$1.50", "x"},
+		{"b", "", "-$20", "12"},
+	})
+	require.Equal(t, []Position{Left, Right, Decimal, Left}, table.Align)
+	require.Equal(t, "╭──────┬───────┬─────────┬──────╮\n"+
+		"│ name │ share │   price │ note │\n"+
+		"├──────┼───────┼─────────┼──────┤\n"+
+		"│ a    │   50% │   $1.50 │ x    │\n"+
+		"│ b    │       │ -$20    │ 12   │\n"+
+		"╰──────┴───────┴─────────┴──────╯", measure.StripANSI(table.Render(0)))
+
+	require.Nil(t, TableFromSlice([][]string{{"name"}, {"api"}}).Align, "no numeric columns")
+	require.Equal(t, Table{}, TableFromSlice(nil))
+}
+
+func TestIsNumeric(t *testing.T) {
+	for _, s := range []string{"0", "12.5", "-3", "+4", "1,204", "$9.99", "-€3", "75%", " 42 "} {
+		require.True(t, isNumeric(s), s)
+	}
+	for _, s := range []string{"", "abc", "1.2.3", "inf", "NaN", "1e5", "0x10", "$", "%", "12 ms"} {
+		require.False(t, isNumeric(s), s)
+	}
+}
@@ -0,0 +1,223 @@
+package tuistyles
+
+import (
+	"strings"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+// TableTree arranges a table's rows in a tree, like a process list grouped
+// by parent: each row has a depth, rows follow the row they belong under,
+// and one column draws branch lines from parents to their children:
+//
+//	╭──────────────┬─────╮
+//	│ Process      │ CPU │
+//	├──────────────┼─────┤
+//	│ systemd      │ 0.1 │
+//	│ ├─ sshd      │ 0.0 │
+//	│ │  └─ bash   │ 0.2 │
+//	│ └─ nginx     │ 1.4 │
+//	│    └─ worker │ 3.5 │
+//	╰──────────────┴─────╯
+//
+// When Collapsed is set, rows with children show whether they are
+// expanded (▾) or collapsed (▸), and the rows under a collapsed one are
+// hidden. RenderPage then pages through the rows still shown (see
+// VisibleRows).
+//
+// Example:
+//
+//	table := Table{
+//	    Headers: []string{"Process", "CPU"},
+//	    Rows:    [][]string{{"systemd", "0.1"}, {"sshd", "0.0"}, {"bash", "0.2"}},
+//	    Tree:    TableTree{Levels: []int{0, 1, 2}},
+//	}
+type TableTree struct {
+	Levels    []int  // Per data row: depth in the tree, 0 for top-level rows
+	Collapsed []bool // Per data row: whether the rows under it are hidden; nil shows no markers
+	Column    int    // Column that draws the branches; the first by default
+}
+
+// Tree branch glyphs: the connector before a row, and the guide line
+// continuing down past it
+const (
+	treeBranch     = "├─ "
+	treeLastBranch = "└─ "
+	treeGuide      = "│  "
+	treeBlank      = "   "
+	treeExpanded   = "▾ "
+	treeCollapsed  = "▸ "
+	treeLeaf       = "  " // Lines up rows without children with their siblings that have some
+)
+
+// VisibleRows returns the indexes in Rows of the data rows the table
+// shows, in order: every row, or for a tree, those not under a collapsed
+// row. Use it to map a cursor on the rendered table back to the data.
+func (t Table) VisibleRows() []int {
+	rows := make([]int, 0, len(t.Rows))
+	levels := t.Tree.levels(len(t.Rows))
+	hideBelow := -1
+	for i, level := range levels {
+		if hideBelow >= 0 && level > hideBelow {
+			continue
+		}
+		hideBelow = -1
+		if i < len(t.Tree.Collapsed) && t.Tree.Collapsed[i] {
+			hideBelow = level
+		}
+		rows = append(rows, i)
+	}
+	return rows
+}
+
+// withTree returns the table with its tree drawn: hidden rows dropped and
+// the tree column's cells prefixed with branches. Callbacks, spans, and
+// blocks still see the indexes of Rows. Tables without a tree are returned
+// as they are.
+func (t Table) withTree() Table {
+	if t.Tree.Levels == nil {
+		return t
+	}
+
+	visible := t.VisibleRows()
+	all := t.Tree.levels(len(t.Rows))
+	levels := make([]int, len(visible))
+	for v, i := range visible {
+		levels[v] = all[i]
+	}
+	prefixes := treePrefixes(levels)
+	if t.Tree.Collapsed != nil {
+		for v, i := range visible {
+			parent := i+1 < len(all) && all[i+1] > all[i]
+			switch {
+			case parent && i < len(t.Tree.Collapsed) && t.Tree.Collapsed[i]:
+				prefixes[v] += treeCollapsed
+			case parent:
+				prefixes[v] += treeExpanded
+			default:
+				prefixes[v] += treeLeaf
+			}
+		}
+	}
+
+	shown := make(map[int]int, len(visible)) // Row index to visible index
+	rows := make([][]string, len(visible))
+	for v, i := range visible {
+		rows[v] = t.Rows[i]
+		shown[i] = v
+	}
+
+	out := t
+	out.Rows = rows
+	out.Tree = TableTree{}
+	out.Spans = nil
+	for _, span := range t.Spans {
+		if v, ok := shown[span.Row]; ok {
+			span.Row = v
+			out.Spans = append(out.Spans, span)
+		}
+	}
+	out.Blocks = nil
+	for _, block := range t.Blocks {
+		if v, ok := shown[block.Row]; ok {
+			block.Row = v
+			if block.Col == t.Tree.Column && block.Content != nil && prefixes[v] != "" {
+				block.Content = treeBlock{prefix: prefixes[v], content: block.Content}
+			}
+			out.Blocks = append(out.Blocks, block)
+		}
+	}
+	if t.RowStyle != nil {
+		out.RowStyle = func(v int, line string) Style { return t.RowStyle(visible[v], line) }
+	}
+	if t.StyleFunc != nil {
+		out.StyleFunc = func(v, c int, value string) Style { return t.StyleFunc(visible[v], c, value) }
+	}
+	out.FormatFunc = func(v, c int, value string) string {
+		if t.FormatFunc != nil {
+			value = t.FormatFunc(visible[v], c, value)
+		}
+		if c == t.Tree.Column {
+			value = prefixes[v] + value
+		}
+		return value
+	}
+	return out
+}
+
+// levels returns the depth of each of n rows, starting at 0 and going at
+// most one deeper than the row before
+func (tt TableTree) levels(n int) []int {
+	levels := make([]int, n)
+	prev := -1
+	for i := range levels {
+		if i < len(tt.Levels) {
+			levels[i] = min(max(tt.Levels[i], 0), prev+1)
+		}
+		prev = levels[i]
+	}
+	return levels
+}
+
+// treePrefixes returns the branch lines before each row of a tree with the
+// given depths. Top-level rows have none; deeper rows have a guide for
+// each ancestor below the top that has rows still to come, then their
+// connector.
+func treePrefixes(levels []int) []string {
+	prefixes := make([]string, len(levels))
+	var more []bool // more[d]: rows at depth d follow before a shallower one
+	for i := len(levels) - 1; i >= 0; i-- {
+		level := levels[i]
+		for len(more) <= level {
+			more = append(more, false)
+		}
+		if level > 0 {
+			var b strings.Builder
+			for d := 1; d < level; d++ {
+				if more[d] {
+					b.WriteString(treeGuide)
+				} else {
+					b.WriteString(treeBlank)
+				}
+			}
+			if more[level] {
+				b.WriteString(treeBranch)
+			} else {
+				b.WriteString(treeLastBranch)
+			}
+			prefixes[i] = b.String()
+		}
+		more[level] = true
+		clear(more[level+1:])
+	}
+	return prefixes
+}
+
+// treeBlock draws a CellBlock in the tree column after its row's branches,
+// with the block's later lines indented to match
+type treeBlock struct {
+	prefix  string
+	content Component
+}
+
+// Render draws the content in what is left of width after the prefix.
+func (b treeBlock) Render(width int) string {
+	indent := measure.Width(b.prefix)
+	if width > 0 {
+		width = max(width-indent, 1)
+	}
+	lines := strings.Split(b.content.Render(width), "\n")
+	for i := range lines {
+		if i == 0 {
+			lines[i] = b.prefix + lines[i]
+		} else {
+			lines[i] = strings.Repeat(" ", indent) + lines[i]
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// MinWidth returns the prefix's width plus the content's.
+func (b treeBlock) MinWidth() int {
+	return measure.Width(b.prefix) + b.content.MinWidth()
+}
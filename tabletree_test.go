@@ -0,0 +1,103 @@
+package tuistyles
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+func processTable() Table {
+	return Table{
+		Headers: []string{"Process", "CPU"},
+		Rows: [][]string{
+			{"systemd", "0.1"}, {"sshd", "0.0"}, {"bash", "0.2"},
+			{"nginx", "1.4"}, {"worker", "3.5"}, {"cron", "0.0"},
+		},
+		Tree: TableTree{Levels: []int{0, 1, 2, 1, 2, 0}},
+	}
+}
+
+func TestTable_Tree(t *testing.T) {
+	want := "╭──────────────┬─────╮\n" +
+		"│ Process      │ CPU │\n" +
+		"├──────────────┼─────┤\n" +
+		"│ systemd      │ 0.1 │\n" +
+		"│ ├─ sshd      │ 0.0 │\n" +
+		"│ │  └─ bash   │ 0.2 │\n" +
+		"│ └─ nginx     │ 1.4 │\n" +
+		"│    └─ worker │ 3.5 │\n" +
+		"│ cron         │ 0.0 │\n" +
+		"╰──────────────┴─────╯"
+	require.Equal(t, want, measure.StripANSI(processTable().Render(0)))
+
+	table := processTable()
+	table.Tree.Column = 1
+	table.Tree.Levels = []int{0, 5, -1}
+	lines := strings.Split(measure.StripANSI(table.Render(0)), "\n")
+	require.Equal(t, "│ sshd    │ └─ 0.0 │", lines[4], "levels deeper than the row before are clamped")
+}
+
+func TestTable_TreeCollapsed(t *testing.T) {
+	table := processTable()
+	table.Tree.Collapsed = []bool{false, true}
+	var styled []int
+	table.StyleFunc = func(row, col int, _ string) Style {
+		if col == 0 {
+			styled = append(styled, row)
+		}
+		return NewStyle()
+	}
+
+	want := "╭────────────────┬─────╮\n" +
+		"│ Process        │ CPU │\n" +
+		"├────────────────┼─────┤\n" +
+		"│ ▾ systemd      │ 0.1 │\n" +
+		"│ ├─ ▸ sshd      │ 0.0 │\n" +
+		"│ └─ ▾ nginx     │ 1.4 │\n" +
+		"│    └─   worker │ 3.5 │\n" +
+		"│   cron         │ 0.0 │\n" +
+		"╰────────────────┴─────╯"
+	require.Equal(t, want, measure.StripANSI(table.Render(0)))
+	require.Equal(t, []int{0, 1, 3, 4, 5}, table.VisibleRows())
+	require.Equal(t, []int{0, 1, 3, 4, 5}, styled, "callbacks see indexes into Rows")
+
+	page := measure.StripANSI(table.RenderPage(0, 2, 2))
+	require.Contains(t, page, "│ └─ ▾ nginx     │ 1.4 │\n│    └─   worker │ 3.5 │")
+	require.True(t, strings.HasSuffix(page, "rows 3–4 of 5"))
+}
+
+func TestTable_TreeCollapsedNested(t *testing.T) {
+	menu := Menu{Items: []MenuItem{{Label: "restart"}, {Label: "stop"}}}
+	table := Table{
+		Headers: []string{"Process"},
+		Rows:    [][]string{{"systemd"}, {"sshd"}, {"bash"}, {"cron"}, {"nginx"}, {"worker"}},
+		Tree: TableTree{
+			Levels:    []int{0, 1, 2, 1, 1, 2},
+			Collapsed: []bool{false, false, false, false, true},
+		},
+		Blocks: []CellBlock{{Row: 3, Col: 0, Content: menu}},
+	}
+
+	want := "╭────────────────╮\n" +
+		"│ Process        │\n" +
+		"├────────────────┤\n" +
+		"│ ▾ systemd      │\n" +
+		"│ ├─ ▾ sshd      │\n" +
+		"│ │  └─   bash   │\n" +
+		"│ ├─   ❯ restart │\n" +
+		"│        stop    │\n" +
+		"│ └─ ▸ nginx     │\n" +
+		"╰────────────────╯"
+	require.Equal(t, want, measure.StripANSI(table.Render(0)))
+
+	narrow := measure.StripANSI(table.Render(table.MinWidth()))
+	require.Contains(t, narrow, "│ ├─   ❯ … │", "the block keeps its branches at MinWidth")
+}
+
+func TestTable_VisibleRowsWithoutTree(t *testing.T) {
+	table := Table{Rows: [][]string{{"a"}, {"b"}}}
+	require.Equal(t, []int{0, 1}, table.VisibleRows())
+}
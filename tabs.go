@@ -0,0 +1,228 @@
+package tuistyles
+
+import (
+	"strings"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+// Tabs renders a row of tab headers above a content box, with the active
+// tab open into the box:
+//
+//	╭──────╮╭──────╮
+//	│ Logs ││ Jobs │
+//	│      └┴──────┴──────────╮
+//	│ content                 │
+//	╰─────────────────────────╯
+//
+// When the headers do not fit the width, the row scrolls to keep the active
+// tab visible and shows ‹ and › where tabs are hidden.
+//
+// Example:
+//
+//	tabs := Tabs{Items: []string{"Logs", "Jobs", "Config"}, Active: 1, Content: jobs}
+//	fmt.Println(tabs.Render(60))
+type Tabs struct {
+	Items   []string
+	Active  int    // Index of the open tab; clamped to Items
+	Content string // Shown in the box below; may be multi-line and styled
+	Border  Border // RoundedBorder by default
+	Theme   Theme
+
+	ActiveStyle   Style // Title of the open tab; bold primary by default
+	InactiveStyle Style // Titles of the other tabs; muted by default
+}
+
+// Scroll indicators shown where tabs are hidden
+const (
+	tabsMoreLeft  = "‹"
+	tabsMoreRight = "›"
+)
+
+// Render draws the tabs and content box width cells wide. A width of 0 fits
+// the headers and content.
+func (t Tabs) Render(width int) string {
+	if len(t.Items) == 0 {
+		return ""
+	}
+
+	theme := t.Theme.WithDefaults()
+	border := t.Border
+	if border == (Border{}) {
+		border = RoundedBorder()
+	}
+	joints := borderJointsFor(border)
+	borderStyle := NewStyle().Foreground(theme.Border)
+	activeStyle := orDefault(t.ActiveStyle, NewStyle().Bold(true).Foreground(theme.Primary))
+	inactiveStyle := orDefault(t.InactiveStyle, NewStyle().Foreground(theme.Muted))
+	active := min(max(t.Active, 0), len(t.Items)-1)
+
+	titles := append([]string(nil), t.Items...)
+	tabWidth := func(i int) int { return measure.Width(titles[i]) + 4 }
+
+	if width <= 0 {
+		for i := range titles {
+			width += tabWidth(i)
+		}
+		width = max(width, measure.MaxWidth(t.Content)+4)
+	}
+	width = max(width, 5)
+
+	// A lone tab wider than the row is truncated, leaving room for the
+	// scroll indicators the other tabs need; in a row too narrow for even
+	// those, the active tab is shown alone
+	indicators := 0
+	if active > 0 {
+		indicators++
+	}
+	if active < len(titles)-1 {
+		indicators++
+	}
+	if tabWidth(active)+indicators > width {
+		titles[active] = measure.Truncate(titles[active], max(width-4-indicators, 1), "…")
+	}
+	start, end := active, active+1
+	roomy := tabWidth(active)+indicators <= width
+	if roomy {
+		start, end = tabsWindow(titles, active, width, tabWidth)
+	}
+	moreLeft, moreRight := roomy && start > 0, roomy && end < len(titles)
+
+	var top, mid strings.Builder
+	col := 0
+	if moreLeft {
+		top.WriteString(" ")
+		mid.WriteString(borderStyle.Render(tabsMoreLeft))
+		col++
+	}
+
+	bottom := []string(nil)
+	for range width {
+		bottom = append(bottom, border.Top)
+	}
+	bottom[0], bottom[width-1] = border.TopLeft, border.TopRight
+
+	for i := start; i < end; i++ {
+		w := tabWidth(i)
+		inner := w - 2
+		titleStyle := inactiveStyle
+		if i == active {
+			titleStyle = activeStyle
+		}
+
+		top.WriteString(borderStyle.Render(border.TopLeft + strings.Repeat(border.Top, inner) + border.TopRight))
+		mid.WriteString(borderStyle.Render(border.Left) + " " + titleStyle.Render(titles[i]) + " " + borderStyle.Render(border.Right))
+
+		left, right := col, col+w-1
+		if i == active {
+			for x := left + 1; x < right; x++ {
+				bottom[x] = " "
+			}
+		}
+		bottom[left] = joints.pick(i == active, left == 0, true)
+		bottom[right] = joints.pick(i == active, right == width-1, false)
+		col += w
+	}
+
+	pad := width - col
+	if moreRight {
+		pad--
+	}
+	top.WriteString(strings.Repeat(" ", pad))
+	mid.WriteString(strings.Repeat(" ", pad))
+	if moreRight {
+		top.WriteString(" ")
+		mid.WriteString(borderStyle.Render(tabsMoreRight))
+	}
+
+	lines := []string{top.String(), mid.String(), borderStyle.Render(strings.Join(bottom, ""))}
+
+	// Content box body and bottom edge
+	inner := width - 4
+	for _, line := range strings.Split(t.Content, "\n") {
+		if measure.Width(line) > inner {
+			line = measure.TruncateStyled(line, inner)
+		}
+		line += strings.Repeat(" ", inner-measure.Width(line))
+		lines = append(lines, borderStyle.Render(border.Left)+" "+line+" "+borderStyle.Render(border.Right))
+	}
+	lines = append(lines, borderStyle.Render(border.BottomLeft+strings.Repeat(border.Bottom, width-2)+border.BottomRight))
+
+	return strings.Join(lines, "\n")
+}
+
+// tabsWindow returns the widest run of tabs [start, end) around active that
+// fits width, leaving a column for each scroll indicator it needs
+func tabsWindow(titles []string, active, width int, tabWidth func(int) int) (int, int) {
+	fits := func(start, end int) bool {
+		used := 0
+		for i := start; i < end; i++ {
+			used += tabWidth(i)
+		}
+		if start > 0 {
+			used++
+		}
+		if end < len(titles) {
+			used++
+		}
+		return used <= width
+	}
+
+	start, end := active, active+1
+	for grew := true; grew; {
+		grew = false
+		if end < len(titles) && fits(start, end+1) {
+			end++
+			grew = true
+		}
+		if start > 0 && fits(start-1, end) {
+			start--
+			grew = true
+		}
+	}
+	return start, end
+}
+
+// borderJoints are the junction glyphs where tab edges meet the content box
+type borderJoints struct {
+	up           string // ┴ tab edge resting on the box top
+	leftEdge     string // ├ first tab on the box's left edge
+	rightEdge    string // ┤ last tab on the box's right edge
+	openLeft     string // ┘ left edge of the open tab
+	openRight    string // └ right edge of the open tab
+	openStraight string // │ open tab flush with a box edge
+}
+
+// pick returns the glyph for a tab edge: open reports the active tab, flush
+// that the edge is on the box's outer edge, and left which side of the tab
+func (j borderJoints) pick(open, flush, left bool) string {
+	switch {
+	case open && flush:
+		return j.openStraight
+	case open && left:
+		return j.openLeft
+	case open:
+		return j.openRight
+	case flush && left:
+		return j.leftEdge
+	case flush:
+		return j.rightEdge
+	default:
+		return j.up
+	}
+}
+
+// borderJoints returns junction glyphs matching the border's line weight.
+// Borders without box-drawing junctions reuse their own characters.
+func borderJointsFor(b Border) borderJoints {
+	switch b.Left + b.Top {
+	case "│─":
+		return borderJoints{"┴", "├", "┤", "┘", "└", "│"}
+	case "┃━":
+		return borderJoints{"┻", "┣", "┫", "┛", "┗", "┃"}
+	case "║═":
+		return borderJoints{"╩", "╠", "╣", "╝", "╚", "║"}
+	default:
+		return borderJoints{b.Bottom, b.Left, b.Right, b.BottomRight, b.BottomLeft, b.Left}
+	}
+}
@@ -0,0 +1,125 @@
+package tuistyles
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+func TestTabs_Render(t *testing.T) {
+	tests := []struct {
+		name   string
+		active int
+		want   []string
+	}{
+		{"first active", 0, []string{
+			"╭──────╮╭──────╮  ",
+			"│ Logs ││ Jobs │  ",
+			"│      └┴──────┴─╮",
+			"│ hi             │",
+			"╰────────────────╯",
+		}},
+		{"second active", 1, []string{
+			"╭──────╮╭──────╮  ",
+			"│ Logs ││ Jobs │  ",
+			"├──────┴┘      └─╮",
+			"│ hi             │",
+			"╰────────────────╯",
+		}},
+		{"clamped", 7, []string{
+			"╭──────╮╭──────╮  ",
+			"│ Logs ││ Jobs │  ",
+			"├──────┴┘      └─╮",
+			"│ hi             │",
+			"╰────────────────╯",
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tabs := Tabs{Items: []string{"Logs", "Jobs"}, Active: tt.active, Content: "hi"}
+			require.Equal(t, strings.Join(tt.want, "\n"), measure.StripANSI(tabs.Render(18)))
+		})
+	}
+}
+
+func TestTabs_FlushWithRightEdge(t *testing.T) {
+	inactive := measure.StripANSI(Tabs{Items: []string{"A", "B"}, Content: "x"}.Render(10))
+	require.Equal(t, "│   └┴───┤", strings.Split(inactive, "\n")[2])
+
+	active := measure.StripANSI(Tabs{Items: []string{"A", "B"}, Active: 1, Content: "x"}.Render(10))
+	require.Equal(t, "├───┴┘   │", strings.Split(active, "\n")[2])
+}
+
+func TestTabs_NaturalWidth(t *testing.T) {
+	got := Tabs{Items: []string{"A", "B"}, Content: "a longer body"}.Render(0)
+
+	require.Equal(t, []int{17, 17, 17, 17, 17}, measure.WidthPerLine(got), "content wider than the headers")
+}
+
+func TestTabs_Overflow(t *testing.T) {
+	items := []string{"One", "Two", "Three", "Four", "Five"}
+	got := measure.StripANSI(Tabs{Items: items, Active: 2}.Render(20))
+	lines := strings.Split(got, "\n")
+
+	require.Equal(t, "‹│ Three ││ Four │ ›", lines[1])
+	require.Equal(t, "╭┘       └┴──────┴─╮", lines[2])
+	for _, line := range lines {
+		require.Equal(t, 20, measure.Width(line))
+	}
+
+	first := strings.Split(measure.StripANSI(Tabs{Items: items}.Render(20)), "\n")
+	require.Equal(t, "│ One ││ Two │     ›", first[1], "no left indicator at the start")
+}
+
+func TestTabs_TruncatesWideTab(t *testing.T) {
+	got := measure.StripANSI(Tabs{Items: []string{"Extremely long title"}}.Render(12))
+
+	require.Equal(t, "│ Extreme… │", strings.Split(got, "\n")[1])
+	require.Equal(t, 12, measure.MaxWidth(got))
+}
+
+func TestTabs_NarrowWithLaterTabActive(t *testing.T) {
+	got := measure.StripANSI(Tabs{Items: []string{"Home", "Settings"}, Active: 1}.Render(10))
+	require.Equal(t, "‹│ Sett… │", strings.Split(got, "\n")[1], "the indicator's cell is reserved")
+
+	for _, items := range [][]string{{"a", "b"}, {"Home", "Settings"}, {"One", "Two", "Three"}} {
+		for active := range items {
+			for width := 5; width <= 24; width++ {
+				got := Tabs{Items: items, Active: active}.Render(width)
+				for _, line := range strings.Split(got, "\n") {
+					require.Equal(t, width, measure.Width(line), "items %v, active %d, width %d", items, active, width)
+				}
+			}
+		}
+	}
+}
+
+func TestTabs_ContentTruncation(t *testing.T) {
+	got := Tabs{Items: []string{"A"}, Content: NewStyle().Bold(true).Render("far too wide for the box")}.Render(12)
+	lines := strings.Split(got, "\n")
+
+	require.Equal(t, 12, measure.Width(lines[3]))
+	require.Contains(t, lines[3], "\x1b[0m", "styled content is closed after truncation")
+}
+
+func TestTabs_Styles(t *testing.T) {
+	tabs := Tabs{
+		Items:         []string{"A", "B"},
+		ActiveStyle:   NewStyle().Underline(true),
+		InactiveStyle: NewStyle().Italic(true),
+		Border:        DoubleBorder(),
+	}
+	got := tabs.Render(0)
+
+	require.Contains(t, got, "\x1b[4mA\x1b[0m")
+	require.Contains(t, got, "\x1b[3mB\x1b[0m")
+	require.Contains(t, measure.StripANSI(got), "║   ╚╩═══╣")
+}
+
+func TestTabs_Empty(t *testing.T) {
+	require.Empty(t, Tabs{}.Render(40))
+}
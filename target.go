@@ -0,0 +1,181 @@
+package tuistyles
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+// OutputTarget is the kind of output a Renderer writes for, so the same
+// view code can feed an interactive terminal, a terminal without color,
+// and a log file.
+type OutputTarget int
+
+const (
+	// TargetColor writes colors and text attributes (the default)
+	TargetColor OutputTarget = iota
+	// TargetMonochrome writes text attributes such as bold and underline
+	// but no colors
+	TargetMonochrome
+	// TargetPlain writes no escape sequences at all, keeping the layout:
+	// borders, padding, and alignment are still drawn
+	TargetPlain
+	// TargetASCII writes plain 7-bit ASCII text that keeps the colored
+	// output's structure: borders become +, -, and |, other characters are
+	// replaced as ToASCII describes, and every line is exactly as wide as
+	// under TargetColor, so golden files diff cleanly across targets
+	TargetASCII
+)
+
+// String returns human-readable output target name
+func (t OutputTarget) String() string {
+	switch t {
+	case TargetColor:
+		return "Color"
+	case TargetMonochrome:
+		return "Monochrome"
+	case TargetPlain:
+		return "Plain"
+	case TargetASCII:
+		return "ASCII"
+	default:
+		return "Unknown"
+	}
+}
+
+// MarshalText encodes the target as its lowercase name ("color", "monochrome", "plain", "ascii").
+func (t OutputTarget) MarshalText() ([]byte, error) {
+	if t < TargetColor || t > TargetASCII {
+		return nil, fmt.Errorf("invalid output target: %d", int(t))
+	}
+	return []byte(strings.ToLower(t.String())), nil
+}
+
+// UnmarshalText decodes an output target name (case-insensitive).
+func (t *OutputTarget) UnmarshalText(text []byte) error {
+	name := strings.ToLower(string(text))
+	for candidate := TargetColor; candidate <= TargetASCII; candidate++ {
+		if strings.ToLower(candidate.String()) == name {
+			*t = candidate
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid output target: %q", string(text))
+}
+
+// DetectOutputTarget probes the environment for the kind of output to
+// write.
+//
+// Heuristics: TERM=dumb means plain text; a non-empty NO_COLOR (see
+// no-color.org) means monochrome; anything else gets color.
+func DetectOutputTarget() OutputTarget {
+	return detectOutputTarget(os.Getenv)
+}
+
+// detectOutputTarget implements DetectOutputTarget with an injectable environment
+func detectOutputTarget(getenv func(string) string) OutputTarget {
+	switch {
+	case getenv("TERM") == "dumb":
+		return TargetPlain
+	case getenv("NO_COLOR") != "":
+		return TargetMonochrome
+	default:
+		return TargetColor
+	}
+}
+
+// Target overrides the detected output target, for example TargetPlain
+// when writing to a log file, or TargetASCII for CI output and golden
+// files.
+//
+// Returns a new Renderer, leaving the original unchanged.
+//
+// Example:
+//
+//	r := NewRenderer()
+//	if !isatty(os.Stdout) {
+//		r = r.Target(TargetPlain)
+//	}
+func (r Renderer) Target(t OutputTarget) Renderer {
+	r2 := r
+	r2.target = t
+	return r2
+}
+
+// OutputTarget returns the renderer's output target.
+func (r Renderer) OutputTarget() OutputTarget {
+	return r.target
+}
+
+// forTarget removes what the target cannot show from rendered output,
+// including escape sequences already present in the rendered text
+func (r Renderer) forTarget(rendered string) string {
+	switch r.target {
+	case TargetPlain, TargetASCII:
+		return measure.StripANSI(rendered)
+	case TargetMonochrome:
+		return stripColors(rendered)
+	default:
+		return rendered
+	}
+}
+
+// stripColors removes color parameters from the SGR sequences in str,
+// dropping sequences left with nothing to set
+func stripColors(str string) string {
+	if !strings.Contains(str, "\x1b[") {
+		return str
+	}
+
+	var b strings.Builder
+	b.Grow(len(str))
+	for i := 0; i < len(str); {
+		_, end, ok := sgrAt(str, i)
+		if !ok {
+			b.WriteByte(str[i])
+			i++
+			continue
+		}
+		body := str[i+2 : end-1]
+		if body == "" {
+			b.WriteString(str[i:end])
+		} else if kept := withoutColorParams(strings.Split(body, ";")); len(kept) > 0 {
+			b.WriteString("\x1b[" + strings.Join(kept, ";") + "m")
+		}
+		i = end
+	}
+	return b.String()
+}
+
+// withoutColorParams returns the fields of an SGR sequence that do not set
+// a foreground, background, or underline color
+func withoutColorParams(fields []string) []string {
+	var kept []string
+	for k := 0; k < len(fields); k++ {
+		code, _, colon := strings.Cut(fields[k], ":")
+		n, err := strconv.Atoi(code)
+		if err != nil {
+			n = 0 // an empty parameter means 0
+		}
+		switch {
+		case n >= 30 && n <= 37, n == 39, n >= 90 && n <= 97,
+			n >= 40 && n <= 47, n == 49, n >= 100 && n <= 107, n == 59:
+		case n == 38 || n == 48 || n == 58:
+			if !colon {
+				// Semicolon form: the color's arguments follow as fields
+				args := make([]int, 0, len(fields)-k-1)
+				for _, f := range fields[k+1:] {
+					a, _ := strconv.Atoi(f) //nolint:errcheck // empty means 0
+					args = append(args, a)
+				}
+				k += extendedColorArgs(args)
+			}
+		default:
+			kept = append(kept, fields[k])
+		}
+	}
+	return kept
+}
@@ -0,0 +1,111 @@
+package tuistyles
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+func TestDetectOutputTarget(t *testing.T) {
+	tests := []struct {
+		name string
+		env  map[string]string
+		want OutputTarget
+	}{
+		{"nothing set", nil, TargetColor},
+		{"NO_COLOR", map[string]string{"NO_COLOR": "1", "TERM": "xterm"}, TargetMonochrome},
+		{"empty NO_COLOR", map[string]string{"NO_COLOR": ""}, TargetColor},
+		{"dumb terminal", map[string]string{"TERM": "dumb", "NO_COLOR": "1"}, TargetPlain},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, detectOutputTarget(func(k string) string { return tt.env[k] }))
+		})
+	}
+}
+
+func TestOutputTarget_Text(t *testing.T) {
+	for _, target := range []OutputTarget{TargetColor, TargetMonochrome, TargetPlain, TargetASCII} {
+		text, err := target.MarshalText()
+		require.NoError(t, err)
+
+		var decoded OutputTarget
+		require.NoError(t, decoded.UnmarshalText(text))
+		require.Equal(t, target, decoded)
+	}
+
+	_, err := OutputTarget(9).MarshalText()
+	require.Error(t, err)
+
+	var target OutputTarget
+	require.Error(t, target.UnmarshalText([]byte("print")))
+}
+
+func TestRenderer_Target(t *testing.T) {
+	style := NewStyle().Bold(true).Foreground(Color("#ff0000")).Background(Color("blue")).
+		Border(NormalBorder()).BorderForeground(Color("green")).Padding(0, 1)
+	text := "ok " + NewStyle().Underline(true).Foreground(Color("red")).Render("!")
+	color := Renderer{}.Render(style, text)
+
+	t.Run("color", func(t *testing.T) {
+		require.Equal(t, color, Renderer{}.Target(TargetColor).Render(style, text))
+	})
+
+	t.Run("monochrome", func(t *testing.T) {
+		got := Renderer{}.Target(TargetMonochrome).Render(style, text)
+		require.Equal(t, measure.StripANSI(color), measure.StripANSI(got))
+		require.Regexp(t, `\x1b\[([0-9]+;)*1[;m]`, got, "bold is kept")
+		require.Regexp(t, `\x1b\[([0-9]+;)*4[;m]`, got, "underline is kept")
+		require.NotRegexp(t, `\x1b\[[0-9;]*(3[0-9]|4[0-9]|9[0-7])m`, got)
+	})
+
+	t.Run("plain", func(t *testing.T) {
+		got := Renderer{}.Target(TargetPlain).Render(style, text)
+		require.Equal(t, "┌──────┐\n│ ok ! │\n└──────┘", got)
+	})
+
+	t.Run("ascii", func(t *testing.T) {
+		got := Renderer{}.Target(TargetASCII).Render(style, text)
+		require.Equal(t, "+------+\n| ok ! |\n+------+", got)
+	})
+}
+
+func TestRenderer_TargetASCII_KeepsWidths(t *testing.T) {
+	style := NewStyle().Border(RoundedBorder()).BorderForeground(Color("#ff8800")).
+		Padding(0, 1).Width(16).Align(Center)
+	text := "café ★\n日本 ✓\n" + NewStyle().Foreground(Color("red")).Render("• done…")
+
+	color := Renderer{}.Render(style, text)
+	got := Renderer{}.Target(TargetASCII).Render(style, text)
+	require.Equal(t, "+------------------+\n|      cafe *      |\n|      ???? v      |\n|     * done.      |\n+------------------+", got)
+	require.True(t, isASCII(got))
+
+	colorLines, asciiLines := strings.Split(color, "\n"), strings.Split(got, "\n")
+	require.Len(t, asciiLines, len(colorLines))
+	for i := range colorLines {
+		require.Equal(t, measure.Width(colorLines[i]), measure.Width(asciiLines[i]), "line %d", i)
+	}
+}
+
+func TestStripColors(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"plain", "plain"},
+		{"\x1b[31mred\x1b[0m", "red\x1b[0m"},
+		{"\x1b[1;31mbold\x1b[m", "\x1b[1mbold\x1b[m"},
+		{"\x1b[38;2;1;2;3;4mx", "\x1b[4mx"},
+		{"\x1b[48;5;200;3mx", "\x1b[3mx"},
+		{"\x1b[4:3;58:2::1:2:3mx", "\x1b[4:3mx"},
+		{"\x1b[39;49;22mx", "\x1b[22mx"},
+		{"\x1b]8;;http://x\x1b\\link", "\x1b]8;;http://x\x1b\\link"},
+	}
+
+	for _, tt := range tests {
+		require.Equal(t, tt.want, stripColors(tt.in), "%q", tt.in)
+	}
+}
@@ -0,0 +1,159 @@
+package term
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+// CompressSGR shrinks a rendered frame by dropping SGR sequences that do not
+// change the styling text is drawn with. Styled strings reset at the end of
+// every span and line, so a full-screen frame of rows sharing one style
+// repeats the same sequences over and over:
+//
+//	ESC[1;34mfoo ESC[0m ESC[1;34mbar ESC[0m  becomes  ESC[1;34mfoo bar ESC[0m
+//
+// Sequences are only written just before the text they style, and each
+// change is written as the shorter of the parameters that differ and a
+// reset followed by the full style. Styling carries across line breaks,
+// except for a background color, which is reset before the break because
+// terminals fill lines scrolled into view with the current background.
+// The frame ends in the same state as the original.
+//
+// Other escape sequences are kept, with the styling brought up to date
+// first since some (such as erasing a line) paint with the current
+// background. SGR parameters outside the colors and attributes a Screen
+// cell records, such as underline colors, are kept as written.
+//
+// Example:
+//
+//	fmt.Print(term.CompressSGR(renderDashboard()))
+func CompressSGR(frame string) string {
+	if !strings.Contains(frame, "\x1b[") {
+		return frame
+	}
+
+	var b strings.Builder
+	b.Grow(len(frame))
+
+	// written is the terminal's styling and want the styling the next text
+	// should have. known is false after a sequence the pen cannot model, and
+	// changed is true when want has been updated since the last write.
+	var written, want pen
+	known, changed := true, false
+	flush := func() {
+		if !changed || (known && want == written) {
+			changed = false
+			return
+		}
+		b.WriteString(sgrTransition(written, want, known))
+		written, known, changed = want, true, false
+	}
+
+	for row, line := range strings.Split(frame, "\n") {
+		if row > 0 {
+			if !known || written.bg != "" {
+				b.WriteString(sgrReset)
+				written, known, changed = pen{}, true, true
+			}
+			b.WriteByte('\n')
+		}
+		for _, seg := range measure.Segments(line) {
+			if !seg.Escape {
+				flush()
+				b.WriteString(seg.Text)
+				continue
+			}
+			if !strings.HasPrefix(seg.Text, "\x1b[") || !strings.HasSuffix(seg.Text, "m") {
+				flush()
+				b.WriteString(seg.Text)
+				continue
+			}
+			body := seg.Text[2 : len(seg.Text)-1]
+			if modeledSGR(body) {
+				want.apply(body)
+				changed = true
+				continue
+			}
+			flush()
+			b.WriteString(seg.Text)
+			want.apply(body)
+			written, known = want, false
+		}
+	}
+	flush()
+	return b.String()
+}
+
+// sgrTransition returns the shortest sequence that changes the styling from
+// from to to. When known is false, from is not reliable and the sequence
+// starts with a reset.
+func sgrTransition(from, to pen, known bool) string {
+	if to == (pen{}) {
+		return sgrReset
+	}
+	full := to.sgr()
+	if !known {
+		return full
+	}
+	if from == (pen{}) {
+		return "\x1b[" + strings.Join(to.params(), ";") + "m"
+	}
+	if from.attrs&^to.attrs != 0 {
+		// Turning attributes off one by one is rarely shorter, and 22
+		// turns off bold and faint together
+		return full
+	}
+
+	var params []string
+	for _, a := range attrCodes {
+		if to.attrs&a.attr != 0 && from.attrs&a.attr == 0 {
+			params = append(params, a.code)
+		}
+	}
+	if to.fg != from.fg {
+		params = append(params, colorOrDefault(to.fg, "39"))
+	}
+	if to.bg != from.bg {
+		params = append(params, colorOrDefault(to.bg, "49"))
+	}
+	if diff := "\x1b[" + strings.Join(params, ";") + "m"; len(diff) < len(full) {
+		return diff
+	}
+	return full
+}
+
+// colorOrDefault returns color, or the default-color parameter when color
+// is unset
+func colorOrDefault(color, def string) string {
+	if color == "" {
+		return def
+	}
+	return color
+}
+
+// modeledSGR reports whether every parameter of an SGR sequence body is one
+// a pen records, so the sequence can be dropped and rewritten from the pen
+func modeledSGR(body string) bool {
+	if body == "" {
+		return true
+	}
+	fields := strings.Split(body, ";")
+	for k := 0; k < len(fields); k++ {
+		n, err := strconv.Atoi(fields[k])
+		if err != nil {
+			return false
+		}
+		switch {
+		case n >= 0 && n <= 7 && n != 6, n == 9, n >= 22 && n <= 25, n == 27, n == 29,
+			n >= 30 && n <= 37, n == 39, n >= 40 && n <= 47, n == 49,
+			n >= 90 && n <= 97, n >= 100 && n <= 107:
+		case n == 38 || n == 48:
+			k += extendedColorArgs(fields[k+1:])
+		default:
+			return false
+		}
+	}
+	return true
+}
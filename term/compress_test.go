@@ -0,0 +1,46 @@
+package term
+
+import "testing"
+
+func TestCompressSGR(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"plain", "ab\ncd", "ab\ncd"},
+		{"adjacent spans", "\x1b[1;34mfoo\x1b[0m\x1b[1;34m bar\x1b[0m", "\x1b[1;34mfoo bar\x1b[0m"},
+		{"across lines", "\x1b[1mab\x1b[0m\n\x1b[1mcd\x1b[0m", "\x1b[1mab\ncd\x1b[0m"},
+		{"background reset at line break", "\x1b[44mab\x1b[0m\n\x1b[44mcd\x1b[0m", "\x1b[44mab\x1b[0m\n\x1b[44mcd\x1b[0m"},
+		{"separate sequences", "\x1b[1m\x1b[31mx\x1b[0m", "\x1b[1;31mx\x1b[0m"},
+		{"only the change", "\x1b[1;31ma\x1b[0m\x1b[1;32mb\x1b[0m", "\x1b[1;31ma\x1b[32mb\x1b[0m"},
+		{"attribute removed", "\x1b[1;31ma\x1b[0m\x1b[31mb\x1b[0m", "\x1b[1;31ma\x1b[0;31mb\x1b[0m"},
+		{"color removed", "\x1b[1;31ma\x1b[39mb\x1b[0m", "\x1b[1;31ma\x1b[39mb\x1b[0m"},
+		{"no text", "\x1b[1m\x1b[0m", ""},
+		{"open style kept", "\x1b[1mab", "\x1b[1mab"},
+		{"style set before erase", "\x1b[41m\x1b[K", "\x1b[41m\x1b[K"},
+		{"hyperlink kept", "\x1b[1m\x1b]8;;http://x\x1b\\a\x1b]8;;\x1b\\\x1b[0m", "\x1b[1m\x1b]8;;http://x\x1b\\a\x1b]8;;\x1b\\\x1b[0m"},
+		{"unmodeled kept", "\x1b[4:3ma\x1b[4mb\x1b[0m", "\x1b[4:3ma\x1b[0;4mb\x1b[0m"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CompressSGR(tt.in); got != tt.want {
+				t.Errorf("CompressSGR(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompressSGR_SameScreen(t *testing.T) {
+	frame := "\x1b[1;38;2;255;0;0mtitle\x1b[0m\n" +
+		"\x1b[44m \x1b[0m\x1b[44m \x1b[0m\x1b[2m dim\x1b[0m\n" +
+		"\x1b[7m\x1b[1mrev\x1b[22m\x1b[27m ok"
+
+	before, after := NewScreen(10, 3), NewScreen(10, 3)
+	before.Blit(0, 0, frame)
+	after.Blit(0, 0, CompressSGR(frame))
+	if before.String() != after.String() {
+		t.Errorf("compressed frame draws\n%q\nwant\n%q", after.String(), before.String())
+	}
+}
@@ -0,0 +1,172 @@
+package term
+
+import (
+	"strings"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+// sgrReset turns off all colors and attributes
+const sgrReset = "\x1b[0m"
+
+// FrameDiff turns a series of complete frames into minimal screen updates,
+// so a dashboard that re-renders everything on each tick can refresh without
+// clearing the screen and flickering.
+//
+// Each call to Update compares the new frame with the previous one and
+// returns only what changed: for every changed line, a cursor move to the
+// first changed cell followed by the rest of the line, with its colors and
+// attributes re-established. Frames are drawn from the top-left corner of
+// the screen, which suits the alternate screen (see AltScreen). The zero
+// value is ready to use; its first update clears the screen and draws the
+// whole frame.
+//
+// Example:
+//
+//	var diff term.FrameDiff
+//	for range ticker.C {
+//		fmt.Print(diff.Update(renderDashboard()))
+//	}
+type FrameDiff struct {
+	prev  [][]frameCell
+	drawn bool
+	sync  bool
+}
+
+// frameCell is one grapheme cluster of a rendered line together with the
+// escape sequences written just before it
+type frameCell struct {
+	escapes string
+	text    string
+	width   int
+}
+
+// SetSync makes Update wrap each non-empty update in synchronized-update
+// sequences (see Synchronized), so terminals that support them never show a
+// partly drawn frame.
+func (d *FrameDiff) SetSync(on bool) {
+	d.sync = on
+}
+
+// Update returns the escape sequences and text that change the screen from
+// the previous frame to frame.
+func (d *FrameDiff) Update(frame string) string {
+	lines := strings.Split(frame, "\n")
+	next := make([][]frameCell, len(lines))
+	for i, line := range lines {
+		next[i] = frameCells(line)
+	}
+
+	var b strings.Builder
+	if !d.drawn {
+		b.WriteString(ClearScreen())
+	}
+
+	for row, cells := range next {
+		var old []frameCell
+		if d.drawn && row < len(d.prev) {
+			old = d.prev[row]
+		}
+		writeLineDiff(&b, row, old, cells)
+	}
+
+	// Erase lines the previous frame had below the new one
+	for row := len(next); d.drawn && row < len(d.prev); row++ {
+		b.WriteString(MoveCursor(0, row))
+		b.WriteString(sgrReset)
+		b.WriteString(ClearLine())
+	}
+
+	d.prev = next
+	d.drawn = true
+	if d.sync {
+		return Synchronized(b.String())
+	}
+	return b.String()
+}
+
+// Reset forgets the previous frame, so the next Update clears the screen
+// and redraws everything. Call it after anything else has written to the
+// screen, or after a resize.
+func (d *FrameDiff) Reset() {
+	d.prev = nil
+	d.drawn = false
+}
+
+// writeLineDiff writes the update turning old into cells on screen row row
+func writeLineDiff(b *strings.Builder, row int, old, cells []frameCell) {
+	same := 0
+	for same < len(old) && same < len(cells) && old[same] == cells[same] {
+		same++
+	}
+	if same == len(old) && same == len(cells) {
+		return
+	}
+
+	col := 0
+	for _, c := range cells[:same] {
+		col += c.width
+	}
+
+	b.WriteString(MoveCursor(col, row))
+	b.WriteString(sgrReset)
+	b.WriteString(activeEscapes(cells[:same]))
+	for _, c := range cells[same:] {
+		b.WriteString(c.escapes)
+		b.WriteString(c.text)
+	}
+
+	if lineWidth(cells) < lineWidth(old) {
+		if same < len(cells) {
+			b.WriteString(sgrReset)
+		}
+		b.WriteString(ClearToEndOfLine())
+	}
+}
+
+// frameCells splits a rendered line into cells. Escape sequences after the
+// last visible character form a final zero-width cell.
+func frameCells(line string) []frameCell {
+	var cells []frameCell
+	var pending strings.Builder
+	for _, seg := range measure.Segments(line) {
+		if seg.Escape {
+			pending.WriteString(seg.Text)
+			continue
+		}
+		measure.EachGrapheme(seg.Text, func(cluster string, width int) bool {
+			cells = append(cells, frameCell{escapes: pending.String(), text: cluster, width: width})
+			pending.Reset()
+			return true
+		})
+	}
+	if pending.Len() > 0 {
+		cells = append(cells, frameCell{escapes: pending.String()})
+	}
+	return cells
+}
+
+// activeEscapes returns the escape sequences needed to restore the styling
+// in effect after cells: everything written since the last full reset
+func activeEscapes(cells []frameCell) string {
+	var all strings.Builder
+	for _, c := range cells {
+		all.WriteString(c.escapes)
+	}
+	s := all.String()
+	for _, reset := range []string{sgrReset, "\x1b[m"} {
+		if i := strings.LastIndex(s, reset); i >= 0 {
+			s = s[i+len(reset):]
+		}
+	}
+	return s
+}
+
+// lineWidth returns the number of columns the cells cover
+func lineWidth(cells []frameCell) int {
+	w := 0
+	for _, c := range cells {
+		w += c.width
+	}
+	return w
+}
@@ -0,0 +1,114 @@
+package term
+
+import "testing"
+
+func TestFrameDiff_Update(t *testing.T) {
+	var d FrameDiff
+
+	steps := []struct {
+		name  string
+		frame string
+		want  string
+	}{
+		{
+			name:  "first frame draws everything",
+			frame: "ab\ncd",
+			want:  ClearScreen() + MoveCursor(0, 0) + sgrReset + "ab" + MoveCursor(0, 1) + sgrReset + "cd",
+		},
+		{
+			name:  "unchanged frame writes nothing",
+			frame: "ab\ncd",
+			want:  "",
+		},
+		{
+			name:  "rewrite from first changed cell",
+			frame: "ab\ncX",
+			want:  MoveCursor(1, 1) + sgrReset + "X",
+		},
+		{
+			name:  "shorter line clears the rest",
+			frame: "a\ncX",
+			want:  MoveCursor(1, 0) + sgrReset + ClearToEndOfLine(),
+		},
+		{
+			name:  "removed lines are erased",
+			frame: "a",
+			want:  MoveCursor(0, 1) + sgrReset + ClearLine(),
+		},
+		{
+			name:  "added lines are drawn",
+			frame: "a\nnew",
+			want:  MoveCursor(0, 1) + sgrReset + "new",
+		},
+	}
+
+	for _, step := range steps {
+		if got := d.Update(step.frame); got != step.want {
+			t.Fatalf("%s: Update() = %q, want %q", step.name, got, step.want)
+		}
+	}
+}
+
+func TestFrameDiff_RestoresStyle(t *testing.T) {
+	var d FrameDiff
+	d.Update("\x1b[31mred 1\x1b[0m")
+
+	got := d.Update("\x1b[31mred 2\x1b[0m")
+	want := MoveCursor(4, 0) + sgrReset + "\x1b[31m" + "2" + "\x1b[0m"
+	if got != want {
+		t.Errorf("Update() = %q, want %q", got, want)
+	}
+}
+
+func TestFrameDiff_StyleChangeIsAChange(t *testing.T) {
+	var d FrameDiff
+	d.Update("ok")
+
+	got := d.Update("o\x1b[1mk\x1b[0m")
+	want := MoveCursor(1, 0) + sgrReset + "\x1b[1mk\x1b[0m"
+	if got != want {
+		t.Errorf("Update() = %q, want %q", got, want)
+	}
+}
+
+func TestFrameDiff_WideCharacters(t *testing.T) {
+	var d FrameDiff
+	d.Update("日本x")
+
+	got := d.Update("日本y")
+	want := MoveCursor(4, 0) + sgrReset + "y"
+	if got != want {
+		t.Errorf("Update() = %q, want %q", got, want)
+	}
+}
+
+func TestFrameDiff_Reset(t *testing.T) {
+	var d FrameDiff
+	d.Update("a")
+	d.Reset()
+
+	want := ClearScreen() + MoveCursor(0, 0) + sgrReset + "a"
+	if got := d.Update("a"); got != want {
+		t.Errorf("Update() after Reset = %q, want %q", got, want)
+	}
+}
+
+func TestActiveEscapes(t *testing.T) {
+	cells := frameCells("\x1b[1ma\x1b[0m\x1b[32mb\x1b[4mc")
+	if got := activeEscapes(cells); got != "\x1b[32m\x1b[4m" {
+		t.Errorf("activeEscapes() = %q", got)
+	}
+}
+
+func TestFrameDiff_Sync(t *testing.T) {
+	var d FrameDiff
+	d.SetSync(true)
+
+	d.Update("a")
+	if got, want := d.Update("b"), Synchronized(MoveCursor(0, 0)+sgrReset+"b"); got != want {
+		t.Errorf("Update() = %q, want %q", got, want)
+	}
+	if got := d.Update("b"); got != "" {
+		t.Errorf("unchanged Update() = %q, want empty", got)
+	}
+}
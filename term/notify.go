@@ -0,0 +1,43 @@
+package term
+
+import "strings"
+
+// SetWindowTitle sets the title of the terminal window or tab (OSC 2).
+// Control characters in title are dropped, so untrusted text cannot end
+// the sequence early and inject its own.
+//
+// Example:
+//
+//	fmt.Print(term.SetWindowTitle("build: 3/10"))
+func SetWindowTitle(title string) string {
+	return "\x1b]2;" + stripControls(title) + "\x07"
+}
+
+// Bell rings the terminal bell. Depending on its settings the terminal
+// beeps, flashes, or marks the tab as needing attention.
+func Bell() string {
+	return "\x07"
+}
+
+// Notify asks the terminal to show a desktop notification with message
+// (OSC 9), for a long-running program to report that it has finished
+// while the user is looking elsewhere. iTerm2, WezTerm, kitty, and
+// Windows Terminal support it; other terminals ignore it, so pair it with
+// Bell for those. Control characters in message are dropped.
+//
+// Example:
+//
+//	fmt.Print(term.Notify("Deploy finished") + term.Bell())
+func Notify(message string) string {
+	return "\x1b]9;" + stripControls(message) + "\x07"
+}
+
+// stripControls removes C0 and C1 control characters and DEL from s
+func stripControls(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r < 0x20 || (r >= 0x7f && r < 0xa0) {
+			return -1
+		}
+		return r
+	}, s)
+}
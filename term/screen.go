@@ -0,0 +1,262 @@
+package term
+
+import (
+	"io"
+	"strings"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+// Cell is one character cell of a Screen.
+//
+// A wide character (CJK, most emoji) occupies two cells: the first holds
+// the character with Width 2, the second is a continuation cell with empty
+// Content and Width 0.
+type Cell struct {
+	Content string // One grapheme cluster; "" in a continuation cell
+	Width   int    // Columns Content covers: 1, 2, or 0 for a continuation
+	Fg      string // Foreground as SGR parameters, e.g. "31" or "38;2;255;0;0"; "" is the default
+	Bg      string // Background as SGR parameters, e.g. "44" or "48;5;236"; "" is the default
+	Attrs   Attr   // Text attributes
+}
+
+// blankCell is an unstyled space
+var blankCell = Cell{Content: " ", Width: 1}
+
+// pen returns the cell's styling
+func (c Cell) pen() pen {
+	return pen{fg: c.Fg, bg: c.Bg, attrs: c.Attrs}
+}
+
+// Screen is a double-buffered grid of cells.
+//
+// Rendered strings are drawn into the back buffer with Blit, which parses
+// their escape sequences into per-cell colors and attributes, so later
+// drawing can overlap earlier drawing cell by cell (dialogs over
+// dashboards, for example). Flush writes the cells that differ from what
+// was flushed before and makes the back buffer the new front buffer.
+//
+// Example:
+//
+//	screen := term.NewScreen(80, 24)
+//	screen.Blit(0, 0, dashboard)
+//	screen.Blit(20, 8, dialog)
+//	screen.Flush(os.Stdout)
+type Screen struct {
+	width, height int
+	back, front   []Cell
+	flushed       bool
+	sync          bool
+}
+
+// NewScreen returns a blank screen of width columns and height rows.
+// Non-positive sizes give an empty screen.
+func NewScreen(width, height int) *Screen {
+	width, height = max(width, 0), max(height, 0)
+	s := &Screen{
+		width:  width,
+		height: height,
+		back:   make([]Cell, width*height),
+		front:  make([]Cell, width*height),
+	}
+	s.Clear()
+	return s
+}
+
+// Size returns the screen's width and height in cells.
+func (s *Screen) Size() (width, height int) {
+	return s.width, s.height
+}
+
+// Cell returns the back-buffer cell at column x and row y, or a blank cell
+// outside the screen.
+func (s *Screen) Cell(x, y int) Cell {
+	if !s.inside(x, y) {
+		return blankCell
+	}
+	return s.back[y*s.width+x]
+}
+
+// SetCell sets the back-buffer cell at column x and row y. Cells outside
+// the screen are ignored. Setting a wide cell also sets the continuation
+// cell after it, and a wide cell that does not fit is replaced with a
+// space.
+func (s *Screen) SetCell(x, y int, c Cell) {
+	if !s.inside(x, y) {
+		return
+	}
+	if c.Width == 2 && x+1 >= s.width {
+		c.Content, c.Width = " ", 1
+	}
+	if c.Width != 2 && c.Width != 0 {
+		c.Width = 1
+	}
+
+	s.breakWide(x, y)
+	s.back[y*s.width+x] = c
+	if c.Width == 2 {
+		s.breakWide(x+1, y)
+		s.back[y*s.width+x+1] = Cell{Fg: c.Fg, Bg: c.Bg, Attrs: c.Attrs}
+	}
+}
+
+// breakWide blanks the other half of a wide character that the cell at x
+// is part of, before the cell is overwritten
+func (s *Screen) breakWide(x, y int) {
+	i := y*s.width + x
+	switch {
+	case s.back[i].Width == 2 && x+1 < s.width:
+		s.back[i+1] = blankCell
+	case s.back[i].Width == 0 && x > 0:
+		s.back[i-1] = blankCell
+	}
+}
+
+// Clear blanks the back buffer.
+func (s *Screen) Clear() {
+	for i := range s.back {
+		s.back[i] = blankCell
+	}
+}
+
+// Blit draws a rendered string into the back buffer with its top-left
+// corner at column x and row y.
+//
+// Colors and attributes from SGR escape sequences are recorded per cell,
+// carrying across lines as they would on a terminal; other escape
+// sequences (such as hyperlinks) are dropped. Anything outside the screen
+// is clipped, and a wide character cut by the right edge becomes a space.
+// Spaces are drawn like any other character, so a blitted box fully covers
+// what was beneath it.
+func (s *Screen) Blit(x, y int, rendered string) {
+	var p pen
+	for row, line := range strings.Split(rendered, "\n") {
+		col := x
+		for _, seg := range measure.Segments(line) {
+			if seg.Escape {
+				if strings.HasPrefix(seg.Text, "\x1b[") && strings.HasSuffix(seg.Text, "m") {
+					p.apply(seg.Text[2 : len(seg.Text)-1])
+				}
+				continue
+			}
+			measure.EachGrapheme(seg.Text, func(cluster string, width int) bool {
+				if width <= 0 {
+					return true
+				}
+				c := Cell{Content: cluster, Width: width, Fg: p.fg, Bg: p.bg, Attrs: p.attrs}
+				switch {
+				case col < 0 && col+width > 0:
+					// Left half of a wide character is clipped
+					s.SetCell(col+1, y+row, Cell{Content: " ", Width: 1, Fg: p.fg, Bg: p.bg, Attrs: p.attrs})
+				case col >= 0:
+					s.SetCell(col, y+row, c)
+				}
+				col += width
+				return true
+			})
+		}
+	}
+}
+
+// SetSync makes Flush wrap each non-empty update in synchronized-update
+// sequences (see Synchronized), so terminals that support them never show a
+// partly drawn screen. Other terminals ignore the sequences, so it is safe
+// to enable whenever output is going to a terminal.
+func (s *Screen) SetSync(on bool) {
+	s.sync = on
+}
+
+// Invalidate makes the next Flush redraw the whole screen, for example after
+// something else has written to the terminal.
+func (s *Screen) Invalidate() {
+	s.flushed = false
+}
+
+// Flush writes the changes between the back buffer and what was last
+// flushed, then makes the back buffer current. The first Flush (and the
+// first after Invalidate) clears the terminal and writes every cell.
+// The screen is drawn from the terminal's top-left corner.
+func (s *Screen) Flush(w io.Writer) error {
+	var b strings.Builder
+	full := !s.flushed
+	if full {
+		b.WriteString(ClearScreen())
+	}
+
+	var current pen
+	cursorX, cursorY := -1, -1
+	for y := 0; y < s.height; y++ {
+		for x := 0; x < s.width; x++ {
+			i := y*s.width + x
+			c := s.back[i]
+			if c.Width == 0 || (!full && c == s.front[i] && !s.wideChanged(x, y)) {
+				continue
+			}
+			if x != cursorX || y != cursorY {
+				b.WriteString(MoveCursor(x, y))
+			}
+			if p := c.pen(); p != current {
+				b.WriteString(p.sgr())
+				current = p
+			}
+			b.WriteString(c.Content)
+			cursorX, cursorY = x+c.Width, y
+		}
+	}
+	if current != (pen{}) {
+		b.WriteString(sgrReset)
+	}
+
+	copy(s.front, s.back)
+	s.flushed = true
+
+	out := b.String()
+	if s.sync {
+		out = Synchronized(out)
+	}
+	_, err := io.WriteString(w, out)
+	return err
+}
+
+// wideChanged reports whether the continuation cell after a wide character
+// at (x, y) differs from the front buffer
+func (s *Screen) wideChanged(x, y int) bool {
+	i := y*s.width + x
+	return s.back[i].Width == 2 && s.back[i+1] != s.front[i+1]
+}
+
+// String renders the back buffer as lines of styled text, for tests and
+// for printing a composed screen without cursor movement.
+func (s *Screen) String() string {
+	var b strings.Builder
+	for y := 0; y < s.height; y++ {
+		if y > 0 {
+			b.WriteString("\n")
+		}
+		var current pen
+		for x := 0; x < s.width; x++ {
+			c := s.back[y*s.width+x]
+			if c.Width == 0 {
+				continue
+			}
+			if p := c.pen(); p != current {
+				if p == (pen{}) {
+					b.WriteString(sgrReset)
+				} else {
+					b.WriteString(p.sgr())
+				}
+				current = p
+			}
+			b.WriteString(c.Content)
+		}
+		if current != (pen{}) {
+			b.WriteString(sgrReset)
+		}
+	}
+	return b.String()
+}
+
+// inside reports whether (x, y) is on the screen
+func (s *Screen) inside(x, y int) bool {
+	return x >= 0 && y >= 0 && x < s.width && y < s.height
+}
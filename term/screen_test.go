@@ -0,0 +1,166 @@
+package term
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestScreen_Blit(t *testing.T) {
+	s := NewScreen(6, 2)
+	s.Blit(1, 0, "\x1b[1;31mab\x1b[0m c\nd\x1b[44me")
+
+	tests := []struct {
+		x, y int
+		want Cell
+	}{
+		{0, 0, blankCell},
+		{1, 0, Cell{Content: "a", Width: 1, Fg: "31", Attrs: AttrBold}},
+		{2, 0, Cell{Content: "b", Width: 1, Fg: "31", Attrs: AttrBold}},
+		{3, 0, Cell{Content: " ", Width: 1}},
+		{4, 0, Cell{Content: "c", Width: 1}},
+		{1, 1, Cell{Content: "d", Width: 1}},
+		{2, 1, Cell{Content: "e", Width: 1, Bg: "44"}},
+		{3, 1, blankCell},
+	}
+	for _, tt := range tests {
+		if got := s.Cell(tt.x, tt.y); got != tt.want {
+			t.Errorf("Cell(%d, %d) = %+v, want %+v", tt.x, tt.y, got, tt.want)
+		}
+	}
+}
+
+func TestScreen_BlitCarriesStyleAcrossLines(t *testing.T) {
+	s := NewScreen(2, 2)
+	s.Blit(0, 0, "\x1b[38;5;196ma\nb\x1b[39m")
+	if got := s.Cell(0, 1).Fg; got != "38;5;196" {
+		t.Errorf("second line Fg = %q, want 38;5;196", got)
+	}
+}
+
+func TestScreen_BlitClips(t *testing.T) {
+	s := NewScreen(3, 2)
+	s.Blit(-1, 1, "abcd\nzz")
+	s.Blit(2, 0, "界")
+
+	if got := s.String(); got != "   \nbcd" {
+		t.Errorf("String() = %q", got)
+	}
+}
+
+func TestScreen_WideCharacters(t *testing.T) {
+	s := NewScreen(4, 1)
+	s.Blit(0, 0, "界界")
+	if got := s.String(); got != "界界" {
+		t.Fatalf("String() = %q", got)
+	}
+
+	s.Blit(1, 0, "x")
+	if got := s.String(); got != " x界" {
+		t.Errorf("overwriting a continuation cell: String() = %q, want %q", got, " x界")
+	}
+
+	s.Blit(2, 0, "y")
+	if got := s.String(); got != " xy " {
+		t.Errorf("overwriting a wide character: String() = %q, want %q", got, " xy ")
+	}
+}
+
+func TestScreen_BlitOverlay(t *testing.T) {
+	s := NewScreen(5, 3)
+	s.Blit(0, 0, "aaaaa\naaaaa\naaaaa")
+	s.Blit(1, 1, "\x1b[7m b \x1b[0m")
+
+	want := "aaaaa\na\x1b[0;7m b " + sgrReset + "a\naaaaa"
+	if got := s.String(); got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
+
+func TestScreen_Flush(t *testing.T) {
+	s := NewScreen(3, 2)
+	s.Blit(0, 0, "ab\n\x1b[32mc")
+
+	steps := []struct {
+		name string
+		draw func()
+		want string
+	}{
+		{
+			name: "first flush draws every cell",
+			draw: func() {},
+			want: ClearScreen() + MoveCursor(0, 0) + "ab " + MoveCursor(0, 1) + "\x1b[0;32mc" + sgrReset + "  ",
+		},
+		{
+			name: "unchanged screen writes nothing",
+			draw: func() {},
+			want: "",
+		},
+		{
+			name: "only changed cells are written",
+			draw: func() { s.Blit(1, 0, "X") },
+			want: MoveCursor(1, 0) + "X",
+		},
+		{
+			name: "style changes are written",
+			draw: func() { s.Blit(2, 1, "\x1b[1mZ") },
+			want: MoveCursor(2, 1) + "\x1b[0;1mZ" + sgrReset,
+		},
+		{
+			name: "invalidate redraws everything",
+			draw: s.Invalidate,
+			want: ClearScreen() + MoveCursor(0, 0) + "aX " + MoveCursor(0, 1) + "\x1b[0;32mc" + sgrReset + " " + "\x1b[0;1mZ" + sgrReset,
+		},
+	}
+
+	for _, step := range steps {
+		step.draw()
+		var out strings.Builder
+		if err := s.Flush(&out); err != nil {
+			t.Fatalf("%s: Flush: %v", step.name, err)
+		}
+		if out.String() != step.want {
+			t.Errorf("%s:\n got %q\nwant %q", step.name, out.String(), step.want)
+		}
+	}
+}
+
+func TestScreen_FlushWideCharacter(t *testing.T) {
+	s := NewScreen(3, 1)
+	s.Flush(&strings.Builder{})
+
+	s.Blit(0, 0, "界x")
+	var out strings.Builder
+	s.Flush(&out)
+	if want := MoveCursor(0, 0) + "界x"; out.String() != want {
+		t.Errorf("got %q, want %q", out.String(), want)
+	}
+}
+
+func TestNewScreen_NegativeSize(t *testing.T) {
+	s := NewScreen(-1, 3)
+	if w, h := s.Size(); w != 0 || h != 3 {
+		t.Errorf("Size() = %d, %d", w, h)
+	}
+	s.Blit(0, 0, "abc")
+	s.SetCell(0, 0, Cell{Content: "x", Width: 1})
+	if got := s.Cell(0, 0); got != blankCell {
+		t.Errorf("Cell(0, 0) = %+v", got)
+	}
+}
+
+func TestScreen_FlushSync(t *testing.T) {
+	s := NewScreen(2, 1)
+	s.SetSync(true)
+
+	var out strings.Builder
+	s.Flush(&out)
+	if want := Synchronized(ClearScreen() + MoveCursor(0, 0) + "  "); out.String() != want {
+		t.Errorf("first flush %q, want %q", out.String(), want)
+	}
+
+	out.Reset()
+	s.Flush(&out)
+	if out.String() != "" {
+		t.Errorf("unchanged flush wrote %q", out.String())
+	}
+}
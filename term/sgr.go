@@ -0,0 +1,157 @@
+package term
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Attr is a set of text attributes of a Cell.
+type Attr uint8
+
+// Text attributes, combined with |.
+const (
+	AttrBold Attr = 1 << iota
+	AttrFaint
+	AttrItalic
+	AttrUnderline
+	AttrBlink
+	AttrReverse
+	AttrStrikethrough
+)
+
+// attrCodes lists the SGR code that turns on each attribute, in bit order
+var attrCodes = [...]struct {
+	attr Attr
+	code string
+}{
+	{AttrBold, "1"},
+	{AttrFaint, "2"},
+	{AttrItalic, "3"},
+	{AttrUnderline, "4"},
+	{AttrBlink, "5"},
+	{AttrReverse, "7"},
+	{AttrStrikethrough, "9"},
+}
+
+// pen is the styling state SGR sequences modify
+type pen struct {
+	fg, bg string
+	attrs  Attr
+}
+
+// sgr returns the sequence that sets p from a reset state, always starting
+// with a reset
+func (p pen) sgr() string {
+	return "\x1b[" + strings.Join(append([]string{"0"}, p.params()...), ";") + "m"
+}
+
+// params returns the SGR parameters that set p's attributes and colors
+func (p pen) params() []string {
+	var params []string
+	for _, a := range attrCodes {
+		if p.attrs&a.attr != 0 {
+			params = append(params, a.code)
+		}
+	}
+	if p.fg != "" {
+		params = append(params, p.fg)
+	}
+	if p.bg != "" {
+		params = append(params, p.bg)
+	}
+	return params
+}
+
+// apply updates p with the parameters of an SGR sequence body (the text
+// between ESC [ and m)
+func (p *pen) apply(body string) {
+	if body == "" {
+		*p = pen{}
+		return
+	}
+
+	fields := strings.Split(body, ";")
+	for k := 0; k < len(fields); k++ {
+		field := fields[k]
+		lead := field
+		if i := strings.IndexByte(field, ':'); i >= 0 {
+			lead = field[:i]
+		}
+		n, err := strconv.Atoi(lead)
+		if err != nil {
+			n = 0
+		}
+
+		switch {
+		case n == 0:
+			*p = pen{}
+		case n == 1:
+			p.attrs |= AttrBold
+		case n == 2:
+			p.attrs |= AttrFaint
+		case n == 3:
+			p.attrs |= AttrItalic
+		case n == 4:
+			p.attrs |= AttrUnderline
+		case n == 5 || n == 6:
+			p.attrs |= AttrBlink
+		case n == 7:
+			p.attrs |= AttrReverse
+		case n == 9:
+			p.attrs |= AttrStrikethrough
+		case n == 22:
+			p.attrs &^= AttrBold | AttrFaint
+		case n == 23:
+			p.attrs &^= AttrItalic
+		case n == 24:
+			p.attrs &^= AttrUnderline
+		case n == 25:
+			p.attrs &^= AttrBlink
+		case n == 27:
+			p.attrs &^= AttrReverse
+		case n == 29:
+			p.attrs &^= AttrStrikethrough
+		case n >= 30 && n <= 37, n >= 90 && n <= 97:
+			p.fg = lead
+		case n == 39:
+			p.fg = ""
+		case n >= 40 && n <= 47, n >= 100 && n <= 107:
+			p.bg = lead
+		case n == 49:
+			p.bg = ""
+		case n == 38 || n == 48:
+			color := field
+			if lead == field {
+				// Semicolon form: 38;5;n or 38;2;r;g;b
+				extra := extendedColorArgs(fields[k+1:])
+				color = strings.Join(fields[k:k+1+extra], ";")
+				k += extra
+			}
+			if n == 38 {
+				p.fg = color
+			} else {
+				p.bg = color
+			}
+		case n == 58:
+			if lead == field {
+				k += extendedColorArgs(fields[k+1:])
+			}
+		}
+	}
+}
+
+// extendedColorArgs returns how many parameters after 38, 48, or 58 belong
+// to the color (5;n for 256 colors, 2;r;g;b for true color)
+func extendedColorArgs(rest []string) int {
+	if len(rest) == 0 {
+		return 0
+	}
+	switch rest[0] {
+	case "5":
+		return min(2, len(rest))
+	case "2":
+		return min(4, len(rest))
+	default:
+		return 1
+	}
+}
@@ -0,0 +1,155 @@
+// Package term provides the basic terminal control sequences full-screen
+// programs need alongside styled output: clearing, cursor movement and
+// visibility, the alternate screen, and the window title, bell, and
+// notifications.
+//
+// Every function returns the escape sequence as a string, ready to print or
+// to concatenate with rendered output so a whole frame is written at once.
+// Coordinates are zero-based cells with (0, 0) at the top-left corner.
+package term
+
+import (
+	"fmt"
+	"io"
+)
+
+// ClearScreen erases the whole screen and moves the cursor to the top-left
+// corner.
+func ClearScreen() string {
+	return "\x1b[2J\x1b[H"
+}
+
+// ClearLine erases the line the cursor is on without moving the cursor.
+func ClearLine() string {
+	return "\x1b[2K"
+}
+
+// ClearToEndOfLine erases from the cursor to the end of its line.
+func ClearToEndOfLine() string {
+	return "\x1b[K"
+}
+
+// ClearToEndOfScreen erases from the cursor to the end of the screen.
+func ClearToEndOfScreen() string {
+	return "\x1b[J"
+}
+
+// MoveCursor moves the cursor to column x and row y. Negative values are
+// clamped to 0.
+//
+// Example:
+//
+//	fmt.Print(term.MoveCursor(0, 5) + term.ClearLine() + status)
+func MoveCursor(x, y int) string {
+	return fmt.Sprintf("\x1b[%d;%dH", max(y, 0)+1, max(x, 0)+1)
+}
+
+// CursorHome moves the cursor to the top-left corner.
+func CursorHome() string {
+	return "\x1b[H"
+}
+
+// CursorUp moves the cursor up n rows, stopping at the top edge. It returns
+// "" when n is not positive.
+func CursorUp(n int) string {
+	return relativeMove(n, 'A')
+}
+
+// CursorDown moves the cursor down n rows, stopping at the bottom edge. It
+// returns "" when n is not positive.
+func CursorDown(n int) string {
+	return relativeMove(n, 'B')
+}
+
+// CursorForward moves the cursor right n columns, stopping at the right
+// edge. It returns "" when n is not positive.
+func CursorForward(n int) string {
+	return relativeMove(n, 'C')
+}
+
+// CursorBack moves the cursor left n columns, stopping at the left edge. It
+// returns "" when n is not positive.
+func CursorBack(n int) string {
+	return relativeMove(n, 'D')
+}
+
+// relativeMove builds a CSI cursor movement by n in the direction final
+func relativeMove(n int, final byte) string {
+	if n <= 0 {
+		return ""
+	}
+	return fmt.Sprintf("\x1b[%d%c", n, final)
+}
+
+// SaveCursor remembers the cursor position (and text attributes) for a
+// later RestoreCursor.
+func SaveCursor() string {
+	return "\x1b7"
+}
+
+// RestoreCursor returns the cursor to the position saved by SaveCursor.
+func RestoreCursor() string {
+	return "\x1b8"
+}
+
+// HideCursor makes the cursor invisible.
+func HideCursor() string {
+	return "\x1b[?25l"
+}
+
+// ShowCursor makes the cursor visible again.
+func ShowCursor() string {
+	return "\x1b[?25h"
+}
+
+// EnterAltScreen switches to the alternate screen buffer, leaving the
+// normal screen and its scrollback untouched underneath.
+func EnterAltScreen() string {
+	return "\x1b[?1049h"
+}
+
+// ExitAltScreen switches back to the normal screen buffer, restoring what
+// was shown before EnterAltScreen.
+func ExitAltScreen() string {
+	return "\x1b[?1049l"
+}
+
+// BeginSync starts a synchronized update (DEC private mode 2026): a
+// supporting terminal holds what follows and shows it at once on EndSync,
+// so a fast refresh is never displayed half-drawn. Other terminals ignore
+// it.
+func BeginSync() string {
+	return "\x1b[?2026h"
+}
+
+// EndSync ends a synchronized update started by BeginSync.
+func EndSync() string {
+	return "\x1b[?2026l"
+}
+
+// Synchronized wraps frame in BeginSync and EndSync. An empty frame stays
+// empty, so a refresh with nothing to draw writes nothing.
+func Synchronized(frame string) string {
+	if frame == "" {
+		return ""
+	}
+	return BeginSync() + frame + EndSync()
+}
+
+// AltScreen switches w to a cleared alternate screen with the cursor hidden
+// and returns a function that undoes both. Defer the function so the
+// terminal is restored however the program exits the screen.
+//
+// Example:
+//
+//	restore := term.AltScreen(os.Stdout)
+//	defer restore()
+//	for range ticker.C {
+//		fmt.Print(term.CursorHome() + dashboard())
+//	}
+func AltScreen(w io.Writer) (restore func()) {
+	_, _ = io.WriteString(w, EnterAltScreen()+HideCursor()+ClearScreen())
+	return func() {
+		_, _ = io.WriteString(w, ShowCursor()+ExitAltScreen())
+	}
+}
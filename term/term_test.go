@@ -0,0 +1,68 @@
+package term
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSequences(t *testing.T) {
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{"ClearScreen", ClearScreen(), "\x1b[2J\x1b[H"},
+		{"ClearLine", ClearLine(), "\x1b[2K"},
+		{"ClearToEndOfLine", ClearToEndOfLine(), "\x1b[K"},
+		{"ClearToEndOfScreen", ClearToEndOfScreen(), "\x1b[J"},
+		{"MoveCursor origin", MoveCursor(0, 0), "\x1b[1;1H"},
+		{"MoveCursor", MoveCursor(9, 4), "\x1b[5;10H"},
+		{"MoveCursor negative", MoveCursor(-3, -1), "\x1b[1;1H"},
+		{"CursorHome", CursorHome(), "\x1b[H"},
+		{"CursorUp", CursorUp(3), "\x1b[3A"},
+		{"CursorDown", CursorDown(1), "\x1b[1B"},
+		{"CursorForward", CursorForward(12), "\x1b[12C"},
+		{"CursorBack", CursorBack(2), "\x1b[2D"},
+		{"CursorUp zero", CursorUp(0), ""},
+		{"CursorBack negative", CursorBack(-1), ""},
+		{"SaveCursor", SaveCursor(), "\x1b7"},
+		{"RestoreCursor", RestoreCursor(), "\x1b8"},
+		{"HideCursor", HideCursor(), "\x1b[?25l"},
+		{"ShowCursor", ShowCursor(), "\x1b[?25h"},
+		{"EnterAltScreen", EnterAltScreen(), "\x1b[?1049h"},
+		{"ExitAltScreen", ExitAltScreen(), "\x1b[?1049l"},
+		{"BeginSync", BeginSync(), "\x1b[?2026h"},
+		{"EndSync", EndSync(), "\x1b[?2026l"},
+		{"Synchronized", Synchronized("x"), "\x1b[?2026hx\x1b[?2026l"},
+		{"Synchronized empty", Synchronized(""), ""},
+		{"SetWindowTitle", SetWindowTitle("build: 3/10"), "\x1b]2;build: 3/10\x07"},
+		{"SetWindowTitle controls", SetWindowTitle("a\x07\x1b]2;b\n"), "\x1b]2;a]2;b\x07"},
+		{"Bell", Bell(), "\x07"},
+		{"Notify", Notify("Deploy finished"), "\x1b]9;Deploy finished\x07"},
+		{"Notify controls", Notify("done\u009c ✓"), "\x1b]9;done ✓\x07"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %q, want %q", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAltScreen(t *testing.T) {
+	var b strings.Builder
+	restore := AltScreen(&b)
+
+	want := EnterAltScreen() + HideCursor() + ClearScreen()
+	if b.String() != want {
+		t.Fatalf("AltScreen wrote %q, want %q", b.String(), want)
+	}
+
+	restore()
+	want += ShowCursor() + ExitAltScreen()
+	if b.String() != want {
+		t.Errorf("after restore wrote %q, want %q", b.String(), want)
+	}
+}
@@ -0,0 +1,34 @@
+package tuistyles
+
+import (
+	"os"
+	"strconv"
+)
+
+// DetectTerminalSize returns the size of the terminal attached to standard
+// output in cells, or 0, 0 when it cannot be determined.
+//
+// The terminal itself is asked first; when standard output is not a
+// terminal (piped or redirected), the COLUMNS and LINES environment
+// variables are used instead.
+func DetectTerminalSize() (width, height int) {
+	return detectTerminalSize(queryTerminalSize, os.Getenv)
+}
+
+// detectTerminalSize implements DetectTerminalSize with an injectable
+// terminal query and environment
+func detectTerminalSize(query func() (int, int, bool), getenv func(string) string) (width, height int) {
+	if w, h, ok := query(); ok {
+		return w, h
+	}
+	return envSize(getenv("COLUMNS")), envSize(getenv("LINES"))
+}
+
+// envSize parses a positive cell count from an environment variable value
+func envSize(v string) int {
+	n, err := strconv.Atoi(v)
+	if err != nil || n < 0 {
+		return 0
+	}
+	return n
+}
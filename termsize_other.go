@@ -0,0 +1,9 @@
+//go:build !(linux || darwin || freebsd)
+
+package tuistyles
+
+// queryTerminalSize is unsupported on this platform; DetectTerminalSize
+// falls back to the environment
+func queryTerminalSize() (width, height int, ok bool) {
+	return 0, 0, false
+}
@@ -0,0 +1,29 @@
+package tuistyles
+
+import "testing"
+
+func TestDetectTerminalSize(t *testing.T) {
+	noTerminal := func() (int, int, bool) { return 0, 0, false }
+	terminal := func() (int, int, bool) { return 132, 43, true }
+
+	tests := []struct {
+		name          string
+		query         func() (int, int, bool)
+		env           map[string]string
+		width, height int
+	}{
+		{"terminal wins", terminal, map[string]string{"COLUMNS": "80", "LINES": "24"}, 132, 43},
+		{"environment", noTerminal, map[string]string{"COLUMNS": "80", "LINES": "24"}, 80, 24},
+		{"invalid environment", noTerminal, map[string]string{"COLUMNS": "wide", "LINES": "-3"}, 0, 0},
+		{"unknown", noTerminal, nil, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w, h := detectTerminalSize(tt.query, func(k string) string { return tt.env[k] })
+			if w != tt.width || h != tt.height {
+				t.Errorf("detectTerminalSize() = %d, %d, want %d, %d", w, h, tt.width, tt.height)
+			}
+		})
+	}
+}
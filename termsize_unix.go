@@ -0,0 +1,25 @@
+//go:build linux || darwin || freebsd
+
+package tuistyles
+
+import (
+	"os"
+	"syscall"
+	"unsafe"
+)
+
+// winsize mirrors the kernel's struct winsize
+type winsize struct {
+	Row, Col, XPixel, YPixel uint16
+}
+
+// queryTerminalSize asks the terminal on standard output for its size
+func queryTerminalSize() (width, height int, ok bool) {
+	var ws winsize
+	_, _, errno := syscall.Syscall(syscall.SYS_IOCTL, os.Stdout.Fd(),
+		uintptr(syscall.TIOCGWINSZ), uintptr(unsafe.Pointer(&ws)))
+	if errno != 0 || ws.Col == 0 {
+		return 0, 0, false
+	}
+	return int(ws.Col), int(ws.Row), true
+}
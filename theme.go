@@ -0,0 +1,69 @@
+package tuistyles
+
+// Theme is a set of semantic colors shared by the pre-built components.
+//
+// Components take a Theme by value; any color left empty falls back to the
+// matching DefaultTheme color, so a theme only needs to set what it changes.
+//
+// Example:
+//
+//	brand := Theme{Primary: Color("#7C3AED")}
+//	fmt.Println(Callout{Kind: CalloutNote, Body: "Saved", Theme: brand}.Render(60))
+type Theme struct {
+	Primary Color // Key accents: titles, selections, active items
+	Muted   Color // De-emphasized text and decorations
+	Border  Color // Neutral borders and separators
+	Info    Color // Informational messages
+	Success Color // Successful outcomes
+	Warning Color // Conditions that need attention
+	Error   Color // Failures
+}
+
+// DefaultTheme returns the package default theme: the built-in one unless
+// SetDefaultTheme has replaced it. The built-in theme uses the 16 ANSI
+// colors so it follows the user's terminal palette on both light and dark
+// backgrounds.
+func DefaultTheme() Theme {
+	if t := defaultTheme.Load(); t != nil {
+		return *t
+	}
+	return builtinTheme()
+}
+
+// builtinTheme returns the theme DefaultTheme starts as
+func builtinTheme() Theme {
+	return Theme{
+		Primary: Color("magenta"),
+		Muted:   Color("bright-black"),
+		Border:  Color("bright-black"),
+		Info:    Color("blue"),
+		Success: Color("green"),
+		Warning: Color("yellow"),
+		Error:   Color("red"),
+	}
+}
+
+// WithDefaults returns a copy of the theme with every empty color filled in
+// from DefaultTheme. Components call it before rendering; packages that build
+// their own styles from a Theme should too.
+func (t Theme) WithDefaults() Theme {
+	return t.fill(DefaultTheme())
+}
+
+// fill returns t with every empty color taken from d
+func (t Theme) fill(d Theme) Theme {
+	fill := func(c *Color, fallback Color) {
+		if *c == "" {
+			*c = fallback
+		}
+	}
+
+	fill(&t.Primary, d.Primary)
+	fill(&t.Muted, d.Muted)
+	fill(&t.Border, d.Border)
+	fill(&t.Info, d.Info)
+	fill(&t.Success, d.Success)
+	fill(&t.Warning, d.Warning)
+	fill(&t.Error, d.Error)
+	return t
+}
@@ -0,0 +1,27 @@
+package tuistyles
+
+import "testing"
+
+func TestTheme_WithDefaults(t *testing.T) {
+	custom := Theme{Primary: Color("#7C3AED")}.WithDefaults()
+	def := DefaultTheme()
+
+	if custom.Primary != Color("#7C3AED") {
+		t.Errorf("Primary = %q, want the custom color", custom.Primary)
+	}
+	if custom.Error != def.Error || custom.Muted != def.Muted {
+		t.Error("unset colors should fall back to DefaultTheme")
+	}
+	if (Theme{}).WithDefaults() != def {
+		t.Error("zero Theme should equal DefaultTheme")
+	}
+}
+
+func TestDefaultTheme_ValidColors(t *testing.T) {
+	def := DefaultTheme()
+	for _, c := range []Color{def.Primary, def.Muted, def.Border, def.Info, def.Success, def.Warning, def.Error} {
+		if _, err := NewColor(string(c)); err != nil {
+			t.Errorf("DefaultTheme color %q is invalid: %v", c, err)
+		}
+	}
+}
@@ -0,0 +1,129 @@
+package tuistyles
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+// FormatDuration formats d compactly with at most two units, choosing the
+// precision from the magnitude: "850ms", "4.2s", "3m05s", "2h07m", "3d04h".
+// Output is at most 7 cells wide for durations under 100 days, including a
+// leading "-" for negative durations.
+//
+// Example:
+//
+//	FormatDuration(185 * time.Second) // "3m05s"
+func FormatDuration(d time.Duration) string {
+	if d < 0 {
+		// -d overflows for the most negative duration, so take the
+		// magnitude as unsigned
+		return "-" + formatMagnitude(uint64(-(d+1))+1)
+	}
+	return formatMagnitude(uint64(d))
+}
+
+// formatMagnitude implements FormatDuration for a non-negative duration in
+// nanoseconds
+func formatMagnitude(d uint64) string {
+	const (
+		ms     = uint64(time.Millisecond)
+		second = uint64(time.Second)
+		minute = uint64(time.Minute)
+		hour   = uint64(time.Hour)
+		day    = 24 * hour
+	)
+
+	switch {
+	case d < second:
+		return fmt.Sprintf("%dms", d/ms)
+	case d < minute:
+		// Truncate rather than round so 59.96s never displays as "60.0s"
+		return fmt.Sprintf("%d.%ds", d/second, d%second/(100*ms))
+	case d < hour:
+		return fmt.Sprintf("%dm%02ds", d/minute, d%minute/second)
+	case d < day:
+		return fmt.Sprintf("%dh%02dm", d/hour, d%hour/minute)
+	default:
+		return fmt.Sprintf("%dd%02dh", d/day, d%day/hour)
+	}
+}
+
+// FormatRelative describes t relative to now in the largest whole unit:
+// "just now", "42s ago", "5m ago", "3h ago", "12d ago", or "in 5m" for
+// future times.
+func FormatRelative(t, now time.Time) string {
+	d := now.Sub(t)
+	future := d < 0
+	// Sub saturates far from now, and negating the most negative duration
+	// overflows, so take the magnitude as unsigned
+	magnitude := uint64(d)
+	if future {
+		magnitude = uint64(-(d + 1)) + 1
+	}
+
+	const (
+		second = uint64(time.Second)
+		minute = uint64(time.Minute)
+		hour   = uint64(time.Hour)
+		day    = 24 * hour
+	)
+	var amount string
+	switch {
+	case magnitude < second:
+		return "just now"
+	case magnitude < minute:
+		amount = fmt.Sprintf("%ds", magnitude/second)
+	case magnitude < hour:
+		amount = fmt.Sprintf("%dm", magnitude/minute)
+	case magnitude < day:
+		amount = fmt.Sprintf("%dh", magnitude/hour)
+	default:
+		amount = fmt.Sprintf("%dd", magnitude/day)
+	}
+
+	if future {
+		return "in " + amount
+	}
+	return amount + " ago"
+}
+
+// RightAlignedDuration renders FormatDuration(d) right-aligned in exactly
+// width cells, so a ticking value in a dashboard never shifts the text
+// around it. Use a width of 7 to fit any duration under 100 days.
+//
+// Example:
+//
+//	elapsed := RightAlignedDuration(time.Since(start), 7, NewStyle().Faint(true))
+func RightAlignedDuration(d time.Duration, width int, style Style) string {
+	return rightAligned(FormatDuration(d), width, style)
+}
+
+// RightAlignedTimestamp renders t formatted with layout (see time.Format)
+// right-aligned in exactly width cells.
+//
+// Example:
+//
+//	clock := RightAlignedTimestamp(time.Now(), time.Kitchen, 7, NewStyle())
+func RightAlignedTimestamp(t time.Time, layout string, width int, style Style) string {
+	return rightAligned(t.Format(layout), width, style)
+}
+
+// RightAlignedRelative renders FormatRelative(t, now) right-aligned in
+// exactly width cells.
+func RightAlignedRelative(t, now time.Time, width int, style Style) string {
+	return rightAligned(FormatRelative(t, now), width, style)
+}
+
+// rightAligned fits text into width cells, padding on the left and cutting
+// from the right if it is too long
+func rightAligned(text string, width int, style Style) string {
+	if width <= 0 {
+		return ""
+	}
+	if measure.Width(text) > width {
+		text = measure.Truncate(text, width, "")
+	}
+	return style.Width(width).Align(Right).Render(text)
+}
@@ -0,0 +1,97 @@
+package tuistyles
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+func TestFormatDuration(t *testing.T) {
+	tests := []struct {
+		in   time.Duration
+		want string
+	}{
+		{0, "0ms"},
+		{850 * time.Millisecond, "850ms"},
+		{4200 * time.Millisecond, "4.2s"},
+		{59960 * time.Millisecond, "59.9s"},
+		{185 * time.Second, "3m05s"},
+		{2*time.Hour + 7*time.Minute + 30*time.Second, "2h07m"},
+		{76 * time.Hour, "3d04h"},
+		{-90 * time.Second, "-1m30s"},
+		{math.MaxInt64, "106751d23h"},
+		{math.MinInt64, "-106751d23h"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			require.Equal(t, tt.want, FormatDuration(tt.in))
+		})
+	}
+}
+
+func TestFormatDuration_MaxWidth(t *testing.T) {
+	for _, d := range []time.Duration{
+		999 * time.Millisecond,
+		59*time.Second + 999*time.Millisecond,
+		59*time.Minute + 59*time.Second,
+		23*time.Hour + 59*time.Minute,
+		99*24*time.Hour + 23*time.Hour,
+	} {
+		require.LessOrEqual(t, measure.Width(FormatDuration(-d)), 7, "duration %v", d)
+	}
+}
+
+func TestFormatRelative(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		offset time.Duration
+		want   string
+	}{
+		{0, "just now"},
+		{-42 * time.Second, "42s ago"},
+		{-5 * time.Minute, "5m ago"},
+		{-3 * time.Hour, "3h ago"},
+		{-12 * 24 * time.Hour, "12d ago"},
+		{5 * time.Minute, "in 5m"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			require.Equal(t, tt.want, FormatRelative(now.Add(tt.offset), now))
+		})
+	}
+
+	// Times further away than a duration can hold
+	require.Equal(t, "in 106751d", FormatRelative(now.AddDate(300, 0, 0), now))
+	require.Equal(t, "106751d ago", FormatRelative(now.AddDate(-300, 0, 0), now))
+}
+
+func TestRightAligned_StableWidth(t *testing.T) {
+	style := NewStyle().Bold(true)
+	for _, d := range []time.Duration{time.Millisecond, 4 * time.Second, 3 * time.Minute, 5 * time.Hour} {
+		got := RightAlignedDuration(d, 7, style)
+		require.Equal(t, 7, measure.Width(got), "duration %v", d)
+	}
+
+	require.Equal(t, "   3m05s", measure.StripANSI(RightAlignedDuration(185*time.Second, 8, NewStyle())))
+}
+
+func TestRightAlignedTimestamp(t *testing.T) {
+	ts := time.Date(2024, 5, 1, 9, 4, 0, 0, time.UTC)
+
+	require.Equal(t, " 9:04AM", RightAlignedTimestamp(ts, time.Kitchen, 7, NewStyle()))
+	require.Equal(t, "9:04", RightAlignedTimestamp(ts, time.Kitchen, 4, NewStyle()), "too-long values are cut to width")
+	require.Empty(t, RightAlignedTimestamp(ts, time.Kitchen, 0, NewStyle()))
+}
+
+func TestRightAlignedRelative(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	require.Equal(t, "  5m ago", RightAlignedRelative(now.Add(-5*time.Minute), now, 8, NewStyle()))
+}
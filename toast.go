@@ -0,0 +1,171 @@
+package tuistyles
+
+import (
+	"strings"
+	"time"
+)
+
+// Toast is one transient notification in a Toasts stack
+type Toast struct {
+	Kind    CalloutKind // Selects the icon and accent color, as for Callout
+	Message string
+	Created time.Time // When the toast appeared; a zero time is never dimmed
+}
+
+// ToastCorner is the corner of the screen a Toasts stack sits in
+type ToastCorner int
+
+const (
+	// ToastTopRight stacks toasts down from the top right corner (the
+	// default)
+	ToastTopRight ToastCorner = iota
+	// ToastTopLeft stacks toasts down from the top left corner
+	ToastTopLeft
+	// ToastBottomRight stacks toasts up from the bottom right corner
+	ToastBottomRight
+	// ToastBottomLeft stacks toasts up from the bottom left corner
+	ToastBottomLeft
+)
+
+// String returns human-readable toast corner name
+func (c ToastCorner) String() string {
+	switch c {
+	case ToastTopRight:
+		return "TopRight"
+	case ToastTopLeft:
+		return "TopLeft"
+	case ToastBottomRight:
+		return "BottomRight"
+	case ToastBottomLeft:
+		return "BottomLeft"
+	default:
+		return "Unknown"
+	}
+}
+
+// Toasts renders a stack of notifications in a corner of the screen, the
+// newest nearest the corner. Each toast is a small bordered box in its
+// kind's accent color; toasts older than FadeAfter are dimmed, and only
+// the newest Max are shown.
+//
+// Toasts only draws; the caller adds toasts and drops expired ones.
+//
+// Example:
+//
+//	toasts := Toasts{FadeAfter: 3 * time.Second}
+//	toasts.Items = append(toasts.Items, Toast{Kind: CalloutTip, Message: "Saved", Created: time.Now()})
+//	fmt.Print(toasts.Overlay(screen, 40))
+type Toasts struct {
+	Items     []Toast       // Oldest first
+	Max       int           // Most toasts shown, the newest; 3 when 0 or less
+	Corner    ToastCorner   // TopRight by default
+	FadeAfter time.Duration // Age after which a toast is dimmed; never when 0 or less
+	Now       time.Time     // Time ages are measured from; the current time when zero
+	Theme     Theme
+}
+
+// Render draws the shown toasts stacked in the order they appear on
+// screen, each at most width cells wide with its message wrapped to fit,
+// and lined up on the corner's side. A width of 0 or less leaves each
+// toast at its natural width.
+func (t Toasts) Render(width int) string {
+	blocks := t.blocks(width)
+	if len(blocks) == 0 {
+		return ""
+	}
+	pos := Left
+	if t.right() {
+		pos = Right
+	}
+	return JoinVertical(pos, blocks...)
+}
+
+// Overlay draws the toasts over screen in the corner, each at most width
+// cells wide (see Render), using Place's positioning for the corner. Toasts
+// that do not fit on the screen are clipped.
+func (t Toasts) Overlay(screen string, width int) string {
+	blocks := t.blocks(width)
+	if len(blocks) == 0 {
+		return screen
+	}
+
+	screenWidth, screenHeight := Size(screen)
+	hPos, vPos := Left, Top
+	if t.right() {
+		hPos = Right
+	}
+	if t.bottom() {
+		vPos = Bottom
+	}
+	area := placement(screenWidth, screenHeight, hPos, vPos, strings.Split(t.Render(width), "\n"))
+
+	// Each toast goes on by itself so the screen shows between narrower
+	// toasts and the stack's edge
+	y := area.Y
+	for _, block := range blocks {
+		w, h := Size(block)
+		x := area.X
+		if t.right() {
+			x = area.X + area.Width - w
+		}
+		screen = Overlay(screen, block, x, y)
+		y += h
+	}
+	return screen
+}
+
+// blocks renders the shown toasts in screen order
+func (t Toasts) blocks(width int) []string {
+	limit := t.Max
+	if limit <= 0 {
+		limit = 3
+	}
+	items := t.Items[max(len(t.Items)-limit, 0):]
+
+	theme := t.Theme.WithDefaults()
+	now := t.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	// The border and padding take four cells
+	inner := 0
+	if width > 0 {
+		inner = max(width-4, 1)
+	}
+
+	blocks := make([]string, len(items))
+	for i, toast := range items {
+		accent := toast.Kind.accent(theme)
+		box := NewStyle().Border(RoundedBorder()).BorderForeground(accent).Padding(0, 1)
+		iconStyle := NewStyle().Foreground(accent)
+		if t.FadeAfter > 0 && !toast.Created.IsZero() && now.Sub(toast.Created) > t.FadeAfter {
+			box = box.BorderForeground(theme.Muted).Faint(true)
+			iconStyle = iconStyle.Foreground(theme.Muted)
+		}
+
+		text := toast.Message
+		if icon := toast.Kind.icon(); icon != "" {
+			text = iconStyle.Render(icon) + " " + text
+		}
+		blocks[i] = box.Render(Wrap(text, inner))
+	}
+
+	// Newest nearest the corner: top stacks read newest first
+	if !t.bottom() {
+		for i, j := 0, len(blocks)-1; i < j; i, j = i+1, j-1 {
+			blocks[i], blocks[j] = blocks[j], blocks[i]
+		}
+	}
+	return blocks
+}
+
+// right reports whether the stack sits on the right edge
+func (t Toasts) right() bool {
+	return t.Corner == ToastTopRight || t.Corner == ToastBottomRight
+}
+
+// bottom reports whether the stack sits on the bottom edge
+func (t Toasts) bottom() bool {
+	return t.Corner == ToastBottomRight || t.Corner == ToastBottomLeft
+}
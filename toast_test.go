@@ -0,0 +1,121 @@
+package tuistyles
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+func TestToasts_Render(t *testing.T) {
+	toasts := Toasts{Items: []Toast{
+		{Kind: CalloutError, Message: "disk full"},
+		{Kind: CalloutTip, Message: "Saved"},
+	}}
+
+	want := "    ╭─────────╮\n" +
+		"    │ ★ Saved │\n" +
+		"    ╰─────────╯\n" +
+		"╭─────────────╮\n" +
+		"│ ✖ disk full │\n" +
+		"╰─────────────╯"
+	require.Equal(t, want, measure.StripANSI(toasts.Render(0)))
+
+	toasts.Corner = ToastBottomLeft
+	want = "╭─────────────╮\n" +
+		"│ ✖ disk full │\n" +
+		"╰─────────────╯\n" +
+		"╭─────────╮    \n" +
+		"│ ★ Saved │    \n" +
+		"╰─────────╯    "
+	require.Equal(t, want, measure.StripANSI(toasts.Render(0)), "newest nearest the corner")
+
+	require.Equal(t, "╭────────╮\n│ ✖ disk │\n│ full   │\n╰────────╯",
+		strings.Join(strings.Split(measure.StripANSI(toasts.Render(10)), "\n")[:4], "\n"), "messages wrap to the width")
+	require.Empty(t, Toasts{}.Render(0))
+}
+
+func TestToasts_Max(t *testing.T) {
+	toasts := Toasts{Max: 2, Items: []Toast{{Message: "one"}, {Message: "two"}, {Message: "three"}}}
+	got := measure.StripANSI(toasts.Render(0))
+	require.NotContains(t, got, "one")
+	require.Contains(t, got, "two")
+	require.Contains(t, got, "three")
+
+	toasts.Max = 0
+	require.Contains(t, measure.StripANSI(toasts.Render(0)), "one", "three are shown by default")
+}
+
+func TestToasts_Fade(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	toasts := Toasts{
+		Items: []Toast{
+			{Message: "old", Created: now.Add(-time.Minute)},
+			{Message: "new", Created: now.Add(-time.Second)},
+			{Message: "untimed"},
+		},
+		FadeAfter: 5 * time.Second,
+		Now:       now,
+	}
+
+	lines := strings.Split(toasts.Render(0), "\n")
+	require.NotContains(t, lines[1], "\x1b[2m", "untimed toasts never fade")
+	require.NotContains(t, lines[4], "\x1b[2m")
+	require.Contains(t, lines[7], "\x1b[2m")
+
+	toasts.FadeAfter = 0
+	require.NotContains(t, toasts.Render(0), "\x1b[2m")
+}
+
+func TestToasts_Overlay(t *testing.T) {
+	screen := strings.TrimSuffix(strings.Repeat(strings.Repeat(".", 20)+"\n", 5), "\n")
+	toasts := Toasts{Items: []Toast{{Kind: CalloutQuote, Message: "a"}, {Kind: CalloutQuote, Message: "bbb"}}}
+
+	want := ".............╭─────╮\n" +
+		".............│ bbb │\n" +
+		".............╰─────╯\n" +
+		"...............╭───╮\n" +
+		"...............│ a │"
+	require.Equal(t, want, measure.StripANSI(toasts.Overlay(screen, 0)), "clipped at the bottom")
+
+	toasts.Items = toasts.Items[:1]
+	toasts.Corner = ToastBottomLeft
+	want = "....................\n" +
+		"....................\n" +
+		"╭───╮...............\n" +
+		"│ a │...............\n" +
+		"╰───╯..............."
+	require.Equal(t, want, measure.StripANSI(toasts.Overlay(screen, 0)))
+	require.Equal(t, screen, Toasts{}.Overlay(screen, 0))
+}
+
+func TestOverlay(t *testing.T) {
+	red := NewStyle().Foreground(Color("#ff0000"))
+	background := red.Render("abcdef") + "\nabcdef\nab"
+
+	tests := []struct {
+		name       string
+		foreground string
+		x, y       int
+		want       string
+	}{
+		{"inside", "XY", 2, 1, "abcdef\nabXYef\nab"},
+		{"past the end", "XY", 5, 0, "abcdeXY\nabcdef\nab"},
+		{"pads short lines", "XY", 4, 2, "abcdef\nabcdef\nab  XY"},
+		{"clips", "XY\nZW", -1, 2, "abcdef\nabcdef\nYb"},
+		{"off screen", "XY", 0, 5, "abcdef\nabcdef\nab"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, measure.StripANSI(Overlay(background, tt.foreground, tt.x, tt.y)))
+		})
+	}
+
+	got := strings.Split(Overlay(background, "XY", 2, 0), "\n")[0]
+	require.True(t, strings.HasPrefix(got, red.Render("ab")), "styling either side is kept")
+	require.True(t, strings.HasSuffix(got, red.Render("ef")))
+}
@@ -0,0 +1,121 @@
+package tuistyles
+
+import (
+	"strings"
+
+	"github.com/orchard9/tui-styles/internal/ansi"
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+// Section is one entry of a table of contents
+type Section struct {
+	Title string // Entry text; may already be styled
+	Page  string // Page number or anchor shown at the right edge
+	Level int    // Nesting depth; each level indents the title
+}
+
+// TOC renders a table of contents with dotted leaders between each title
+// and its page:
+//
+//	Introduction ................ 1
+//	  Installation .............. 3
+//
+// Leaders are laid out by absolute column, so multi-character patterns
+// such as ". " line up from row to row, and titles are measured without
+// their escape sequences, so pre-styled titles align too. Leader
+// characters must be one cell wide.
+//
+// Example:
+//
+//	toc := TOC{Sections: []Section{{Title: "Introduction", Page: "1"}}}
+//	fmt.Println(toc.Render(40))
+type TOC struct {
+	Sections    []Section
+	Leader      string // Fill pattern; "." when empty
+	Indent      int    // Cells per nesting level; 2 when 0
+	TitleStyle  Style
+	LeaderStyle Style
+	PageStyle   Style
+}
+
+// minLeader is the fewest leader cells shown between a title and its page
+const minLeader = 3
+
+// Render draws the contents at width cells. Titles too long for the width
+// are truncated with "…". A width of 0 or less fits the longest entry.
+func (t TOC) Render(width int) string {
+	if len(t.Sections) == 0 {
+		return ""
+	}
+
+	leader := t.Leader
+	if leader == "" {
+		leader = "."
+	}
+	indent := t.Indent
+	if indent <= 0 {
+		indent = 2
+	}
+
+	pageWidth := 0
+	for _, sec := range t.Sections {
+		pageWidth = max(pageWidth, measure.Width(sec.Page))
+	}
+	if width <= 0 {
+		for _, sec := range t.Sections {
+			width = max(width, max(sec.Level, 0)*indent+measure.Width(sec.Title)+pageWidth+minLeader+2)
+		}
+	}
+
+	lines := make([]string, len(t.Sections))
+	for i, sec := range t.Sections {
+		lines[i] = t.renderEntry(sec, leader, indent, pageWidth, width)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// renderEntry lays out one line: indent, title, leader, right-aligned page
+func (t TOC) renderEntry(sec Section, leader string, indent, pageWidth, width int) string {
+	prefix := strings.Repeat(" ", max(sec.Level, 0)*indent)
+
+	// Space left for the title after the page column and minimal leader
+	avail := width - len(prefix) - pageWidth - minLeader - 2
+	title := sec.Title
+	if avail < 1 {
+		title = ""
+	} else if measure.Width(title) > avail {
+		head, _ := measure.SplitAt(title, avail-1)
+		if strings.Contains(head, "\x1b") {
+			head += ansi.Reset()
+		}
+		title = head + "…"
+	}
+
+	titleEnd := len(prefix) + measure.Width(title) + 1
+	pageStart := width - measure.Width(sec.Page)
+	fill := leaderFill(leader, titleEnd, pageStart-1)
+
+	var b strings.Builder
+	b.WriteString(prefix)
+	b.WriteString(t.TitleStyle.Render(title))
+	b.WriteString(" ")
+	b.WriteString(t.LeaderStyle.Render(fill))
+	b.WriteString(strings.Repeat(" ", max(pageStart-titleEnd-measure.Width(fill), 0)))
+	b.WriteString(t.PageStyle.Render(sec.Page))
+	return b.String()
+}
+
+// leaderFill repeats pattern over the columns [from, to), indexed by
+// absolute column so patterns line up between rows
+func leaderFill(pattern string, from, to int) string {
+	runes := []rune(pattern)
+	if to <= from || len(runes) == 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	for col := from; col < to; col++ {
+		b.WriteRune(runes[col%len(runes)])
+	}
+	return b.String()
+}
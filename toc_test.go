@@ -0,0 +1,68 @@
+package tuistyles
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+func TestTOC_Render(t *testing.T) {
+	toc := TOC{Sections: []Section{
+		{Title: "Introduction", Page: "1"},
+		{Title: "Installation", Page: "3", Level: 1},
+		{Title: "Reference", Page: "12"},
+	}}
+
+	require.Equal(t, strings.Join([]string{
+		"Introduction .............. 1",
+		"  Installation ............ 3",
+		"Reference ................ 12",
+	}, "\n"), toc.Render(29))
+}
+
+func TestTOC_NaturalWidth(t *testing.T) {
+	toc := TOC{Sections: []Section{{Title: "Intro", Page: "1"}, {Title: "Usage", Page: "2"}}}
+
+	require.Equal(t, "Intro ... 1\nUsage ... 2", toc.Render(0))
+}
+
+func TestTOC_PatternLinesUp(t *testing.T) {
+	toc := TOC{
+		Leader:   ". ",
+		Sections: []Section{{Title: "Ab", Page: "1"}, {Title: "Abc", Page: "2"}},
+	}
+	lines := strings.Split(toc.Render(14), "\n")
+
+	// Dots sit on the same columns in every row
+	require.Equal(t, "Ab  . . . .  1", lines[0])
+	require.Equal(t, "Abc . . . .  2", lines[1])
+}
+
+func TestTOC_TruncatesLongTitles(t *testing.T) {
+	toc := TOC{Sections: []Section{{Title: "A very long section title", Page: "7"}}}
+	got := toc.Render(20)
+
+	require.Equal(t, "A very long s… ... 7", got)
+	require.Equal(t, 20, measure.Width(got))
+}
+
+func TestTOC_StyledTitlesAlign(t *testing.T) {
+	bold := NewStyle().Bold(true)
+	toc := TOC{
+		Sections:    []Section{{Title: bold.Render("Styled"), Page: "1"}, {Title: "Plain", Page: "2"}},
+		LeaderStyle: NewStyle().Faint(true),
+	}
+	got := toc.Render(20)
+
+	for _, line := range strings.Split(got, "\n") {
+		require.Equal(t, 20, measure.Width(line))
+	}
+	require.Contains(t, got, "\x1b[2m", "leaders are styled")
+}
+
+func TestTOC_Empty(t *testing.T) {
+	require.Empty(t, TOC{}.Render(40))
+}
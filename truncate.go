@@ -0,0 +1,181 @@
+package tuistyles
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/orchard9/tui-styles/internal/ansi"
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+// TruncateMode selects which part of a line MaxWidth cuts away
+type TruncateMode int
+
+const (
+	// TruncateTail keeps the start of the line: "a long lin..." (the
+	// default when unset)
+	TruncateTail TruncateMode = iota
+	// TruncateHead keeps the end of the line: "...ong line"
+	TruncateHead
+	// TruncateCenter keeps both ends, for paths and identifiers whose
+	// start and end both matter: "a lo...line"
+	TruncateCenter
+)
+
+// String returns human-readable truncation mode name
+func (m TruncateMode) String() string {
+	switch m {
+	case TruncateTail:
+		return "Tail"
+	case TruncateHead:
+		return "Head"
+	case TruncateCenter:
+		return "Center"
+	default:
+		return "Unknown"
+	}
+}
+
+// MarshalText encodes the mode as its lowercase name ("tail", "head", "center").
+func (m TruncateMode) MarshalText() ([]byte, error) {
+	if m < TruncateTail || m > TruncateCenter {
+		return nil, fmt.Errorf("invalid truncate mode: %d", int(m))
+	}
+	return []byte(strings.ToLower(m.String())), nil
+}
+
+// UnmarshalText decodes a truncation mode name (case-insensitive).
+func (m *TruncateMode) UnmarshalText(text []byte) error {
+	name := strings.ToLower(string(text))
+	for candidate := TruncateTail; candidate <= TruncateCenter; candidate++ {
+		if strings.ToLower(candidate.String()) == name {
+			*m = candidate
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid truncate mode: %q", string(text))
+}
+
+// TruncateMode sets which part of a line wider than MaxWidth is cut away
+// and replaced with "...". With TruncateHead and TruncateCenter the kept
+// parts keep their escape sequences, so pre-styled text stays styled.
+//
+// Returns a new Style with truncateMode set, leaving the original unchanged.
+//
+// Example:
+//
+//	s := NewStyle().MaxWidth(20).TruncateMode(TruncateCenter)
+//	fmt.Println(s.Render("/home/user/projects/tui-styles/render.go"))
+//	// /home/use...ender.go
+func (s Style) TruncateMode(m TruncateMode) Style {
+	s2 := s
+	s2.truncateMode = &m
+	return s2
+}
+
+// TruncateExact makes lines cut by MaxWidth exactly MaxWidth cells wide.
+// Without it, a wide character that would straddle the cut is dropped and
+// the line comes out a cell short, so "你好世界" cut to 6 cells renders as
+// "你..." (5 cells); with it the gap is filled with a space: "你 ...".
+// Turn it on when truncated text sits in a fixed-width column.
+//
+// Returns a new Style with truncateExact set, leaving the original unchanged.
+//
+// Example:
+//
+//	s := NewStyle().MaxWidth(6).TruncateExact(true)
+//	fmt.Println(s.Render("你好世界")) // "你 ..."
+func (s Style) TruncateExact(v bool) Style {
+	s2 := s
+	s2.truncateExact = &v
+	return s2
+}
+
+// TruncateMiddle shortens s to at most width cells by replacing its middle
+// with ellipsis, keeping both ends: useful for file paths, URLs, and IDs
+// whose start and end carry the meaning. When the ends cannot split evenly
+// the start keeps the extra cell.
+//
+// Escape sequences in the kept parts are preserved: styling open before the
+// cut is closed ahead of the ellipsis and re-opened after it. A wide
+// character that would straddle a cut is dropped, so the result can be a
+// cell narrower than width. Strings that already fit are returned
+// unchanged; when ellipsis alone is wider than width it is cut to fit.
+//
+// Example:
+//
+//	TruncateMiddle("/home/user/projects/tui-styles/render.go", 20, "…")
+//	// "/home/user…render.go"
+func TruncateMiddle(s string, width int, ellipsis string) string {
+	avail := width - measure.Width(ellipsis)
+	return truncateKeeping(s, width, ellipsis, (avail+1)/2, false)
+}
+
+// truncateKeeping shortens s to width cells, keeping up to headCells cells
+// of its start and filling the rest after ellipsis with its end. With exact,
+// a cell lost to a dropped wide character is filled with a space.
+func truncateKeeping(s string, width int, ellipsis string, headCells int, exact bool) string {
+	total := measure.Width(s)
+	if total <= width {
+		return s
+	}
+	if width <= 0 {
+		return ""
+	}
+	avail := width - measure.Width(ellipsis)
+	if avail <= 0 {
+		head, _ := measure.SplitAt(ellipsis, width)
+		if exact {
+			head += strings.Repeat(" ", width-measure.Width(head))
+		}
+		return head
+	}
+
+	headCells = min(max(headCells, 0), avail)
+	head, _ := measure.SplitAt(s, headCells)
+	tailCells := avail - measure.Width(head)
+
+	// The tail starts where the last tailCells cells begin; a wide
+	// character straddling that point is dropped
+	before, tail := measure.SplitAt(s, total-tailCells)
+	if measure.Width(tail) > tailCells {
+		before, tail = measure.SplitAt(s, total-tailCells+1)
+	}
+
+	var b strings.Builder
+	b.WriteString(head)
+	if measure.OpenSGR(head) != "" {
+		b.WriteString(ansi.Reset())
+	}
+	b.WriteString(ellipsis)
+	if gap := tailCells - measure.Width(tail); exact && gap > 0 {
+		b.WriteString(strings.Repeat(" ", gap))
+	}
+	b.WriteString(measure.OpenSGR(before))
+	b.WriteString(tail)
+	return b.String()
+}
+
+// truncateLine cuts line to width cells according to the style's
+// truncation mode
+func (s Style) truncateLine(line string, width int) string {
+	mode := TruncateTail
+	if s.truncateMode != nil {
+		mode = *s.truncateMode
+	}
+
+	exact := s.truncateExact != nil && *s.truncateExact
+
+	switch mode {
+	case TruncateHead:
+		return truncateKeeping(line, width, "...", 0, exact)
+	case TruncateCenter:
+		// Same split as TruncateMiddle
+		return truncateKeeping(line, width, "...", (width-3+1)/2, exact)
+	default:
+		if exact {
+			return measure.TruncateExact(line, width, "...")
+		}
+		return measure.Truncate(line, width, "...")
+	}
+}
@@ -0,0 +1,111 @@
+package tuistyles
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+func TestTruncateMiddle(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		width    int
+		ellipsis string
+		want     string
+	}{
+		{"path", "/home/user/projects/tui-styles/render.go", 20, "…", "/home/user…render.go"},
+		{"fits", "short", 10, "…", "short"},
+		{"odd split favors start", "abcdefghij", 6, "..", "ab..ij"},
+		{"even split", "abcdefghij", 7, "…", "abc…hij"},
+		{"ellipsis only", "abcdefghij", 1, "…", "…"},
+		{"ellipsis wider than width", "abcdefghij", 2, "...", ".."},
+		{"zero width", "abcdefghij", 0, "…", ""},
+		{"wide characters", "你好世界你好世界", 8, "…", "你好…界"},
+		{"wide character at the cut", "你好世界你好", 6, "…", "你…好"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := TruncateMiddle(tt.input, tt.width, tt.ellipsis)
+			require.Equal(t, tt.want, got)
+			require.LessOrEqual(t, measure.Width(got), max(tt.width, 0))
+		})
+	}
+}
+
+func TestTruncateMiddle_Styled(t *testing.T) {
+	in := "\x1b[1mbold start\x1b[0m plain \x1b[31mred end\x1b[0m"
+
+	got := TruncateMiddle(in, 11, "…")
+	require.Equal(t, "\x1b[1mbold \x1b[0m…\x1b[31md end\x1b[0m", got)
+
+	// Styling open across the cut is re-opened after the ellipsis
+	got = TruncateMiddle("\x1b[4mabcdefghij\x1b[0m", 5, "…")
+	require.Equal(t, "\x1b[4mab\x1b[0m…\x1b[4mij\x1b[0m", got)
+}
+
+func TestStyle_TruncateMode(t *testing.T) {
+	text := "abcdefghijklmnop"
+
+	tests := []struct {
+		mode TruncateMode
+		want string
+	}{
+		{TruncateTail, "abcdefg..."},
+		{TruncateHead, "...jklmnop"},
+		{TruncateCenter, "abcd...nop"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.mode.String(), func(t *testing.T) {
+			require.Equal(t, tt.want, NewStyle().MaxWidth(10).TruncateMode(tt.mode).Render(text))
+		})
+	}
+
+	require.Equal(t, "abcdefg...", NewStyle().MaxWidth(10).Render(text), "tail is the default")
+}
+
+func TestStyle_TruncateExact(t *testing.T) {
+	text := "你好世界你好"
+
+	tests := []struct {
+		mode        TruncateMode
+		loose, want string
+	}{
+		{TruncateTail, "你...", "你 ..."},
+		{TruncateHead, "...好", "... 好"},
+		{TruncateCenter, "你...", "你... "},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.mode.String(), func(t *testing.T) {
+			style := NewStyle().MaxWidth(6).TruncateMode(tt.mode)
+			require.Equal(t, tt.loose, style.Render(text))
+			require.Equal(t, tt.want, style.TruncateExact(true).Render(text))
+		})
+	}
+
+	require.Equal(t, "abc...", NewStyle().MaxWidth(6).TruncateExact(true).Render("abcdefgh"))
+	require.Equal(t, "你好", NewStyle().MaxWidth(6).TruncateExact(true).Render("你好"), "short lines are not padded")
+}
+
+func TestTruncateMode_Text(t *testing.T) {
+	for _, m := range []TruncateMode{TruncateTail, TruncateHead, TruncateCenter} {
+		data, err := json.Marshal(m)
+		require.NoError(t, err)
+
+		var got TruncateMode
+		require.NoError(t, json.Unmarshal(data, &got))
+		require.Equal(t, m, got)
+	}
+
+	_, err := TruncateMode(7).MarshalText()
+	require.Error(t, err)
+	var m TruncateMode
+	require.Error(t, m.UnmarshalText([]byte("sideways")))
+	require.Equal(t, "Unknown", TruncateMode(7).String())
+}
@@ -0,0 +1,126 @@
+package tuistyles
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Validation errors reported by Style.Validate, for use with errors.Is.
+var (
+	ErrConflictingSizes = errors.New("conflicting sizes")
+	ErrNoEffect         = errors.New("has no effect")
+)
+
+// Validate reports contradictory or ineffective settings, or nil when the
+// style is consistent. It is meant for development and tests: a style that
+// fails validation still renders, just not as its author intended.
+//
+// Besides the invalid colors reported by Err, Validate finds:
+//   - Width or Height larger than MaxWidth or MaxHeight
+//   - settings that depend on another one that is missing, such as Align
+//     without Width, TruncateMode without MaxWidth, AlignVertical without
+//     Height, border colors without a border (or on a disabled side),
+//     ShadowColor without Shadow, and a padding background on a side
+//     without padding
+//
+// Width and Height measure the content area, excluding padding and border,
+// so padding can never crowd out the content.
+//
+// Each problem names the property as Style.Diff does and wraps
+// ErrConflictingSizes, ErrNoEffect, or a color error.
+//
+// Example:
+//
+//	s := NewStyle().Width(30).MaxWidth(20).Align(Center)
+//	err := s.Validate() // Width: conflicting sizes: 30 exceeds MaxWidth 20
+//	errors.Is(err, ErrConflictingSizes) // true
+func (s Style) Validate() error {
+	var errs []error
+	if err := s.Err(); err != nil {
+		if joined, ok := err.(interface{ Unwrap() []error }); ok {
+			errs = append(errs, joined.Unwrap()...)
+		} else {
+			errs = append(errs, err)
+		}
+	}
+	add := func(name string, sentinel error, format string, args ...any) {
+		errs = append(errs, fmt.Errorf("%s: %w: %s", name, sentinel, fmt.Sprintf(format, args...)))
+	}
+
+	if s.width != nil && s.maxWidth != nil && *s.width > *s.maxWidth {
+		add("Width", ErrConflictingSizes, "%d exceeds MaxWidth %d", *s.width, *s.maxWidth)
+	}
+	if s.height != nil && s.maxHeight != nil && *s.height > *s.maxHeight {
+		add("Height", ErrConflictingSizes, "%d exceeds MaxHeight %d", *s.height, *s.maxHeight)
+	}
+
+	if s.align != nil && *s.align != Decimal && s.width == nil {
+		add("Align", ErrNoEffect, "requires Width")
+	}
+	if s.alignVertical != nil && s.height == nil {
+		add("AlignVertical", ErrNoEffect, "requires Height")
+	}
+	if s.hyphenate != nil && *s.hyphenate && s.width == nil {
+		add("Hyphenate", ErrNoEffect, "requires Width")
+	}
+	if s.longWords != nil && s.width == nil {
+		add("LongWords", ErrNoEffect, "requires Width")
+	}
+	if s.truncateMode != nil && s.maxWidth == nil {
+		add("TruncateMode", ErrNoEffect, "requires MaxWidth")
+	}
+	if s.shadowColor != nil && !s.hasShadow() {
+		add("ShadowColor", ErrNoEffect, "requires Shadow")
+	}
+	if s.hasBackgroundPattern() && s.width == nil && s.height == nil && !s.hasPadding() {
+		add("BackgroundPattern", ErrNoEffect, "requires Width, Height, or padding")
+	}
+
+	for _, side := range []struct {
+		name    string
+		padding *int
+		bg      *Color
+	}{
+		{"PaddingTopBackground", s.paddingTop, s.paddingTopBackground},
+		{"PaddingRightBackground", s.paddingRight, s.paddingRightBackground},
+		{"PaddingBottomBackground", s.paddingBottom, s.paddingBottomBackground},
+		{"PaddingLeftBackground", s.paddingLeft, s.paddingLeftBackground},
+	} {
+		if side.bg != nil && (side.padding == nil || *side.padding == 0) {
+			add(side.name, ErrNoEffect, "no padding on that side")
+		}
+	}
+
+	if !s.hasBorder() {
+		if s.borderForeground != nil {
+			add("BorderForeground", ErrNoEffect, "requires Border")
+		}
+		if s.borderBackground != nil {
+			add("BorderBackground", ErrNoEffect, "requires Border")
+		}
+	}
+	for _, side := range []struct {
+		name    string
+		enabled *bool
+		colors  []*Color
+	}{
+		{"BorderTop", s.borderTop, []*Color{s.borderTopForeground, s.borderTopBackground}},
+		{"BorderRight", s.borderRight, []*Color{s.borderRightForeground, s.borderRightBackground}},
+		{"BorderBottom", s.borderBottom, []*Color{s.borderBottomForeground, s.borderBottomBackground}},
+		{"BorderLeft", s.borderLeft, []*Color{s.borderLeftForeground, s.borderLeftBackground}},
+	} {
+		drawn := s.hasBorder() && (side.enabled == nil || *side.enabled)
+		for i, c := range side.colors {
+			if c == nil || drawn {
+				continue
+			}
+			name := side.name + "Foreground"
+			if i == 1 {
+				name = side.name + "Background"
+			}
+			add(name, ErrNoEffect, "side has no border")
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,65 @@
+package tuistyles
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStyle_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		style    Style
+		sentinel error
+		message  string
+	}{
+		{"width exceeds max width", NewStyle().Width(30).MaxWidth(20), ErrConflictingSizes, "Width: conflicting sizes: 30 exceeds MaxWidth 20"},
+		{"height exceeds max height", NewStyle().Height(8).MaxHeight(4), ErrConflictingSizes, "Height: conflicting sizes: 8 exceeds MaxHeight 4"},
+		{"align without width", NewStyle().Align(Center), ErrNoEffect, "Align: has no effect: requires Width"},
+		{"vertical align without height", NewStyle().AlignVertical(Bottom), ErrNoEffect, "AlignVertical: has no effect: requires Height"},
+		{"hyphenate without width", NewStyle().Hyphenate(true), ErrNoEffect, "Hyphenate: has no effect: requires Width"},
+		{"long words without width", NewStyle().LongWords(Overflow), ErrNoEffect, "LongWords: has no effect: requires Width"},
+		{"truncate mode without max width", NewStyle().TruncateMode(TruncateCenter), ErrNoEffect, "TruncateMode: has no effect: requires MaxWidth"},
+		{"shadow color without shadow", NewStyle().ShadowColor(Color("240")), ErrNoEffect, "ShadowColor: has no effect: requires Shadow"},
+		{"pattern without space to fill", NewStyle().BackgroundPattern("░"), ErrNoEffect, "BackgroundPattern: has no effect: requires Width, Height, or padding"},
+		{"padding background without padding", NewStyle().PaddingLeft(0).PaddingLeftBackground(Color("red")), ErrNoEffect, "PaddingLeftBackground: has no effect: no padding on that side"},
+		{"border color without border", NewStyle().BorderForeground(Color("red")), ErrNoEffect, "BorderForeground: has no effect: requires Border"},
+		{"side color on disabled side", NewStyle().Border(NormalBorder(), true, true, true, false).BorderLeftForeground(Color("red")), ErrNoEffect, "BorderLeftForeground: has no effect: side has no border"},
+		{"invalid color", NewStyle().Foreground(Color("#GGG")), ErrInvalidHex, "Foreground: invalid hex color: #GGG"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.style.Validate()
+			require.ErrorIs(t, err, tt.sentinel)
+			require.EqualError(t, err, tt.message)
+		})
+	}
+}
+
+func TestStyle_ValidateConsistent(t *testing.T) {
+	styles := []Style{
+		NewStyle(),
+		NewStyle().Bold(true).Foreground(Color("#7D56F4")),
+		NewStyle().Width(20).MaxWidth(20).Align(Center).Height(3).AlignVertical(Center),
+		NewStyle().Border(RoundedBorder()).BorderForeground(Color("63")).BorderTopBackground(Color("236")),
+		NewStyle().Padding(1).PaddingLeftBackground(Color("blue")).BackgroundPattern("░"),
+		NewStyle().Shadow(true).ShadowColor(Color("240")),
+	}
+	for i, s := range styles {
+		require.NoError(t, s.Validate(), "style %d", i)
+	}
+}
+
+func TestStyle_ValidateJoinsProblems(t *testing.T) {
+	err := NewStyle().Width(10).MaxWidth(5).Align(Right).BorderBackground(Color("nope")).Validate()
+
+	require.ErrorIs(t, err, ErrConflictingSizes)
+	require.ErrorIs(t, err, ErrNoEffect)
+	require.ErrorIs(t, err, ErrUnknownName)
+
+	var joined interface{ Unwrap() []error }
+	require.True(t, errors.As(err, &joined))
+	require.Len(t, joined.Unwrap(), 3)
+}
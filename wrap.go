@@ -0,0 +1,194 @@
+package tuistyles
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+// LongWordPolicy selects what wrapping does with a word wider than the
+// line, such as a URL or a hash
+type LongWordPolicy int
+
+const (
+	// BreakAnywhere splits the word across lines at whatever cell the line
+	// ends on (the default when unset)
+	BreakAnywhere LongWordPolicy = iota
+	// Overflow puts the word on a line of its own, whole, letting it run
+	// past the width so it can still be copied
+	Overflow
+	// TruncateToken cuts the word to the width, ending it with "…"
+	TruncateToken
+)
+
+// String returns human-readable long word policy name
+func (p LongWordPolicy) String() string {
+	switch p {
+	case BreakAnywhere:
+		return "BreakAnywhere"
+	case Overflow:
+		return "Overflow"
+	case TruncateToken:
+		return "TruncateToken"
+	default:
+		return "Unknown"
+	}
+}
+
+// MarshalText encodes the policy as its lowercase name ("breakanywhere", "overflow", "truncatetoken").
+func (p LongWordPolicy) MarshalText() ([]byte, error) {
+	if p < BreakAnywhere || p > TruncateToken {
+		return nil, fmt.Errorf("invalid long word policy: %d", int(p))
+	}
+	return []byte(strings.ToLower(p.String())), nil
+}
+
+// UnmarshalText decodes a long word policy name (case-insensitive).
+func (p *LongWordPolicy) UnmarshalText(text []byte) error {
+	name := strings.ToLower(string(text))
+	for candidate := BreakAnywhere; candidate <= TruncateToken; candidate++ {
+		if strings.ToLower(candidate.String()) == name {
+			*p = candidate
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid long word policy: %q", string(text))
+}
+
+// LongWords makes Render word-wrap content to the style's Width, handling
+// words wider than Width with policy. It has no effect without a Width.
+// With Hyphenate as well, long words are hyphenated where possible and the
+// policy handles the rest.
+//
+// Returns a new Style with longWords set, leaving the original unchanged.
+//
+// Example:
+//
+//	cell := NewStyle().Width(20).LongWords(TruncateToken)
+//	fmt.Println(cell.Render("see https://example.com/a/very/long/path"))
+//	// see
+//	// https://example.com…
+func (s Style) LongWords(policy LongWordPolicy) Style {
+	s2 := s
+	s2.longWords = &policy
+	return s2
+}
+
+// Wrap word-wraps str so no line is wider than width cells.
+//
+// Lines break at spaces; words longer than width are split across lines.
+// Existing newlines are kept, and escape sequences are carried along
+// without counting toward the width, so already-styled text can be wrapped.
+// A width of 0 or less returns str unchanged.
+//
+// Example:
+//
+//	Wrap("the quick brown fox", 10) // "the quick\nbrown fox"
+func Wrap(str string, width int) string {
+	return wrapText(str, width, nil, BreakAnywhere)
+}
+
+// WrapLongWords word-wraps str to width like Wrap, but handles words wider
+// than width with policy instead of always splitting them.
+//
+// Example:
+//
+//	WrapLongWords("id 3f786850e387550fdab836ed7e6dc881de23001b", 12, TruncateToken)
+//	// "id\n3f786850e38…"
+func WrapLongWords(str string, width int, policy LongWordPolicy) string {
+	return wrapText(str, width, nil, policy)
+}
+
+// wrapText wraps every line of str to width, hyphenating words with h when
+// it is not nil and handling words that still do not fit with policy
+func wrapText(str string, width int, h *Hyphenator, policy LongWordPolicy) string {
+	if width <= 0 {
+		return str
+	}
+
+	lines := strings.Split(str, "\n")
+	wrapped := make([]string, 0, len(lines))
+	for _, line := range lines {
+		wrapped = append(wrapped, wrapLine(line, width, h, policy)...)
+	}
+	return strings.Join(wrapped, "\n")
+}
+
+// wrapLine greedily wraps a single line of text to width, hyphenating
+// words with h when it is not nil
+func wrapLine(line string, width int, h *Hyphenator, policy LongWordPolicy) []string {
+	if measure.Width(line) <= width {
+		return []string{line}
+	}
+
+	var lines []string
+	var current strings.Builder
+	currentWidth := 0
+
+	flush := func() {
+		lines = append(lines, current.String())
+		current.Reset()
+		currentWidth = 0
+	}
+
+	for i, word := range strings.Split(line, " ") {
+		wordWidth := measure.Width(word)
+
+		// Join onto the current line when the word (and its separating space) fits
+		if i > 0 {
+			if currentWidth+1+wordWidth <= width {
+				current.WriteString(" ")
+				current.WriteString(word)
+				currentWidth += 1 + wordWidth
+				continue
+			}
+
+			// Otherwise fill the rest of the line with part of a hyphenated word
+			if h != nil {
+				if head, tail, ok := h.split(word, width-currentWidth-1); ok {
+					current.WriteString(" ")
+					current.WriteString(head)
+					word, wordWidth = tail, measure.Width(tail)
+				}
+			}
+			flush()
+		}
+
+		// Break words that cannot fit on a line of their own
+		for wordWidth > width {
+			var head, tail string
+			hyphenated := false
+			if h != nil {
+				head, tail, hyphenated = h.split(word, width)
+			}
+			if !hyphenated && policy == Overflow {
+				break
+			}
+			if !hyphenated && policy == TruncateToken {
+				word = truncateKeeping(word, width, "…", width, false)
+				wordWidth = measure.Width(word)
+				break
+			}
+			if !hyphenated {
+				head, tail = measure.SplitAt(word, width)
+				if measure.Width(head) == 0 {
+					// A wide character alone exceeds width; emit it rather than loop forever
+					head, tail = measure.SplitAt(word, width+1)
+				}
+				if tail == "" {
+					break
+				}
+			}
+			current.WriteString(head)
+			flush()
+			word, wordWidth = tail, measure.Width(tail)
+		}
+
+		current.WriteString(word)
+		currentWidth += wordWidth
+	}
+
+	flush()
+	return lines
+}
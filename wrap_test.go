@@ -0,0 +1,109 @@
+package tuistyles
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/orchard9/tui-styles/internal/measure"
+)
+
+func TestWrap(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		width int
+		want  string
+	}{
+		{"fits", "hello", 10, "hello"},
+		{"breaks at spaces", "the quick brown fox", 10, "the quick\nbrown fox"},
+		{"keeps newlines", "one two\nthree four", 7, "one two\nthree\nfour"},
+		{"hard-breaks long words", "abcdefghijkl xy", 5, "abcde\nfghij\nkl xy"},
+		{"wide runes", "你好世界", 4, "你好\n世界"},
+		{"wide rune wider than width", "你好", 1, "你\n好"},
+		{"zero width unchanged", "a b c", 0, "a b c"},
+		{"empty", "", 5, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Wrap(tt.input, tt.width); got != tt.want {
+				t.Errorf("Wrap(%q, %d) = %q, want %q", tt.input, tt.width, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWrap_StyledText(t *testing.T) {
+	input := NewStyle().Bold(true).Render("alpha") + " beta gamma"
+	got := Wrap(input, 10)
+
+	if plain := measure.StripANSI(got); plain != "alpha beta\ngamma" {
+		t.Errorf("plain text = %q, want %q", plain, "alpha beta\ngamma")
+	}
+	if !strings.Contains(got, "\x1b[1m") {
+		t.Error("escape sequences should be preserved")
+	}
+	for _, line := range strings.Split(got, "\n") {
+		if w := measure.Width(line); w > 10 {
+			t.Errorf("line %q is %d cells wide, want <= 10", line, w)
+		}
+	}
+}
+
+func TestWrapLongWords(t *testing.T) {
+	input := "see abcdefghijkl now"
+
+	tests := []struct {
+		policy LongWordPolicy
+		want   string
+	}{
+		{BreakAnywhere, "see\nabcde\nfghij\nkl\nnow"},
+		{Overflow, "see\nabcdefghijkl\nnow"},
+		{TruncateToken, "see\nabcd…\nnow"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.policy.String(), func(t *testing.T) {
+			if got := WrapLongWords(input, 5, tt.policy); got != tt.want {
+				t.Errorf("WrapLongWords(%q, 5, %s) = %q, want %q", input, tt.policy, got, tt.want)
+			}
+		})
+	}
+
+	if got := WrapLongWords(input, 5, BreakAnywhere); got != Wrap(input, 5) {
+		t.Errorf("BreakAnywhere = %q, want Wrap's %q", got, Wrap(input, 5))
+	}
+}
+
+func TestStyle_LongWords(t *testing.T) {
+	got := NewStyle().Width(6).LongWords(TruncateToken).Render("id 3f786850e387")
+	if want := "id\n3f786…"; got != want {
+		t.Errorf("Render = %q, want %q", got, want)
+	}
+
+	got = NewStyle().Width(6).LongWords(Overflow).Render("id 3f786850e387")
+	if want := "id\n3f786850e387"; got != want {
+		t.Errorf("Render = %q, want %q", got, want)
+	}
+}
+
+func TestLongWordPolicy_Text(t *testing.T) {
+	for _, policy := range []LongWordPolicy{BreakAnywhere, Overflow, TruncateToken} {
+		text, err := policy.MarshalText()
+		if err != nil {
+			t.Fatalf("MarshalText(%s): %v", policy, err)
+		}
+		var decoded LongWordPolicy
+		if err := decoded.UnmarshalText(text); err != nil || decoded != policy {
+			t.Errorf("UnmarshalText(%q) = %s, %v; want %s", text, decoded, err, policy)
+		}
+	}
+
+	if _, err := LongWordPolicy(9).MarshalText(); err == nil {
+		t.Error("MarshalText of an unknown policy should fail")
+	}
+	var policy LongWordPolicy
+	if err := policy.UnmarshalText([]byte("hyphenate")); err == nil {
+		t.Error("UnmarshalText of an unknown name should fail")
+	}
+}